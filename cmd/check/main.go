@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/passives"
+	"github.com/Everlag/slippery-policy/policy"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+)
+
+var policyName = flag.String("policy", policy.GucciHoboName,
+	fmt.Sprintf("which policy to check, one of %v", policy.BuiltinNames()))
+var rulesFile = flag.String("rules", "", "policy file(json or yaml) to check instead of -policy")
+var itemsFile = flag.String("items", "", "saved get-items response")
+var passivesFile = flag.String("passives", "", "saved get-passive-skills response")
+var accountName = flag.String("account", "", "account the character belongs to, for output only")
+var level = flag.Int("level", 0, "character level, required when -items is not provided and rejected otherwise")
+var format = flag.String("format", "table", "output format, one of table, csv or json")
+
+// Exit codes, distinguishing a character failing the policy
+// from the check itself failing.
+const (
+	exitOK        = 0
+	exitViolation = 1
+	exitError     = 2
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+check runs a policy against saved get-items and get-passive-skills responses
+
+Every failure is printed. The exit code is 1 if any failure is a violation
+and 2 if the responses or policy could not be read.
+
+Usage:
+	check -items get-items.json -passives get-passive-skills.json`)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	os.Exit(run(os.Stdout, os.Stderr))
+}
+
+// run checks the saved responses specified by our flags, writing
+// every failure to stdout or why the check failed to stderr, and
+// returns the exit code.
+//
+// Only failures are written to stdout, so it stays machine
+// readable with -format csv or json.
+func run(stdout, stderr io.Writer) int {
+	if len(*itemsFile) == 0 && len(*passivesFile) == 0 {
+		flag.Usage()
+		return exitError
+	}
+	// Without get-items, the level is unknown and every rule
+	// would sit under its grace level
+	if len(*itemsFile) == 0 && *level <= 0 {
+		fmt.Fprintln(stderr, "-level is required when -items is not provided")
+		return exitError
+	}
+	// With get-items, the level comes from the response
+	if len(*itemsFile) > 0 && *level > 0 {
+		fmt.Fprintln(stderr, "-level cannot be provided alongside -items")
+		return exitError
+	}
+
+	p, err := selectPolicy()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	snapshot, err := readSnapshot()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	// Check includes every LadderRule
+	failures := p.Check(snapshot)
+	if err := write(stdout, *format, failures); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	for _, f := range failures {
+		if f.Severity == items.SeverityViolation {
+			return exitViolation
+		}
+	}
+	return exitOK
+}
+
+// selectPolicy returns the Policy specified by -rules, if present, and
+// otherwise the built-in Policy specified by -policy.
+func selectPolicy() (policy.Policy, error) {
+	if len(*rulesFile) > 0 {
+		return policy.LoadFile(*rulesFile)
+	}
+	return policy.Builtin(*policyName)
+}
+
+// readSnapshot builds a CharacterSnapshot from the saved responses.
+//
+// The ladder Entry is filled out from the get-items response as
+// saved responses do not include one.
+func readSnapshot() (policy.CharacterSnapshot, error) {
+	snapshot := policy.CharacterSnapshot{
+		When: time.Now(),
+	}
+	snapshot.Entry.Account.Name = *accountName
+	snapshot.Entry.Character.Level = *level
+
+	if len(*itemsFile) > 0 {
+		f, err := os.Open(*itemsFile)
+		if err != nil {
+			return snapshot, errors.Wrap(err, "opening items file")
+		}
+		defer f.Close()
+		resp, err := items.ReadGetItemResp(f)
+		if err != nil {
+			return snapshot, errors.Wrapf(err, "reading items file %s", *itemsFile)
+		}
+		snapshot.Items = resp
+		snapshot.Entry.Character = ladder.Character{
+			Name:       resp.Character.Name,
+			Level:      resp.Character.Level,
+			Class:      resp.Character.Class,
+			Experience: resp.Character.Experience,
+		}
+	}
+
+	if len(*passivesFile) > 0 {
+		f, err := os.Open(*passivesFile)
+		if err != nil {
+			return snapshot, errors.Wrap(err, "opening passives file")
+		}
+		defer f.Close()
+		resp, err := passives.ReadPassives(f)
+		if err != nil {
+			return snapshot, errors.Wrapf(err, "reading passives file %s", *passivesFile)
+		}
+		snapshot.Passives = resp
+	}
+
+	return snapshot, nil
+}
+
+// write outputs the failures in the provided format.
+func write(w io.Writer, format string, failures []items.PolicyFailure) error {
+	switch format {
+	case "table":
+		return writeTable(w, failures)
+	case "csv":
+		writer := csv.NewWriter(w)
+		writer.Write(items.PolicyFailureCSVHeader())
+		for _, f := range failures {
+			record, err := f.ToCSVRecord()
+			if err != nil {
+				return err
+			}
+			writer.Write(record)
+		}
+		writer.Flush()
+		return errors.Wrap(writer.Error(), "writing csv")
+	case "json":
+		// Always output a list, even when empty
+		if failures == nil {
+			failures = []items.PolicyFailure{}
+		}
+		encoder := jsoniter.ConfigCompatibleWithStandardLibrary.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return errors.Wrap(encoder.Encode(failures), "writing json")
+	default:
+		return errors.Errorf("unknown format %q, expected one of table, csv or json",
+			format)
+	}
+}
+
+// writeTable outputs the failures as an aligned, human-readable table.
+func writeTable(w io.Writer, failures []items.PolicyFailure) error {
+	if len(failures) == 0 {
+		_, err := fmt.Fprintln(w, "no failures")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SEVERITY\tRULE\tREASON\tSLOT\tITEM\tSOCKETED IN")
+	for _, f := range failures {
+		var parent string
+		if len(f.ParentItemSlot) > 0 {
+			parent = fmt.Sprintf("%s (%s)", f.ParentItemName, f.ParentItemSlot)
+		}
+		fmt.Fprintln(tw, strings.Join([]string{
+			string(f.Severity),
+			f.RuleID,
+			f.Reason,
+			f.ItemSlot,
+			f.ItemName,
+			parent,
+		}, "\t"))
+	}
+	return tw.Flush()
+}
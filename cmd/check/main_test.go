@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/policy"
+	"github.com/stretchr/testify/require"
+)
+
+const itemsFixture = "../../fixtures/get-items.json"
+const passivesFixture = "../../fixtures/get-passive-skills.json"
+const emptyItemsFixture = "../../fixtures/golden/fresh-character/get-items.json"
+
+// resetFlags restores every flag to its default.
+func resetFlags() {
+	*policyName = policy.GucciHoboName
+	*rulesFile = ""
+	*itemsFile = ""
+	*passivesFile = ""
+	*accountName = ""
+	*level = 0
+	*format = "table"
+}
+
+func fixtureFailures() []items.PolicyFailure {
+	return []items.PolicyFailure{
+		{
+			Reason:        items.PolicyFailureReasonItem,
+			RuleID:        policy.RuleIDRarity,
+			Severity:      items.SeverityViolation,
+			ItemName:      "Bramble Fingers Dragonscale Gauntlets",
+			ItemSlot:      "Gloves",
+			CharacterName: "SleeperSpectreBoi",
+			AccountName:   "Everlag",
+		},
+		{
+			Reason:         items.PolicyFailureReasonItem,
+			RuleID:         policy.RuleIDRarity,
+			Severity:       items.SeverityWarning,
+			ItemName:       "Gloom Eye Murderous Eye Jewel",
+			ItemSlot:       "Abyss",
+			CharacterName:  "SleeperSpectreBoi",
+			AccountName:    "Everlag",
+			ParentItemName: "Some Body Armour",
+			ParentItemSlot: "BodyArmour",
+		},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, write(&buf, "table", fixtureFailures()))
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+		require.True(t, strings.HasPrefix(lines[0], "SEVERITY"))
+		require.Contains(t, lines[1], "violation")
+		require.Contains(t, lines[1], "Bramble Fingers Dragonscale Gauntlets")
+		require.Contains(t, lines[2], "Some Body Armour (BodyArmour)")
+	})
+
+	t.Run("empty table", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, write(&buf, "table", nil))
+		require.Equal(t, "no failures\n", buf.String())
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, write(&buf, "csv", fixtureFailures()))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, items.PolicyFailureCSVHeader(), records[0])
+		expected, err := fixtureFailures()[0].ToCSVRecord()
+		require.NoError(t, err)
+		require.Equal(t, expected, records[1])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, write(&buf, "json", fixtureFailures()))
+
+		var decoded []items.PolicyFailure
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Equal(t, fixtureFailures(), decoded)
+	})
+
+	t.Run("empty json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, write(&buf, "json", nil))
+		require.Equal(t, "[]\n", buf.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.Error(t, write(&buf, "xml", fixtureFailures()))
+	})
+}
+
+func TestReadSnapshot(t *testing.T) {
+	t.Run("items", func(t *testing.T) {
+		resetFlags()
+		*itemsFile = itemsFixture
+		*accountName = "Everlag"
+
+		snapshot, err := readSnapshot()
+		require.NoError(t, err)
+		require.NotNil(t, snapshot.Items)
+		require.Nil(t, snapshot.Passives)
+		require.Equal(t, "Everlag", snapshot.Entry.Account.Name)
+		require.Equal(t, "SleeperSpectreBoi", snapshot.Entry.Character.Name)
+		require.Equal(t, 91, snapshot.Entry.Character.Level)
+		require.Equal(t, "Necromancer", snapshot.Entry.Character.Class)
+	})
+
+	t.Run("passives only", func(t *testing.T) {
+		resetFlags()
+		*passivesFile = passivesFixture
+		*level = 50
+
+		snapshot, err := readSnapshot()
+		require.NoError(t, err)
+		require.Nil(t, snapshot.Items)
+		require.NotNil(t, snapshot.Passives)
+		require.Equal(t, 50, snapshot.Entry.Character.Level)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		resetFlags()
+		*itemsFile = "not-a-file.json"
+
+		_, err := readSnapshot()
+		require.Error(t, err)
+	})
+
+	t.Run("malformed file", func(t *testing.T) {
+		resetFlags()
+		*itemsFile = "main.go"
+
+		_, err := readSnapshot()
+		require.Error(t, err)
+	})
+}
+
+func TestRun(t *testing.T) {
+	// requireParseable fails unless stdout is empty or a complete
+	// document in the provided format.
+	requireParseable := func(t *testing.T, format string, stdout []byte) {
+		if len(stdout) == 0 {
+			return
+		}
+		switch format {
+		case "csv":
+			records, err := csv.NewReader(bytes.NewReader(stdout)).ReadAll()
+			require.NoError(t, err)
+			require.Equal(t, items.PolicyFailureCSVHeader(), records[0])
+		case "json":
+			var decoded []items.PolicyFailure
+			require.NoError(t, json.Unmarshal(stdout, &decoded))
+		}
+	}
+
+	t.Run("violation", func(t *testing.T) {
+		resetFlags()
+		*itemsFile = itemsFixture
+
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitViolation, run(&stdout, &stderr))
+		require.Contains(t, stdout.String(), "violation")
+		require.Empty(t, stderr.String())
+	})
+
+	t.Run("violation csv", func(t *testing.T) {
+		resetFlags()
+		*itemsFile = itemsFixture
+		*format = "csv"
+
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitViolation, run(&stdout, &stderr))
+		require.NotEmpty(t, stdout.String())
+		requireParseable(t, *format, stdout.Bytes())
+	})
+
+	t.Run("no failures", func(t *testing.T) {
+		resetFlags()
+		*itemsFile = emptyItemsFixture
+
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitOK, run(&stdout, &stderr))
+		require.Equal(t, "no failures\n", stdout.String())
+	})
+
+	for _, outFormat := range []string{"csv", "json"} {
+		t.Run("unreadable response "+outFormat, func(t *testing.T) {
+			resetFlags()
+			*itemsFile = "not-a-file.json"
+			*format = outFormat
+
+			var stdout, stderr bytes.Buffer
+			require.Equal(t, exitError, run(&stdout, &stderr))
+			require.Contains(t, stderr.String(), "not-a-file.json")
+			requireParseable(t, outFormat, stdout.Bytes())
+		})
+	}
+
+	t.Run("level without items", func(t *testing.T) {
+		resetFlags()
+		*passivesFile = passivesFixture
+		*format = "json"
+
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitError, run(&stdout, &stderr), "level is required")
+		require.Contains(t, stderr.String(), "-level")
+		requireParseable(t, *format, stdout.Bytes())
+	})
+
+	t.Run("level with items", func(t *testing.T) {
+		resetFlags()
+		*itemsFile = itemsFixture
+		*level = 10
+		*format = "csv"
+
+		var stdout, stderr bytes.Buffer
+		require.Equal(t, exitError, run(&stdout, &stderr))
+		require.Contains(t, stderr.String(), "-level")
+		requireParseable(t, *format, stdout.Bytes())
+	})
+}
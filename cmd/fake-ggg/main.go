@@ -0,0 +1,435 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/remote"
+	"github.com/pkg/errors"
+)
+
+var addr = flag.String("addr", "localhost:8080", "address to listen on")
+var dir = flag.String("dir", "fixtures/golden", "directory of saved characters, laid out as the golden corpus")
+
+// Rate limiting, as GGG describes it.
+var characterRules = flag.String("character_rules", "45:60:60,240:240:900",
+	"rate limit windows, hits:period:penalty in seconds, of get-items and get-passive-skills")
+var ladderRules = flag.String("ladder_rules", "12:60:60",
+	"rate limit windows, hits:period:penalty in seconds, of the ladder")
+
+// Failure injection; characters which are private or deleted
+// stay that way for the life of the server.
+var rate429 = flag.Float64("rate_429", 0, "fraction of requests which are rate limited regardless of the windows")
+var rate403 = flag.Float64("rate_403", 0, "fraction of characters with a private profile")
+var rate404 = flag.Float64("rate_404", 0, "fraction of characters which have been deleted")
+var latency = flag.Duration("latency", 0, "delay before every response")
+var jitter = flag.Duration("jitter", 0, "random delay, up to this, added to -latency")
+var league = flag.String("league", "Slippery Hobo League (PL5357)", "league every saved character is listed in by get-characters")
+var seed = flag.Int64("seed", 1, "seed for failure injection")
+var credential = flag.String("credential", "",
+	fmt.Sprintf("%s=<session id> or Bearer <token> which reads private profiles, as though every private player granted it access",
+		remote.SessionCookieName))
+
+func main() {
+	flag.Usage = func() {
+		fmt.Println(`
+fake-ggg serves the ladder, get-items, get-passive-skills and get-characters endpoints
+from a directory of saved characters, for testing watch without GGG
+
+Rate limit headers are emitted as GGG does, and 429s, private profiles,
+deleted characters and latency can be injected.
+
+Usage:
+	fake-ggg -dir fixtures/golden -rate_403 0.1
+	watch -ladder_url http://localhost:8080/ladders \
+		-items_url http://localhost:8080/character-window/get-items \
+		-passives_url http://localhost:8080/character-window/get-passive-skills \
+		-characters_url http://localhost:8080/character-window/get-characters`)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	s, err := newServer()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("serving %d characters from %s on %s\n", len(s.entries), *dir, *addr)
+	if err := http.ListenAndServe(*addr, s); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// character is a single saved character.
+type character struct {
+	entry    ladder.Entry
+	items    []byte
+	passives []byte
+}
+
+// server is a stand-in for the GGG API.
+type server struct {
+	entries    []ladder.Entry
+	characters map[string]character
+
+	ladderLimit    *limit
+	characterLimit *limit
+
+	// credential reads private profiles, when set
+	credential remote.Credential
+
+	mux *http.ServeMux
+
+	rand *rand.Rand
+	sync.Mutex
+}
+
+func newServer() (*server, error) {
+	ladderWindows, err := parseRules(*ladderRules)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing -ladder_rules")
+	}
+	characterWindows, err := parseRules(*characterRules)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing -character_rules")
+	}
+
+	s := &server{
+		characters:     make(map[string]character),
+		ladderLimit:    newLimit("ladder-view", ladderWindows),
+		characterLimit: newLimit("character-window-request-limit", characterWindows),
+		mux:            http.NewServeMux(),
+		rand:           rand.New(rand.NewSource(*seed)),
+	}
+	if len(*credential) > 0 {
+		s.credential, err = remote.ParseCredential(*credential)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing -credential")
+		}
+	}
+	if err := s.load(*dir); err != nil {
+		return nil, err
+	}
+
+	s.mux.HandleFunc("/ladders/", s.handleLadder)
+	s.mux.HandleFunc("/character-window/get-items", s.handleCharacter(
+		func(c character) []byte { return c.items }))
+	s.mux.HandleFunc("/character-window/get-passive-skills", s.handleCharacter(
+		func(c character) []byte { return c.passives }))
+	s.mux.HandleFunc("/character-window/get-characters", s.handleCharacters)
+	return s, nil
+}
+
+// load reads every character in the directory, ranking them in
+// the order of their directories.
+func (s *server) load(dir string) error {
+	entries, err := filepath.Glob(filepath.Join(dir, "*", "entry.json"))
+	if err != nil {
+		return errors.Wrap(err, "listing characters")
+	}
+	if len(entries) == 0 {
+		return errors.Errorf("no characters found in %s", dir)
+	}
+	sort.Strings(entries)
+
+	for i, filename := range entries {
+		blob, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return errors.Wrap(err, "reading entry")
+		}
+		var c character
+		if err := json.Unmarshal(blob, &c.entry); err != nil {
+			return errors.Wrapf(err, "decoding entry %s", filename)
+		}
+		c.entry.Rank = i + 1
+
+		caseDir := filepath.Dir(filename)
+		c.items, err = readOptional(filepath.Join(caseDir, "get-items.json"),
+			`{"items": [], "character": {}}`)
+		if err != nil {
+			return err
+		}
+		c.passives, err = readOptional(filepath.Join(caseDir, "get-passive-skills.json"),
+			`{"hashes": [], "items": []}`)
+		if err != nil {
+			return err
+		}
+
+		s.entries = append(s.entries, c.entry)
+		s.characters[characterKey(c.entry.Account.Name, c.entry.Character.Name)] = c
+	}
+	return nil
+}
+
+// readOptional returns the contents of the file, or the fallback
+// when it does not exist.
+func readOptional(filename, fallback string) ([]byte, error) {
+	blob, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return []byte(fallback), nil
+	}
+	return blob, errors.Wrapf(err, "reading %s", filename)
+}
+
+func characterKey(account, character string) string {
+	return fmt.Sprintf("%s-%s", account, character)
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	delay := *latency
+	if *jitter > 0 {
+		delay += time.Duration(s.rand.Int63n(int64(*jitter)))
+	}
+	injected := s.rand.Float64() < *rate429
+	s.Unlock()
+	time.Sleep(delay)
+
+	limit := s.characterLimit
+	if strings.HasPrefix(r.URL.Path, "/ladders/") {
+		limit = s.ladderLimit
+	}
+	if !limit.take(w, injected) {
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *server) handleLadder(w http.ResponseWriter, r *http.Request) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	count, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || count <= 0 {
+		count = 20
+	}
+
+	entries := s.entries
+	if account := r.URL.Query().Get("accountName"); len(account) > 0 {
+		entries = nil
+		for _, e := range s.entries {
+			if e.Account.Name == account {
+				entries = append(entries, e)
+			}
+		}
+	}
+
+	page := ladder.Ladder{
+		Total:       len(entries),
+		CachedSince: time.Now().UTC(),
+		Entries:     []ladder.Entry{},
+	}
+	if offset < len(entries) {
+		end := offset + count
+		if end > len(entries) {
+			end = len(entries)
+		}
+		page.Entries = entries[offset:end]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(page)
+}
+
+// handleCharacter serves the part of a character selected by the
+// provided function, injecting private and deleted characters.
+func (s *server) handleCharacter(
+	selectBody func(c character) []byte) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		account := r.FormValue("accountName")
+		name := r.FormValue("character")
+		key := characterKey(account, name)
+
+		c, ok := s.characters[key]
+		switch {
+		case !ok || failing(key, "404", *rate404):
+			writeError(w, http.StatusNotFound, 1, "Resource not found")
+			return
+		case failing(key, "403", *rate403) && !s.authenticated(r):
+			writeError(w, http.StatusForbidden, 6, "Forbidden")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(selectBody(c))
+	}
+}
+
+// handleCharacters lists every saved character of an account,
+// all in -league.
+func (s *server) handleCharacters(w http.ResponseWriter, r *http.Request) {
+	account := r.FormValue("accountName")
+	if failing(account, "403", *rate403) && !s.authenticated(r) {
+		writeError(w, http.StatusForbidden, 6, "Forbidden")
+		return
+	}
+
+	listed := []ladder.AccountCharacter{}
+	for _, e := range s.entries {
+		if e.Account.Name != account {
+			continue
+		}
+		listed = append(listed, ladder.AccountCharacter{
+			Name:       e.Character.Name,
+			League:     *league,
+			Class:      e.Character.Class,
+			Level:      e.Character.Level,
+			Experience: e.Character.Experience,
+		})
+	}
+	if len(listed) == 0 {
+		writeError(w, http.StatusNotFound, 1, "Resource not found")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(listed)
+}
+
+// authenticated returns true when the request carries -credential.
+func (s *server) authenticated(r *http.Request) bool {
+	if len(s.credential.SessionID) > 0 {
+		cookie, err := r.Cookie(remote.SessionCookieName)
+		return err == nil && cookie.Value == s.credential.SessionID
+	}
+	if len(s.credential.Token) > 0 {
+		return r.Header.Get("Authorization") == "Bearer "+s.credential.Token
+	}
+	return false
+}
+
+// failing returns true when the character, identified by key, is
+// selected for the provided kind of failure at the provided rate.
+//
+// Selection is stable for a given -seed.
+func failing(key, kind string, rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d-%s-%s", *seed, kind, key)
+	return float64(h.Sum64()%10000)/10000 < rate
+}
+
+// writeError writes an error body as GGG does.
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"error": {"code": %d, "message": %q}}`, code, message)
+}
+
+// parseRules parses windows formatted as in X-Rate-Limit-<rule>
+func parseRules(rules string) ([]remote.RateLimitWindow, error) {
+	h := http.Header{}
+	h.Set(remote.RateLimitRulesHeader, "Ip")
+	h.Set(remote.RateLimitHeaderPrefix+"Ip", rules)
+	state, err := remote.ParseRateLimitState(h)
+	if err != nil {
+		return nil, err
+	}
+	return state.Rules[0].Limits, nil
+}
+
+// limit tracks a single rate limit policy, with a single Ip rule,
+// shared by every client.
+type limit struct {
+	policy  string
+	windows []remote.RateLimitWindow
+
+	// hits are the times of every request within the longest window
+	hits []time.Time
+	// penalties are when the active penalty, if any, of each window ends
+	penalties []time.Time
+
+	sync.Mutex
+}
+
+func newLimit(policy string, windows []remote.RateLimitWindow) *limit {
+	return &limit{
+		policy:    policy,
+		windows:   windows,
+		penalties: make([]time.Time, len(windows)),
+	}
+}
+
+// take records a request, writing rate limit headers, and returns
+// true if it may proceed. Otherwise, a 429 has been written.
+//
+// Requests are always refused when injected is set.
+func (l *limit) take(w http.ResponseWriter, injected bool) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	l.hits = append(l.hits, now)
+
+	var longest time.Duration
+	var limited bool
+	state := make([]string, len(l.windows))
+	for i, window := range l.windows {
+		if window.Period > longest {
+			longest = window.Period
+		}
+
+		var hits int
+		for _, hit := range l.hits {
+			if now.Sub(hit) < window.Period {
+				hits++
+			}
+		}
+		if hits > window.Hits && now.After(l.penalties[i]) {
+			l.penalties[i] = now.Add(window.Penalty)
+		}
+
+		var penalty time.Duration
+		if l.penalties[i].After(now) {
+			penalty = l.penalties[i].Sub(now)
+			limited = true
+		}
+		state[i] = remote.RateLimitWindow{
+			Hits: hits, Period: window.Period, Penalty: penalty,
+		}.String()
+	}
+
+	// Forget hits no window cares about
+	for len(l.hits) > 0 && now.Sub(l.hits[0]) >= longest {
+		l.hits = l.hits[1:]
+	}
+
+	rules := make([]string, len(l.windows))
+	for i, window := range l.windows {
+		rules[i] = window.String()
+	}
+	w.Header().Set(remote.RateLimitPolicyHeader, l.policy)
+	w.Header().Set(remote.RateLimitRulesHeader, "Ip")
+	w.Header().Set(remote.RateLimitHeaderPrefix+"Ip", strings.Join(rules, ","))
+	w.Header().Set(remote.RateLimitHeaderPrefix+"Ip-State", strings.Join(state, ","))
+
+	if !limited && !injected {
+		return true
+	}
+	retryAfter := time.Second
+	for _, penalty := range l.penalties {
+		if until := penalty.Sub(now); until > retryAfter {
+			retryAfter = until
+		}
+	}
+	w.Header().Set(remote.RetryAfterHeader,
+		strconv.Itoa(int(retryAfter.Round(time.Second)/time.Second)))
+	writeError(w, http.StatusTooManyRequests, 3, "Rate limit exceeded")
+	return false
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/remote"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testServer returns a Client against a fake-ggg serving the golden
+// corpus, alongside a function to shut it down.
+//
+// The server is configured by the flags set when this is called.
+func testServer(t *testing.T) (*remote.Client, func()) {
+	*dir = "../../fixtures/golden"
+	s, err := newServer()
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(s)
+
+	c := remote.NewClient(remote.NewLimiters(time.Microsecond,
+		time.Millisecond, zap.NewNop()))
+	c.ItemsURL = httpServer.URL + "/character-window/get-items"
+	c.PassivesURL = httpServer.URL + "/character-window/get-passive-skills"
+	c.CharactersURL = httpServer.URL + "/character-window/get-characters"
+	c.LadderURL = httpServer.URL + "/ladders"
+	c.HTTP = httpServer.Client()
+	c.Retry = remote.Retry{}
+	return c, httpServer.Close
+}
+
+// resetFlags restores every flag the server reads to values which
+// never limit or fail a test.
+func resetFlags() {
+	*characterRules = "1000:1:1"
+	*ladderRules = "1000:1:1"
+	*rate429 = 0
+	*rate403 = 0
+	*rate404 = 0
+	*latency = 0
+	*jitter = 0
+	*seed = 1
+	*credential = ""
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	t.Run("rate limit headers", func(t *testing.T) {
+		resetFlags()
+		*characterRules = "45:60:60,240:240:900"
+		c, done := testServer(t)
+		defer done()
+
+		resp, err := c.HTTP.Get(c.PassivesURL +
+			"?accountName=golden-account&character=AbyssMarauder")
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		state, err := remote.ParseRateLimitState(resp.Header)
+		require.NoError(t, err)
+		require.Equal(t, "character-window-request-limit", state.Policy)
+		require.Equal(t, 1, state.Current)
+		require.Equal(t, 45, state.Max)
+		require.Len(t, state.Rules, 1)
+		require.Equal(t, []remote.RateLimitWindow{
+			{Hits: 45, Period: time.Minute, Penalty: time.Minute},
+			{Hits: 240, Period: 4 * time.Minute, Penalty: 15 * time.Minute},
+		}, state.Rules[0].Limits)
+	})
+
+	t.Run("injected 429", func(t *testing.T) {
+		resetFlags()
+		*rate429 = 1
+		c, done := testServer(t)
+		defer done()
+
+		_, err := c.FetchCharacter(ctx, logger, "golden-account", "AbyssMarauder")
+		require.IsType(t, remote.ErrRateLimited{}, errors.Cause(err))
+	})
+
+	t.Run("missing character", func(t *testing.T) {
+		resetFlags()
+		c, done := testServer(t)
+		defer done()
+
+		_, err := c.FetchCharacter(ctx, logger, "golden-account", "NotACharacter")
+		require.Equal(t, remote.ErrNotFound, errors.Cause(err))
+	})
+
+	t.Run("private profiles stable for seed", func(t *testing.T) {
+		private := func() ([]string, int) {
+			resetFlags()
+			*rate403 = 0.5
+			*seed = 7
+			c, done := testServer(t)
+			defer done()
+
+			var names []string
+			l := listLadder(t, c, 100)
+			for _, e := range l.Entries {
+				_, err := c.FetchCharacter(ctx, logger,
+					e.Account.Name, e.Character.Name)
+				if errors.Cause(err) == remote.ErrPrivateProfile {
+					names = append(names, e.Character.Name)
+					continue
+				}
+				require.NoError(t, err)
+			}
+			return names, l.Total
+		}
+
+		first, total := private()
+		require.NotEmpty(t, first)
+		require.True(t, len(first) < total, "only some of the corpus is private")
+		second, _ := private()
+		require.Equal(t, first, second)
+	})
+
+	t.Run("private profiles readable with credential", func(t *testing.T) {
+		resetFlags()
+		*rate403 = 1
+		*credential = "POESESSID=some-session"
+		c, done := testServer(t)
+		defer done()
+
+		_, err := c.FetchCharacter(ctx, logger, "golden-account", "AbyssMarauder")
+		require.Equal(t, remote.ErrPrivateProfile, errors.Cause(err))
+
+		c.Credential = remote.Credential{SessionID: "other-session"}
+		_, err = c.FetchCharacter(ctx, logger, "golden-account", "AbyssMarauder")
+		require.Equal(t, remote.ErrPrivateProfile, errors.Cause(err))
+
+		c.Credential = remote.Credential{SessionID: "some-session"}
+		_, err = c.FetchCharacter(ctx, logger, "golden-account", "AbyssMarauder")
+		require.NoError(t, err)
+		_, err = c.FetchCharacters(ctx, logger, "golden-account")
+		require.NoError(t, err)
+	})
+
+	t.Run("ladder paging", func(t *testing.T) {
+		resetFlags()
+		c, done := testServer(t)
+		defer done()
+
+		all := listLadder(t, c, 100)
+		require.Len(t, all.Entries, all.Total)
+
+		var paged []ladder.Entry
+		for offset := 0; offset < all.Total; offset += 4 {
+			blob, err := c.FetchLadder(ctx, logger,
+				ladder.PageCursor{Limit: 4, Offset: offset},
+				"some-ladder", remote.LadderOptions{})
+			require.NoError(t, err)
+			page, err := ladder.ReadLadder(bytes.NewReader(blob))
+			require.NoError(t, err)
+			require.Equal(t, all.Total, page.Total)
+			require.True(t, len(page.Entries) <= 4)
+			paged = append(paged, page.Entries...)
+		}
+		require.Equal(t, all.Entries, paged)
+	})
+}
+
+// listLadder returns the first page of the ladder, up to limit entries.
+func listLadder(t *testing.T, c *remote.Client, limit int) ladder.Ladder {
+	blob, err := c.FetchLadder(context.Background(), zap.NewNop(),
+		ladder.PageCursor{Limit: limit}, "some-ladder", remote.LadderOptions{})
+	require.NoError(t, err)
+	l, err := ladder.ReadLadder(bytes.NewReader(blob))
+	require.NoError(t, err)
+	return l
+}
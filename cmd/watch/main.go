@@ -1,17 +1,18 @@
 package main
 
 import (
-	"bytes"
-	"encoding/csv"
+	"context"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/Everlag/slippery-policy/items"
 	"github.com/Everlag/slippery-policy/ladder"
-	"github.com/Everlag/slippery-policy/passives"
-	"github.com/Everlag/slippery-policy/pob"
+	"github.com/Everlag/slippery-policy/policy"
 	"github.com/Everlag/slippery-policy/remote"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -19,12 +20,43 @@ import (
 )
 
 var ladderPageSize = flag.Int("ladder_page_size", 5, "how many characters to process between ladder refreshes")
+var workers = flag.Int("workers", 4, "how many characters to check concurrently; throughput is still bounded by rate limiting")
+var queueSize = flag.Int("queue_size", 20, "how many ladder entries may wait to be checked before ladder fetching pauses")
 var ladderName = flag.String("ladder", "Slippery Hobo League (PL5357)", "which ladder to use")
+var ladderType = flag.String("ladder_type", "",
+	fmt.Sprintf("which type of ladder to use, %s or %s; defaults to %s",
+		remote.LadderTypeLeague, remote.LadderTypeLabyrinth, remote.LadderTypeLeague))
+var ladderDifficulty = flag.String("ladder_difficulty", "",
+	fmt.Sprintf("difficulty of the labyrinth ladder, one of %v", remote.LabyrinthDifficulties))
+var ladderStart = flag.String("ladder_start", "", "day of the labyrinth race, as YYYY-MM-DD in UTC")
+var ladderSort = flag.String("ladder_sort", "",
+	fmt.Sprintf("how the ladder is sorted, ie %s or %s for delve depth; defaults to experience",
+		remote.LadderSortDepth, remote.LadderSortDepthSolo))
+var ladderTrack = flag.Bool("ladder_track", false, "if dead characters should be included in the ladder")
+var ladderAccount = flag.String("ladder_account", "", "only use characters of this account on the ladder")
 var outputFile = flag.String("o", "policy_failures.%s.csv", "output file")
+var policyName = flag.String("policy", policy.GucciHoboName,
+	fmt.Sprintf("which policy to enforce, one of %v", policy.BuiltinNames()))
+var rulesFile = flag.String("rules", "", "policy file(json or yaml) to enforce instead of -policy")
+
+// Allow pointing at a mirror or local stand-in of the GGG API.
+var itemsURL = flag.String("items_url", remote.GetItemsURL, "get-items endpoint")
+var passivesURL = flag.String("passives_url", remote.GetPassivesURL, "get-passive-skills endpoint")
+var charactersURL = flag.String("characters_url", remote.GetCharactersURL, "get-characters endpoint")
+var ladderURL = flag.String("ladder_url", remote.GetLadderURL, "ladder endpoint, the ladder name is appended")
+var userAgent = flag.String("user_agent", remote.DefaultUserAgent, "user agent sent with every request")
+var credentialFile = flag.String("credential_file", "",
+	fmt.Sprintf("file holding %s=<session id> or Bearer <token>, to read private profiles which granted access; defaults to $%s",
+		remote.SessionCookieName, remote.CredentialEnv))
+
+// Allow reproducing a run offline.
+var recordDir = flag.String("record", "", "directory to record every request and response to")
+var replayDir = flag.String("replay", "", "directory of recorded requests and responses to serve instead of the remote")
 
 // Allow disabling specific portions of enforcement. This is primarily
 // aimed at isolating specific components for validation against real servers.
 var doEnforceItems = flag.Bool("items", true, "if character equipment should be enforced")
+var accountCharacters = flag.Bool("account_characters", false, "if every league character of accounts on the ladder should be enforced, rather than only those on the ladder")
 var doEnforcePassives = flag.Bool("passives", true, "if character passives should be enforced(this includes socketed jewels)")
 
 func main() {
@@ -38,246 +70,171 @@ func main() {
 	logger = logger.With(zap.String("ladder", *ladderName))
 	logger.Debug("booting up")
 
-	config := enforceConfig{
-		Ladder: *ladderName,
-		LadderLimiter: remote.NewLimiter(time.Millisecond*5000, time.Second*2,
-			5, logger.With(zap.String("limiter", "ladder"))),
-		CharLimiter: remote.NewLimiter(time.Millisecond*1500, time.Second*2,
-			5, logger.With(zap.String("limiter", "character"))),
-
-		// Make a best-effort attempt at deduplicating output.
-		// We only care about the first violation a Character had
-		//
-		// We don't want to report any characters we've seen already.
-		Seen: make(map[string]struct{}, 200),
-	}
-	coreLoop(*ladderPageSize, logger, config)
-}
-
-func getLogger() (*zap.Logger, error) {
-	config := zap.NewProductionConfig()
-	// Print output and also send to a file.
-	config.OutputPaths = []string{"stdout", "watch.log"}
-	config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
-	return config.Build()
-}
-
-func coreLoop(pageSize int,
-	logger *zap.Logger,
-	config enforceConfig) error {
-
-	out := fmt.Sprintf(*outputFile, *ladderName)
-	output, err := os.OpenFile(out, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	p, err := selectPolicy()
 	if err != nil {
-		return errors.Wrapf(err, "opening output file: %s", out)
+		// Print directly as this is likely a mistake in a rules
+		// file that needs to be fixed before we can start.
+		fmt.Println(err)
+		os.Exit(1)
 	}
-	defer output.Close()
+	logger.Info("enforcing policy", zap.String("policy", p.Name))
 
-	writer := csv.NewWriter(output)
-
-	// Check if this is a new file we should
-	// write a header for
-	stats, err := output.Stat()
+	ladderOptions, err := selectLadderOptions()
 	if err != nil {
-		return errors.Wrap(err, "statting output file")
-	}
-	if stats.Size() == 0 {
-		writer.Write(items.PolicyFailureCSVHeader())
-		writer.Flush()
+		fmt.Println(err)
+		os.Exit(1)
 	}
-
-	for {
-		ladderCursor := ladder.PageCursor{
-			Limit:  pageSize,
-			Offset: 0,
-		}
-
-		logger.Info("starting from top of ladder")
-
-		// Iterate over the entire ladder
-		hadFullPage := true
-		for i := 0; hadFullPage; i++ {
-			logger := logger.With(zap.String("cursor", ladderCursor.String()))
-
-			failures, foundCount, err := enforce(logger,
-				ladderCursor,
-				config)
-			if err != nil {
-				// Ignore failed pages; everything after this
-				// effectively NOPs.
-				//
-				// However, we CANNOT continue here as the cursor
-				// management needs to happen :|
-				logger.Error("failed enforcing against ladder page",
-					zap.Error(err))
-			}
-
-			for _, f := range failures {
-				seenKey := seenKey(f.CharacterName, f.AccountName)
-				if _, ok := config.Seen[seenKey]; ok {
-					continue
-				}
-
-				err := writer.Write(f.ToCSVRecord())
-				if err != nil {
-					logger.Error("failed writing CSV line",
-						zap.Error(err))
-				}
-
-				config.Seen[seenKey] = struct{}{}
-			}
-			// Ensure this hits the disk
-			writer.Flush()
-			if writer.Error() != nil {
-				logger.Error("failed flushing CSV lines",
-					zap.Error(err))
-				return errors.Wrap(err, "flushing CSV")
-			}
-
-			// Manage our cursor and be able to wrap.
-			hadFullPage = foundCount >= pageSize
-			ladderCursor.Offset += pageSize
-		}
-
+	if err := checkPipelineFlags(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
 
-}
-
-type enforceConfig struct {
-	Ladder        string
-	LadderLimiter *remote.Limiter
-
-	CharLimiter *remote.Limiter
-
-	// Keep track of the Character's we've failed on
-	Seen map[string]struct{}
-}
-
-func seenKey(character, account string) string {
-	return fmt.Sprintf("%s-%s", account, character)
-}
-
-func enforce(logger *zap.Logger,
-	ladderCursor ladder.PageCursor,
-	config enforceConfig) ([]items.PolicyFailure, int, error) {
-
-	ladderBuf, err := remote.FetchLadder(logger,
-		config.LadderLimiter, ladderCursor, config.Ladder)
+	// Limiters are paced by GGG's rate limit policies once
+	// discovered, until then we are conservative.
+	client := remote.NewClient(remote.NewLimiters(time.Millisecond*5000,
+		time.Second*2, logger.With(zap.String("limiter", "remote"))))
+	client.ItemsURL = *itemsURL
+	client.PassivesURL = *passivesURL
+	client.CharactersURL = *charactersURL
+	client.LadderURL = *ladderURL
+	client.UserAgent = *userAgent
+	client.HTTP, err = selectHTTP()
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "fetching ladder page %s", ladderCursor)
+		fmt.Println(err)
+		os.Exit(1)
 	}
-
-	l, err := ladder.ReadLadder(bytes.NewReader(ladderBuf))
+	client.Credential, err = remote.LoadCredential(*credentialFile)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "decoding ladder page %s", ladderCursor)
+		fmt.Println(errors.Wrap(err, "loading credential"))
+		os.Exit(1)
+	}
+	if !client.Credential.IsZero() {
+		logger.Info("authenticating requests",
+			zap.Stringer("credential", client.Credential))
 	}
 
-	now := time.Now()
-	var failures []items.PolicyFailure
-	for _, c := range l.ActiveCharacters() {
-		logger := logger.With(
-			zap.String("account", c.Account.Name),
-			zap.String("character", c.Character.Name),
-		)
-		logger.Debug("checking")
-
-		if *doEnforceItems {
-			itemsFailed, err := enforceItems(logger, now, c, config)
-			if err != nil {
-				logger.Info("failed enforcing item constraints",
-					zap.Error(err))
-				continue
-			}
-			failures = append(failures, itemsFailed...)
-		}
+	config := enforceConfig{
+		Ladder:        *ladderName,
+		LadderOptions: ladderOptions,
+		Policy:        p,
+		Client:        client,
 
-		if *doEnforcePassives {
-			passivesFailed, err := enforcePassives(logger, now, c, config)
-			if err != nil {
-				logger.Info("failed enforcing passives constraints",
-					zap.Error(err))
-				continue
-			}
-			failures = append(failures, passivesFailed...)
-		}
+		// Make a best-effort attempt at deduplicating output.
+		// We only care about the first pass a Character violated
+		// the policy on
+		//
+		// We don't want to report any violations we've seen already.
+		Seen: make(map[string]struct{}, 200),
 	}
 
-	// Include ALL characters here, including dead
-	return failures, len(l.Entries), nil
-}
-
-func enforceItems(logger *zap.Logger,
-	now time.Time,
-	c ladder.Entry, config enforceConfig) ([]items.PolicyFailure, error) {
+	// Stop cleanly, even mid-page, when interrupted
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-interrupt
+		logger.Info("stopping", zap.String("signal", sig.String()))
+		cancel()
+	}()
 
-	var failures []items.PolicyFailure
-	buf, err := remote.FetchCharacter(logger,
-		config.CharLimiter, c.Account.Name, c.Character.Name)
+	err = coreLoop(ctx, *ladderPageSize, logger, config)
+	client.Limiters.Halt()
 	if err != nil {
-		if errors.Cause(err) == remote.ErrPrivateProfile {
-			// TODO: deduplicate if possible
-			failures = append(failures, items.PolicyFailure{
-				Reason:        items.PolicyFailureReasonPrivateProfile,
-				AccountName:   c.Account.Name,
-				CharacterName: c.Character.Name,
-				When:          now,
-			})
-			return failures, nil
-		}
-		return failures, errors.Wrap(err, "finding character; may have been deleted")
+		logger.Error("exiting", zap.Error(err))
+		os.Exit(1)
 	}
+}
 
-	resp, err := items.ReadGetItemResp(bytes.NewReader(buf))
-	if err != nil {
-		return failures, errors.Wrap(err, "decoding character; api may have changed in a way that breaks compatibility")
+// selectPolicy returns the Policy specified by -rules, if present, and
+// otherwise the built-in Policy specified by -policy.
+func selectPolicy() (policy.Policy, error) {
+	if len(*rulesFile) > 0 {
+		return policy.LoadFile(*rulesFile)
 	}
+	return policy.Builtin(*policyName)
+}
 
-	f := resp.EnforceGucciHobo(now, c.Account.Name)
-	if len(f) == 0 {
-		return failures, nil
-	}
-	code, err := pob.GetItemRespToCode(*resp)
-	if err != nil {
-		logger.Warn("failed converting GetItemsResp to PoB code, skipping",
-			zap.Error(err))
-	}
-	for i, fail := range f {
-		fail.PoB = code
-		f[i] = fail
+// selectLadderOptions returns the LadderOptions specified by the
+// -ladder_* flags, if they select a ladder the endpoint serves.
+func selectLadderOptions() (remote.LadderOptions, error) {
+	opts := remote.LadderOptions{
+		Type:        *ladderType,
+		Sort:        *ladderSort,
+		Track:       *ladderTrack,
+		AccountName: *ladderAccount,
+		Difficulty:  *ladderDifficulty,
+	}
+	if len(*ladderStart) > 0 {
+		start, err := time.Parse("2006-01-02", *ladderStart)
+		if err != nil {
+			return opts, errors.Wrap(err, "parsing -ladder_start")
+		}
+		opts.Start = start
 	}
-
-	failures = append(failures, f...)
-	return failures, nil
+	return opts, errors.Wrap(opts.Validate(), "invalid -ladder_* flags")
 }
 
-func enforcePassives(logger *zap.Logger, now time.Time,
-	c ladder.Entry, config enforceConfig) ([]items.PolicyFailure, error) {
+// checkPipelineFlags returns an error if the flags sizing the
+// pipeline would leave it unable to make progress.
+func checkPipelineFlags() error {
+	switch {
+	case *workers <= 0:
+		return errors.Errorf("-workers must be positive, not %d", *workers)
+	case *queueSize < 0:
+		return errors.Errorf("-queue_size must not be negative, not %d", *queueSize)
+	case *ladderPageSize <= 0:
+		return errors.Errorf("-ladder_page_size must be positive, not %d", *ladderPageSize)
+	}
+	return nil
+}
 
-	var failures []items.PolicyFailure
-	buf, err := remote.FetchPassives(logger, c.Account.Name, c.Character.Name)
-	if err != nil {
-		if errors.Cause(err) == remote.ErrPrivateProfile {
-			failures = append(failures, items.PolicyFailure{
-				Reason:        items.PolicyFailureReasonPrivateProfile,
-				AccountName:   c.Account.Name,
-				CharacterName: c.Character.Name,
-				When:          now,
-			})
-			return failures, nil
+// selectHTTP returns the http.Client requests are made with, which
+// records or replays when -record or -replay are specified.
+func selectHTTP() (*http.Client, error) {
+	switch {
+	case len(*recordDir) > 0 && len(*replayDir) > 0:
+		return nil, errors.New("only one of -record and -replay may be specified")
+	case len(*recordDir) > 0:
+		recorder, err := remote.NewRecorder(*recordDir, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "starting recording")
+		}
+		return &http.Client{Transport: recorder}, nil
+	case len(*replayDir) > 0:
+		replayer, err := remote.NewReplayer(*replayDir)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading replay")
 		}
-		return failures, errors.Wrap(err, "finding character; may have been deleted")
+		return &http.Client{Transport: replayer}, nil
 	}
+	return http.DefaultClient, nil
+}
 
-	resp, err := passives.ReadPassives(bytes.NewReader(buf))
-	if err != nil {
-		return failures, errors.Wrap(err, "decoding character; api may have changed in a way that breaks compatibility")
+func getLogger() (*zap.Logger, error) {
+	config := zap.NewProductionConfig()
+	// Print output and also send to a file.
+	config.OutputPaths = []string{"stdout", "watch.log"}
+	config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
+	return config.Build()
+}
+
+// describeFetchErr adds what a failed fetch of a character
+// likely means to its error.
+func describeFetchErr(err error) error {
+	if errors.Cause(err) == remote.ErrNotFound {
+		return errors.Wrap(err, "finding character; may have been deleted")
 	}
+	return errors.Wrap(err, "fetching character")
+}
 
-	f := resp.EnforceGucciHobo(now,
-		c.Account.Name, c.Character.Level, c.Character.Name)
-	if len(f) == 0 {
-		return failures, nil
+// privateProfileFailure returns the PolicyFailure of a Character whose
+// profile could not be read; its Severity is left to the Policy.
+func privateProfileFailure(now time.Time, c ladder.Entry) items.PolicyFailure {
+	return items.PolicyFailure{
+		Reason:        items.PolicyFailureReasonPrivateProfile,
+		RuleID:        items.RuleIDPrivateProfile,
+		AccountName:   c.Account.Name,
+		CharacterName: c.Character.Name,
+		When:          now,
 	}
-	failures = append(failures, f...)
-	return failures, nil
 }
@@ -0,0 +1,465 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/pob"
+	"github.com/Everlag/slippery-policy/policy"
+	"github.com/Everlag/slippery-policy/remote"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// coreLoop enforces the policy against the ladder, from the top, until
+// the context is done.
+//
+// This is a pipeline; the ladder is fetched by a single producer into a
+// bounded queue of entries, which -workers check concurrently, sending
+// their failures to a single sink. Every stage blocks on the next, so
+// a slow sink or rate limiting backs up to the ladder rather than
+// accumulating in memory.
+func coreLoop(ctx context.Context, pageSize int,
+	logger *zap.Logger,
+	config enforceConfig) error {
+
+	out := fmt.Sprintf(*outputFile, *ladderName)
+	sink, err := newCSVSink(out, logger)
+	if err != nil {
+		return err
+	}
+	defer sink.Close()
+
+	// Stop every stage when the sink fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	entries := make(chan ladder.Entry, *queueSize)
+	results := make(chan []items.PolicyFailure, *workers)
+
+	go produceLadder(ctx, pageSize, logger, config, entries)
+
+	var wg sync.WaitGroup
+	for i := 0; i < *workers; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			logger := logger.With(zap.Int("worker", worker))
+			for c := range entries {
+				// Drain queued entries once stopped
+				if ctx.Err() != nil {
+					continue
+				}
+				failures := check(ctx, logger, c, config)
+				if len(failures) == 0 {
+					continue
+				}
+				results <- failures
+			}
+		}(i)
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var sinkErr error
+	for failures := range results {
+		// Drain results so workers can exit once the sink has failed
+		if sinkErr != nil {
+			continue
+		}
+		if err := config.report(sink, failures); err != nil {
+			logger.Error("failed writing failures", zap.Error(err))
+			sinkErr = err
+			cancel()
+		}
+	}
+
+	return sinkErr
+}
+
+// produceLadder sends every active entry of the ladder, from the top,
+// to the provided channel until the context is done; it then closes
+// the channel.
+//
+// With -account_characters, every league character of each account
+// on the ladder is also sent, even if not on the ladder itself.
+//
+// Pages are only fetched as the channel has room for their entries.
+func produceLadder(ctx context.Context, pageSize int,
+	logger *zap.Logger, config enforceConfig,
+	entries chan<- ladder.Entry) {
+
+	defer close(entries)
+
+	for ctx.Err() == nil {
+		ladderCursor := ladder.PageCursor{
+			Limit:  pageSize,
+			Offset: 0,
+		}
+
+		logger.Info("starting from top of ladder")
+
+		// Characters are only checked once per pass, even if both
+		// listed on an account and on the ladder.
+		queued := make(map[string]struct{})
+		listed := make(map[string]struct{})
+		queue := func(c ladder.Entry) bool {
+			key := seenKey(c.Character.Name, c.Account.Name)
+			if _, ok := queued[key]; ok {
+				return true
+			}
+			queued[key] = struct{}{}
+
+			select {
+			case entries <- c:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// Iterate over the entire ladder
+		hadFullPage := true
+		for hadFullPage && ctx.Err() == nil {
+			logger := logger.With(zap.String("cursor", ladderCursor.String()))
+
+			l, err := fetchLadder(ctx, logger, ladderCursor, config)
+			if err != nil {
+				// Failed pages restart from the top of the ladder
+				logger.Error("failed fetching ladder page",
+					zap.Error(err))
+			}
+
+			for _, c := range l.ActiveCharacters() {
+				if !queue(c) {
+					return
+				}
+			}
+
+			if *accountCharacters {
+				for _, c := range l.Entries {
+					if _, ok := listed[c.Account.Name]; ok {
+						continue
+					}
+					listed[c.Account.Name] = struct{}{}
+
+					characters, err := fetchAccountCharacters(ctx, logger,
+						c.Account.Name, config)
+					if err != nil {
+						logger.Info("failed listing account characters",
+							zap.String("account", c.Account.Name),
+							zap.Error(err))
+						continue
+					}
+					for _, character := range characters {
+						if !queue(character) {
+							return
+						}
+					}
+				}
+			}
+
+			// Manage our cursor and be able to wrap.
+			//
+			// Include ALL characters here, including dead
+			hadFullPage = len(l.Entries) >= pageSize
+			ladderCursor.Offset += pageSize
+		}
+	}
+}
+
+type enforceConfig struct {
+	Ladder        string
+	LadderOptions remote.LadderOptions
+	Policy        policy.Policy
+	Client        *remote.Client
+
+	// Keep track of the Character's we've failed on, and of the
+	// failures we've written for those yet to commit a violation
+	//
+	// This is only accessed by the sink.
+	Seen map[string]struct{}
+}
+
+func seenKey(character, account string) string {
+	return fmt.Sprintf("%s-%s", account, character)
+}
+
+// seenFailureKey identifies a kind of PolicyFailure of a Character.
+func seenFailureKey(f items.PolicyFailure) string {
+	return fmt.Sprintf("%s-%s-%s",
+		seenKey(f.CharacterName, f.AccountName), f.RuleID, f.Severity)
+}
+
+// report writes every failure a Character has until we see them
+// commit a violation, but not on later passes.
+//
+// Before then, each rule is only written once per severity so
+// warnings are neither repeated every pass nor hide a later violation.
+//
+// The failures must all be for the same Character.
+func (config enforceConfig) report(sink failureSink,
+	failures []items.PolicyFailure) error {
+
+	seenKey := seenKey(failures[0].CharacterName, failures[0].AccountName)
+	if _, ok := config.Seen[seenKey]; ok {
+		return nil
+	}
+
+	var unseen []items.PolicyFailure
+	violated := false
+	for _, f := range failures {
+		if _, ok := config.Seen[seenFailureKey(f)]; ok {
+			continue
+		}
+		unseen = append(unseen, f)
+		violated = violated || f.Severity == items.SeverityViolation
+	}
+	if len(unseen) == 0 {
+		return nil
+	}
+	if err := sink.Write(unseen); err != nil {
+		return err
+	}
+
+	for _, f := range unseen {
+		config.Seen[seenFailureKey(f)] = struct{}{}
+	}
+	if violated {
+		config.Seen[seenKey] = struct{}{}
+	}
+	return nil
+}
+
+// failureSink is where the failures of each Character are written.
+type failureSink interface {
+	Write(failures []items.PolicyFailure) error
+	Close() error
+}
+
+// csvSink appends failures to a CSV file.
+type csvSink struct {
+	output *os.File
+	writer *csv.Writer
+	logger *zap.Logger
+}
+
+// newCSVSink opens the provided CSV file for appending, writing
+// a header when it is new.
+func newCSVSink(out string, logger *zap.Logger) (*csvSink, error) {
+	output, err := os.OpenFile(out, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening output file: %s", out)
+	}
+	sink := &csvSink{
+		output: output,
+		writer: csv.NewWriter(output),
+		logger: logger,
+	}
+
+	// Check if this is a new file we should
+	// write a header for
+	stats, err := output.Stat()
+	if err != nil {
+		output.Close()
+		return nil, errors.Wrap(err, "statting output file")
+	}
+	if stats.Size() == 0 {
+		sink.writer.Write(items.PolicyFailureCSVHeader())
+		sink.writer.Flush()
+		return sink, nil
+	}
+
+	// Appending rows to a file with other columns would make
+	// the whole file unreadable
+	if err := checkCSVHeader(out); err != nil {
+		output.Close()
+		return nil, err
+	}
+	return sink, nil
+}
+
+// checkCSVHeader returns an error if the first record of the CSV file
+// is not the current PolicyFailureCSVHeader.
+func checkCSVHeader(out string) error {
+	f, err := os.Open(out)
+	if err != nil {
+		return errors.Wrapf(err, "opening output file: %s", out)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return errors.Wrapf(err, "reading header of output file: %s", out)
+	}
+
+	expected := items.PolicyFailureCSVHeader()
+	if strings.Join(header, ",") != strings.Join(expected, ",") {
+		return errors.Errorf(
+			"output file %s has columns %v rather than %v; move it aside or choose another with -o",
+			out, header, expected)
+	}
+	return nil
+}
+
+// Write implements failureSink
+func (s *csvSink) Write(failures []items.PolicyFailure) error {
+	for _, f := range failures {
+		record, err := f.ToCSVRecord()
+		if err != nil {
+			// Keep the failure, without what could not be encoded
+			s.logger.Error("dropping evidence of failure",
+				zap.String("account", f.AccountName),
+				zap.String("character", f.CharacterName),
+				zap.Error(err))
+			f.Evidence = nil
+			record, err = f.ToCSVRecord()
+			if err != nil {
+				return errors.Wrap(err, "formatting failure")
+			}
+		}
+		s.writer.Write(record)
+	}
+	// Ensure this hits the disk
+	s.writer.Flush()
+	return errors.Wrap(s.writer.Error(), "flushing CSV")
+}
+
+// Close implements failureSink
+func (s *csvSink) Close() error {
+	return s.output.Close()
+}
+
+var _ failureSink = &csvSink{}
+
+// fetchLadder returns the ladder page at the provided cursor.
+func fetchLadder(ctx context.Context, logger *zap.Logger,
+	ladderCursor ladder.PageCursor, config enforceConfig) (ladder.Ladder, error) {
+
+	ladderBuf, err := config.Client.FetchLadder(ctx, logger,
+		ladderCursor, config.Ladder, config.LadderOptions)
+	if err != nil {
+		return ladder.Ladder{}, errors.Wrapf(err, "fetching ladder page %s", ladderCursor)
+	}
+
+	l, err := ladder.ReadLadder(bytes.NewReader(ladderBuf))
+	if err != nil {
+		return ladder.Ladder{}, errors.Wrapf(err, "decoding ladder page %s", ladderCursor)
+	}
+	return l, nil
+}
+
+// fetchAccountCharacters returns an Entry for every character the
+// account has in the league of our ladder.
+func fetchAccountCharacters(ctx context.Context, logger *zap.Logger,
+	accountName string, config enforceConfig) ([]ladder.Entry, error) {
+
+	buf, err := config.Client.FetchCharacters(ctx, logger, accountName)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching account characters")
+	}
+
+	characters, err := ladder.ReadAccountCharacters(bytes.NewReader(buf))
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding account characters")
+	}
+
+	var entries []ladder.Entry
+	for _, c := range characters.InLeague(config.Ladder) {
+		entries = append(entries, c.Entry(accountName))
+	}
+	return entries, nil
+}
+
+// check returns every PolicyFailure the provided Character has.
+//
+// Failures fetching the Character are logged; whatever could be
+// fetched is still checked.
+func check(ctx context.Context, logger *zap.Logger,
+	c ladder.Entry, config enforceConfig) []items.PolicyFailure {
+
+	logger = logger.With(
+		zap.String("account", c.Account.Name),
+		zap.String("character", c.Character.Name),
+	)
+	logger.Debug("checking")
+
+	// Avoid spending our character budget on anyone who
+	// already fails based on the ladder alone.
+	ladderSnapshot := policy.CharacterSnapshot{
+		Entry: c,
+		When:  time.Now(),
+	}
+	if f := config.Policy.CheckLadder(ladderSnapshot); len(f) > 0 {
+		logger.Debug("failed from ladder, skipping fetch")
+		return f
+	}
+
+	snapshot := characterSnapshot(c, time.Now(),
+		config.Client.FetchSnapshot(ctx, logger,
+			c.Account.Name, c.Character.Name,
+			remote.SnapshotOptions{
+				Items:    *doEnforceItems,
+				Passives: *doEnforcePassives,
+			}))
+	if snapshot.Private {
+		return config.Policy.Grade(snapshot, []items.PolicyFailure{
+			privateProfileFailure(snapshot.When, c),
+		})
+	}
+	if snapshot.ItemsErr != nil {
+		logger.Info("failed enforcing item constraints",
+			zap.Error(describeFetchErr(snapshot.ItemsErr)))
+		return nil
+	}
+	if snapshot.PassivesErr != nil {
+		// Still enforce against the items we did manage to fetch
+		logger.Info("failed enforcing passives constraints",
+			zap.Error(describeFetchErr(snapshot.PassivesErr)))
+	}
+
+	f := config.Policy.Check(snapshot)
+	if len(f) == 0 || snapshot.Items == nil {
+		return f
+	}
+	code, err := pob.GetItemRespToCode(*snapshot.Items)
+	if err != nil {
+		logger.Warn("failed converting GetItemsResp to PoB code, skipping",
+			zap.Error(err))
+	}
+	for i, fail := range f {
+		fail.PoB = code
+		f[i] = fail
+	}
+	return f
+}
+
+// characterSnapshot returns the CharacterSnapshot of the ladder Entry
+// made up of what was fetched.
+func characterSnapshot(entry ladder.Entry, when time.Time,
+	fetched remote.Snapshot) policy.CharacterSnapshot {
+
+	return policy.CharacterSnapshot{
+		Entry:           entry,
+		Items:           fetched.Items,
+		Passives:        fetched.Passives,
+		When:            when,
+		ItemsFetched:    fetched.ItemsFetched,
+		PassivesFetched: fetched.PassivesFetched,
+		ItemsErr:        fetched.ItemsErr,
+		PassivesErr:     fetched.PassivesErr,
+		Private:         fetched.Private,
+	}
+}
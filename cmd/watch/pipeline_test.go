@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/policy"
+	"github.com/Everlag/slippery-policy/remote"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// memorySink keeps every failure written to it.
+type memorySink struct {
+	written []items.PolicyFailure
+}
+
+func (s *memorySink) Write(failures []items.PolicyFailure) error {
+	s.written = append(s.written, failures...)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
+
+func fixtureFailure(ruleID string, severity items.Severity) items.PolicyFailure {
+	return items.PolicyFailure{
+		RuleID:        ruleID,
+		Severity:      severity,
+		AccountName:   "some-account",
+		CharacterName: "some-character",
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Run("violation after warning", func(t *testing.T) {
+		config := enforceConfig{Seen: make(map[string]struct{})}
+		sink := &memorySink{}
+
+		warning := fixtureFailure("unknown-passives", items.SeverityWarning)
+		require.NoError(t, config.report(sink, []items.PolicyFailure{warning}))
+		require.Equal(t, []items.PolicyFailure{warning}, sink.written)
+
+		// The same warning on a later pass is not written again
+		require.NoError(t, config.report(sink, []items.PolicyFailure{warning}))
+		require.Len(t, sink.written, 1)
+
+		// A later violation still is
+		violation := fixtureFailure("banned-unique", items.SeverityViolation)
+		require.NoError(t, config.report(sink,
+			[]items.PolicyFailure{warning, violation}))
+		require.Equal(t, []items.PolicyFailure{warning, violation}, sink.written)
+	})
+
+	t.Run("nothing after violation", func(t *testing.T) {
+		config := enforceConfig{Seen: make(map[string]struct{})}
+		sink := &memorySink{}
+
+		violation := fixtureFailure("banned-unique", items.SeverityViolation)
+		require.NoError(t, config.report(sink, []items.PolicyFailure{violation}))
+
+		other := fixtureFailure("banned-gem", items.SeverityViolation)
+		warning := fixtureFailure("unknown-passives", items.SeverityWarning)
+		require.NoError(t, config.report(sink,
+			[]items.PolicyFailure{other, warning}))
+		require.Equal(t, []items.PolicyFailure{violation}, sink.written)
+	})
+}
+
+// ladderServer serves a ladder of the provided entries, and the same
+// items for each of them, counting the items requests of every
+// character.
+//
+// The ladder blocks once fetched from the top a second time, so only
+// a single pass is ever made.
+type ladderServer struct {
+	entries []ladder.Entry
+	items   []byte
+
+	mu     sync.Mutex
+	passes int
+	// fetched counts the items requests of each character
+	fetched map[string]int
+}
+
+func (s *ladderServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Never limit the test
+	w.Header().Set(remote.RateLimitRulesHeader, "Ip")
+	w.Header().Set(remote.RateLimitHeaderPrefix+"Ip", "1000:1:1")
+	w.Header().Set(remote.RateLimitHeaderPrefix+"Ip-State", "1:1:0")
+
+	if r.URL.Path == "/character-window/get-items" {
+		s.mu.Lock()
+		s.fetched[r.FormValue("character")]++
+		s.mu.Unlock()
+		w.Write(s.items)
+		return
+	}
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if offset == 0 {
+		s.mu.Lock()
+		s.passes++
+		passes := s.passes
+		s.mu.Unlock()
+		if passes > 1 {
+			<-r.Context().Done()
+			return
+		}
+	}
+
+	page := ladder.Ladder{Total: len(s.entries), Entries: []ladder.Entry{}}
+	if offset < len(s.entries) {
+		end := offset + limit
+		if end > len(s.entries) {
+			end = len(s.entries)
+		}
+		page.Entries = s.entries[offset:end]
+	}
+	json.NewEncoder(w).Encode(page)
+}
+
+// bufferedTransport reads every items response in full before
+// signalling it was fetched, so cancelling afterwards cannot fail it.
+type bufferedTransport struct {
+	fetched chan<- struct{}
+}
+
+func (t bufferedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || req.URL.Path != "/character-window/get-items" {
+		return resp, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	t.fetched <- struct{}{}
+	return resp, nil
+}
+
+func TestCoreLoop(t *testing.T) {
+	itemsBody, err := ioutil.ReadFile("../../fixtures/get-items.json")
+	require.NoError(t, err)
+
+	const characters = 7
+	server := &ladderServer{
+		items:   itemsBody,
+		fetched: make(map[string]int),
+	}
+	for i := 0; i < characters; i++ {
+		var e ladder.Entry
+		e.Rank = i + 1
+		e.Account.Name = "some-account"
+		e.Character.Name = fmt.Sprintf("character-%d", i)
+		e.Character.Level = 90
+		e.Character.Class = "Necromancer"
+		server.entries = append(server.entries, e)
+	}
+	// Dead characters are never checked
+	var dead ladder.Entry
+	dead.Dead = true
+	dead.Account.Name = "some-account"
+	dead.Character.Name = "dead-character"
+	server.entries = append(server.entries, dead)
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	fetched := make(chan struct{}, characters)
+	c := remote.NewClient(remote.NewLimiters(time.Microsecond,
+		time.Millisecond, zap.NewNop()))
+	c.ItemsURL = httpServer.URL + "/character-window/get-items"
+	c.LadderURL = httpServer.URL + "/ladders"
+	c.HTTP = &http.Client{Transport: bufferedTransport{fetched: fetched}}
+	c.Retry = remote.Retry{}
+
+	gucciHobo, err := policy.Builtin(policy.GucciHoboName)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "watch")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	*outputFile = filepath.Join(dir, "failures.%s.csv")
+	*ladderName = "some-ladder"
+	*workers = 2
+	*queueSize = 1
+	*doEnforceItems = true
+	*doEnforcePassives = false
+	*accountCharacters = false
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- coreLoop(ctx, 3, zap.NewNop(), enforceConfig{
+			Ladder: *ladderName,
+			Policy: gucciHobo,
+			Client: c,
+			Seen:   make(map[string]struct{}),
+		})
+	}()
+
+	// Stop as soon as the last character is fetched, before its
+	// failures can have been written
+	for i := 0; i < characters; i++ {
+		select {
+		case <-fetched:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("only %d of %d characters fetched", i, characters)
+		}
+	}
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("pipeline did not stop once cancelled")
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Len(t, server.fetched, characters)
+	for name, count := range server.fetched {
+		require.Equal(t, 1, count, "%s checked exactly once", name)
+	}
+
+	// Every failure found is written, including those of the
+	// page in flight when cancelled
+	output, err := os.Open(fmt.Sprintf(*outputFile, *ladderName))
+	require.NoError(t, err)
+	defer output.Close()
+	records, err := csv.NewReader(output).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, items.PolicyFailureCSVHeader(), records[0])
+	written := make(map[string]struct{})
+	for _, record := range records[1:] {
+		written[record[9]] = struct{}{}
+	}
+	require.Len(t, written, characters)
+	for _, e := range server.entries[:characters] {
+		require.Contains(t, written, e.Character.Name)
+	}
+}
@@ -7,11 +7,34 @@ import "github.com/gobuffalo/packr"
 // You can use the "packr clean" command to clean up this,
 // and any other packr generated files.
 func init() {
-	packr.PackJSONBytes(".", "fixtures.go", "\"cGFja2FnZSBmaXh0dXJlcwoKaW1wb3J0ICgKCSJ0ZXN0aW5nIgoKCSJnaXRodWIuY29tL2dvYnVmZmFsby9wYWNrciIKCSJnaXRodWIuY29tL3N0cmV0Y2hyL3Rlc3RpZnkvcmVxdWlyZSIKKQoKY29uc3QgR2V0SXRlbXNGaXh0dXJlID0gImdldC1pdGVtcy5qc29uIgpjb25zdCBHZXRMYWRkZXJGaXh0dXJlID0gImdldC1sYWRkZXIuanNvbiIKY29uc3QgRW1wdHlJdGVtc0ZpeHR1cmUgPSAibGlmZWN5Y2xlL2dldC1pdGVtcy5lbXB0eS5qc29uIgpjb25zdCBGdWxsSXRlbXNGaXh0dXJlID0gImxpZmVjeWNsZS9nZXQtaXRlbXMuZnVsbC5qc29uIgpjb25zdCBHZXRQYXNzaXZlc0ZpeHR1cmUzNCA9ICJnZXQtcGFzc2l2ZS1za2lsbHMuanNvbiIKCnZhciBib3ggPSBwYWNrci5OZXdCb3goIi4iKQoKLy8gRml4dHVyZUJ5dGVzIHJldHVybnMgdGhlIGJ5dGVzIGZyb20gYSBmaWxlIHJlbGF0aXZlIHRvIHRoZSBmaXh0dXJlcyBkaXJlY3RvcnkKZnVuYyBGaXh0dXJlQnl0ZXModCAqdGVzdGluZy5ULCBmaXh0dXJlIHN0cmluZykgW11ieXRlIHsKCWlmIHQgIT0gbmlsIHsKCQl0LkxvZyhmaXh0dXJlKQoJfQoJYmxvYiwgZXJyIDo9IGJveC5NdXN0Qnl0ZXMoZml4dHVyZSkKCWlmIHQgIT0gbmlsIHsKCQlyZXF1aXJlLk5vRXJyb3IodCwgZXJyLCAicmVhZGluZyBmaXh0dXJlIGZpbGUiKQoJfQoKCXJldHVybiBibG9iCn0K\"")
+	packr.PackJSONBytes(".", "fixtures.go", "\"cGFja2FnZSBmaXh0dXJlcwoKaW1wb3J0ICgKCSJwYXRoL2ZpbGVwYXRoIgoJInNvcnQiCgkic3RyaW5ncyIKCSJ0ZXN0aW5nIgoKCSJnaXRodWIuY29tL2dvYnVmZmFsby9wYWNrciIKCSJnaXRodWIuY29tL3N0cmV0Y2hyL3Rlc3RpZnkvcmVxdWlyZSIKKQoKY29uc3QgR2V0SXRlbXNGaXh0dXJlID0gImdldC1pdGVtcy5qc29uIgpjb25zdCBHZXRMYWRkZXJGaXh0dXJlID0gImdldC1sYWRkZXIuanNvbiIKY29uc3QgR2V0Q2hhcmFjdGVyc0ZpeHR1cmUgPSAiZ2V0LWNoYXJhY3RlcnMuanNvbiIKY29uc3QgRW1wdHlJdGVtc0ZpeHR1cmUgPSAiZ29sZGVuL2ZyZXNoLWNoYXJhY3Rlci9nZXQtaXRlbXMuanNvbiIKY29uc3QgRnVsbEl0ZW1zRml4dHVyZSA9ICJnb2xkZW4vc2xlZXBlci1zcGVjdHJlL2dldC1pdGVtcy5qc29uIgpjb25zdCBHZXRQYXNzaXZlc0ZpeHR1cmUzNCA9ICJnZXQtcGFzc2l2ZS1za2lsbHMuanNvbiIKCi8vIEdvbGRlbkRpciBob2xkcyB0aGUgZ29sZGVuIHBvbGljeSBjb3JwdXMsIG9uZSBkaXJlY3RvcnkgcGVyIGNhc2UuCi8vCi8vIFNlZSBnb2xkZW4vUkVBRE1FLm1kIGZvciB0aGUgbGF5b3V0IG9mIGEgY2FzZS4KY29uc3QgR29sZGVuRGlyID0gImdvbGRlbiIKCnZhciBib3ggPSBwYWNrci5OZXdCb3goIi4iKQoKLy8gRml4dHVyZUJ5dGVzIHJldHVybnMgdGhlIGJ5dGVzIGZyb20gYSBmaWxlIHJlbGF0aXZlIHRvIHRoZSBmaXh0dXJlcyBkaXJlY3RvcnkKZnVuYyBGaXh0dXJlQnl0ZXModCB0ZXN0aW5nLlRCLCBmaXh0dXJlIHN0cmluZykgW11ieXRlIHsKCWlmIHQgIT0gbmlsIHsKCQl0LkxvZyhmaXh0dXJlKQoJfQoJYmxvYiwgZXJyIDo9IGJveC5NdXN0Qnl0ZXMoZml4dHVyZSkKCWlmIHQgIT0gbmlsIHsKCQlyZXF1aXJlLk5vRXJyb3IodCwgZXJyLCAicmVhZGluZyBmaXh0dXJlIGZpbGUiKQoJfQoKCXJldHVybiBibG9iCn0KCi8vIEhhc0ZpeHR1cmUgcmV0dXJucyB0cnVlIHdoZW4gdGhlIGZpbGUgZXhpc3RzIHJlbGF0aXZlIHRvCi8vIHRoZSBmaXh0dXJlcyBkaXJlY3RvcnkKZnVuYyBIYXNGaXh0dXJlKGZpeHR1cmUgc3RyaW5nKSBib29sIHsKCXJldHVybiBib3guSGFzKGZpeHR1cmUpCn0KCi8vIEZpeHR1cmVEaXJzIHJldHVybnMgdGhlIG5hbWVzIG9mIGV2ZXJ5IGRpcmVjdG9yeSBkaXJlY3RseSB3aXRoaW4KLy8gYSBkaXJlY3RvcnkgcmVsYXRpdmUgdG8gdGhlIGZpeHR1cmVzIGRpcmVjdG9yeSwgc29ydGVkLgpmdW5jIEZpeHR1cmVEaXJzKHQgdGVzdGluZy5UQiwgZGlyIHN0cmluZykgW11zdHJpbmcgewoJZm91bmQgOj0gbWFrZShtYXBbc3RyaW5nXXN0cnVjdHt9KQoJcHJlZml4IDo9IHN0cmluZ3MuVHJpbVN1ZmZpeChkaXIsICIvIikgKyAiLyIKCWVyciA6PSBib3guV2Fsa1ByZWZpeChwcmVmaXgsIGZ1bmMobmFtZSBzdHJpbmcsIF8gcGFja3IuRmlsZSkgZXJyb3IgewoJCXJlc3QgOj0gc3RyaW5ncy5UcmltUHJlZml4KGZpbGVwYXRoLlRvU2xhc2gobmFtZSksIHByZWZpeCkKCQlpZiBpIDo9IHN0cmluZ3MuSW5kZXgocmVzdCwgIi8iKTsgaSA+IDAgewoJCQlmb3VuZFtyZXN0WzppXV0gPSBzdHJ1Y3R7fXt9CgkJfQoJCXJldHVybiBuaWwKCX0pCglpZiB0ICE9IG5pbCB7CgkJcmVxdWlyZS5Ob0Vycm9yKHQsIGVyciwgIndhbGtpbmcgZml4dHVyZSBkaXJlY3RvcnkiKQoJfQoKCWRpcnMgOj0gbWFrZShbXXN0cmluZywgMCwgbGVuKGZvdW5kKSkKCWZvciBkIDo9IHJhbmdlIGZvdW5kIHsKCQlkaXJzID0gYXBwZW5kKGRpcnMsIGQpCgl9Cglzb3J0LlN0cmluZ3MoZGlycykKCXJldHVybiBkaXJzCn0K\"")
+	packr.PackJSONBytes(".", "get-characters.json", "\"WwogIHsKICAgICJuYW1lIjogIlNsZWVwZXJTcGVjdHJlQm9pIiwKICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgImNsYXNzSWQiOiAzLAogICAgImFzY2VuZGFuY3lDbGFzcyI6IDMsCiAgICAiY2xhc3MiOiAiTmVjcm9tYW5jZXIiLAogICAgImxldmVsIjogOTIsCiAgICAiZXhwZXJpZW5jZSI6IDIxMDc5NjIzNDEKICB9LAogIHsKICAgICJuYW1lIjogIkhvYm9EdWVsaXN0T2ZmTGFkZGVyIiwKICAgICJsZWFndWUiOiAiU2xpcHBlcnkgSG9ibyBMZWFndWUgKFBMNTM1NykiLAogICAgImNsYXNzSWQiOiA0LAogICAgImFzY2VuZGFuY3lDbGFzcyI6IDEsCiAgICAiY2xhc3MiOiAiU2xheWVyIiwKICAgICJsZXZlbCI6IDcxLAogICAgImV4cGVyaWVuY2UiOiAyMDQ2MTEyNjcsCiAgICAibGFzdEFjdGl2ZSI6IHRydWUKICB9LAogIHsKICAgICJuYW1lIjogIkhvYm9UZW1wbGFyIiwKICAgICJsZWFndWUiOiAiU2xpcHBlcnkgSG9ibyBMZWFndWUgKFBMNTM1NykiLAogICAgImNsYXNzSWQiOiA1LAogICAgImFzY2VuZGFuY3lDbGFzcyI6IDAsCiAgICAiY2xhc3MiOiAiVGVtcGxhciIsCiAgICAibGV2ZWwiOiAxMiwKICAgICJleHBlcmllbmNlIjogOTgxNTAKICB9LAogIHsKICAgICJuYW1lIjogIlNTRldpdGNoIiwKICAgICJsZWFndWUiOiAiU1NGIFN0YW5kYXJkIiwKICAgICJjbGFzc0lkIjogMywKICAgICJhc2NlbmRhbmN5Q2xhc3MiOiAwLAogICAgImNsYXNzIjogIldpdGNoIiwKICAgICJsZXZlbCI6IDM4LAogICAgImV4cGVyaWVuY2UiOiA0MjUwMzY0CiAgfQpdCg==\"")
 	packr.PackJSONBytes(".", "get-items.json", "\"ewogICJpdGVtcyI6IFsKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMiwKICAgICAgImgiOiAyLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0FybW91cnMvR2xvdmVzL0dsb3Zlc1N0ckRleDMucG5nP3NjYWxlPTEmdz0yJmg9MiZ2PTI2YTliMTU5YTFhNTNlMGNhZTc5ZDMwZDZmYTUwY2RkIiwKICAgICAgImxlYWd1ZSI6ICJTdGFuZGFyZCIsCiAgICAgICJpZCI6ICI4ZWMwZWI1ZTBlOGFmNDU3M2VlMGIxMDE0YTlkMWE2ODRjMGQ5ZDhhNmIyMjk2MTRjOWUwOTFmM2ZhMDRjNDFmIiwKICAgICAgImluZmx1ZW5jZXMiOiB7CiAgICAgICAgImVsZGVyIjogdHJ1ZQogICAgICB9LAogICAgICAiZWxkZXIiOiB0cnVlLAogICAgICAic29ja2V0cyI6IFsKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiRCIsCiAgICAgICAgICAic0NvbG91ciI6ICJHIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMCwKICAgICAgICAgICJhdHRyIjogIlMiLAogICAgICAgICAgInNDb2xvdXIiOiAiUiIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJEIiwKICAgICAgICAgICJzQ29sb3VyIjogIkciCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiRCIsCiAgICAgICAgICAic0NvbG91ciI6ICJHIgogICAgICAgIH0KICAgICAgXSwKICAgICAgIm5hbWUiOiAiQnJhbWJsZSBGaW5nZXJzIiwKICAgICAgInR5cGVMaW5lIjogIkRyYWdvbnNjYWxlIEdhdW50bGV0cyIsCiAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgImlsdmwiOiA3NiwKICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiUXVhbGl0eSIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIisyMCUiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDYKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkFybW91ciIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjE2OCIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogMTYKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkV2YXNpb24gUmF0aW5nIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTY5IiwKICAgICAgICAgICAgICAxCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiAxNwogICAgICAgIH0KICAgICAgXSwKICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJTdHIiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIxMTEiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkRleCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjExMSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMjMiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIlNvY2tldGVkIEdlbXMgaGF2ZSAxNiUgbW9yZSBBdHRhY2sgYW5kIENhc3QgU3BlZWQiLAogICAgICAgICIrMjIgdG8gRGV4dGVyaXR5IiwKICAgICAgICAiKzE5IHRvIEFybW91ciIsCiAgICAgICAgIisyMCB0byBFdmFzaW9uIFJhdGluZyIsCiAgICAgICAgIisyOCUgdG8gTGlnaHRuaW5nIFJlc2lzdGFuY2UiCiAgICAgIF0sCiAgICAgICJjcmFmdGVkTW9kcyI6IFsKICAgICAgICAiKzQ1IHRvIG1heGltdW0gTGlmZSIKICAgICAgXSwKICAgICAgImVuY2hhbnRNb2RzIjogWwogICAgICAgICJUcmlnZ2VyIENvbW1hbmRtZW50IG9mIHRoZSBHcmF2ZSB3aGVuIHlvdXIgU2tpbGxzIG9yIE1pbmlvbnMgS2lsbCIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDIsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiR2xvdmVzIiwKICAgICAgInNvY2tldGVkSXRlbXMiOiBbCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvU3VwcG9ydC9GYXN0ZXJBdHRhY2tzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1jMTQyMDNmN2IxOTY1MDg2MTkwN2EzMGE5MmUzYjZmZSIsCiAgICAgICAgICAic3VwcG9ydCI6IHRydWUsCiAgICAgICAgICAiaWQiOiAiNWIyZDc2ZGI4MTU2ZTA2YTU2YzM0MTk5ZDQ0ZTAwMjUwMDk4NzIyZWU4MzY2MTQ3MDEzNzdiYzc2ZjdjMmExNCIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkZhc3RlciBBdHRhY2tzIFN1cHBvcnQiLAogICAgICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAgICAgImlsdmwiOiAwLAogICAgICAgICAgImNvcnJ1cHRlZCI6IHRydWUsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkF0dGFjaywgU3VwcG9ydCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjAgKE1heCkiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWFuYSBNdWx0aXBsaWVyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTE1JSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlF1YWxpdHkiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIrOSUiLAogICAgICAgICAgICAgICAgICAxCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNgogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJEZXgiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMTEiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIlN1cHBvcnRzIGF0dGFjayBza2lsbHMuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGhhdmUgNDglIGluY3JlYXNlZCBBdHRhY2sgU3BlZWQiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDAsCiAgICAgICAgICAiY29sb3VyIjogIkQiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9TdXBwb3J0L0ZvcnRpZnlHZW0ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTZhNjk4NTNhYWY3Mjc4NjY3YmRlNDM1MzE1NDFlZjdkIiwKICAgICAgICAgICJzdXBwb3J0IjogdHJ1ZSwKICAgICAgICAgICJpZCI6ICIzMjQ4NTA1OTM2ODNhMGYyMGRlOTMzZGIwNWU4MTYwZjIxOGZjYzU0NTcyNzRkZDg1NTVkMzkxODNjNTJlMjQ3IiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiRm9ydGlmeSBTdXBwb3J0IiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJjb3JydXB0ZWQiOiB0cnVlLAogICAgICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJBdHRhY2ssIFN1cHBvcnQsIE1lbGVlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIE11bHRpcGxpZXIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMzAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJTdHIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMTEiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIlN1cHBvcnRzIG1lbGVlIGF0dGFjayBza2lsbHMgdGhhdCBhcmUgbm90IHRyaWdnZXJlZC4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIlN1cHBvcnRlZCBTa2lsbHMgZ3JhbnQgRm9ydGlmeSBvbiBNZWxlZSBoaXQiLAogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBoYXZlIDM0JSBpbmNyZWFzZWQgRm9ydGlmeSBkdXJhdGlvbiIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMzQlIG1vcmUgRGFtYWdlIHdpdGggQWlsbWVudHMgY2F1c2VkIGJ5IE1lbGVlIEhpdHMiLAogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBkZWFsIDM0JSBtb3JlIE1lbGVlIERhbWFnZSIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMSwKICAgICAgICAgICJjb2xvdXIiOiAiUyIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL1N1cHBvcnQvQ3VsbGluZ1N0cmlrZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9N2NiNzU2ZWQwMzNiNjA3Mzk4NmZkOGJhYmZjMTBmNDMiLAogICAgICAgICAgInN1cHBvcnQiOiB0cnVlLAogICAgICAgICAgImlkIjogImM0OGFiZmUxY2IzMmRhMDA0NmI5NDkzNTBkYWUxNTkyMDYzNGM1Mzg0OTRmMGNjMTViYTI2NTNhY2RhYjAxMWEiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJDdWxsaW5nIFN0cmlrZSBTdXBwb3J0IiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJjb3JydXB0ZWQiOiB0cnVlLAogICAgICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJTdXBwb3J0IiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIE11bHRpcGxpZXIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMTAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJEZXgiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMTEiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIlN1cHBvcnRzIGFueSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcy4gSWYgZW5lbWllcyBhcmUgbGVmdCBiZWxvdyAxMCUgb2YgbWF4aW11bSBsaWZlIGFmdGVyIGJlaW5nIGhpdCBieSB0aGVzZSBza2lsbHMsIHRoZXkgd2lsbCBiZSBraWxsZWQuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJLaWxsIEVuZW1pZXMgdGhhdCBoYXZlIDEwJSBMaWZlIG9yIGxvd2VyIHdoZW4gSGl0IGJ5IFN1cHBvcnRlZCBTa2lsbHMiLAogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBkZWFsIDM4JSBpbmNyZWFzZWQgRGFtYWdlIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAyLAogICAgICAgICAgImNvbG91ciI6ICJEIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvV2hpcmxpbmdCbGFkZXMucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PWU0NTYwNGU4MDhiYzk4ZjUxMmZkNDgxODI2NWNkZDdhIiwKICAgICAgICAgICJzdXBwb3J0IjogZmFsc2UsCiAgICAgICAgICAiaWQiOiAiM2Y4Y2FhYjgzZDk4YmQyOWQ5Nzc1MzlmYzg4YTE5M2M1YTg2MjgzNWYyMTMyNjdiY2RiY2EwZWEzYmJkOGNiMSIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIldoaXJsaW5nIEJsYWRlcyIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkF0dGFjaywgTW92ZW1lbnQsIFRyYXZlbCwgTWVsZWUiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjQiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWFuYSBDb3N0IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJFZmZlY3RpdmVuZXNzIG9mIEFkZGVkIERhbWFnZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEwMyUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAiYWRkaXRpb25hbFByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJFeHBlcmllbmNlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTExMTkyLzExMTE5MiIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDIsCiAgICAgICAgICAgICAgInByb2dyZXNzIjogMSwKICAgICAgICAgICAgICAidHlwZSI6IDIwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkRleCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjMzIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjMiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAibmV4dExldmVsUmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyNSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkRleCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjM4IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjYiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIkRpdmUgdGhyb3VnaCBlbmVtaWVzLCBkZWFsaW5nIHdlYXBvbiBkYW1hZ2UuIE9ubHkgd29ya3Mgd2l0aCBkYWdnZXJzLCBjbGF3cyBhbmQgb25lIGhhbmRlZCBzd29yZHMuIENhbm5vdCBiZSBzdXBwb3J0ZWQgYnkgTXVsdGlzdHJpa2UuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJEZWFscyAxMDMuMiUgb2YgQmFzZSBEYW1hZ2UiLAogICAgICAgICAgICAiKzAuNiBzZWNvbmRzIHRvIEF0dGFjayBUaW1lIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDMsCiAgICAgICAgICAiY29sb3VyIjogIkQiCiAgICAgICAgfQogICAgICBdCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAyLAogICAgICAiaCI6IDMsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvQXJtb3Vycy9TaGllbGRzL1Jpc2VvZnRoZXBob2VuaXgucG5nP3NjYWxlPTEmdz0yJmg9MyZ2PWM3NzE3YzRlMDFiN2U1MDY4NzYxNmM2ODY1MDM5NzFjIiwKICAgICAgImxlYWd1ZSI6ICJTdGFuZGFyZCIsCiAgICAgICJpZCI6ICJlMDhjZTRjMzA2ZjJmOWM2N2E4NmVhNmYwNzYxYjE0YjJkZGRjMjBhNzAxYzk3MjMyYWQzMGVhMzZmNjE0NGNkIiwKICAgICAgInNvY2tldHMiOiBbCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMCwKICAgICAgICAgICJhdHRyIjogIkkiLAogICAgICAgICAgInNDb2xvdXIiOiAiQiIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJTIiwKICAgICAgICAgICJzQ29sb3VyIjogIlIiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiSSIsCiAgICAgICAgICAic0NvbG91ciI6ICJCIgogICAgICAgIH0KICAgICAgXSwKICAgICAgIm5hbWUiOiAiUmlzZSBvZiB0aGUgUGhvZW5peCIsCiAgICAgICJ0eXBlTGluZSI6ICJNb3NhaWMgS2l0ZSBTaGllbGQiLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogNjksCiAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlF1YWxpdHkiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIrMjAlIiwKICAgICAgICAgICAgICAxCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiA2CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJDaGFuY2UgdG8gQmxvY2siLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIyNCUiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDE1CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJBcm1vdXIiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIzMjIiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDE2CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJFbmVyZ3kgU2hpZWxkIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNjEiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDE4CiAgICAgICAgfQogICAgICBdLAogICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNzIiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlN0ciIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjExMSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTIxIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfQogICAgICBdLAogICAgICAiaW1wbGljaXRNb2RzIjogWwogICAgICAgICIrOCUgdG8gYWxsIEVsZW1lbnRhbCBSZXNpc3RhbmNlcyIKICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiOTklIGluY3JlYXNlZCBBcm1vdXIgYW5kIEVuZXJneSBTaGllbGQiLAogICAgICAgICIrNTAgdG8gbWF4aW11bSBMaWZlIiwKICAgICAgICAiUmVnZW5lcmF0ZSAxNy41IExpZmUgcGVyIHNlY29uZCIsCiAgICAgICAgIis1JSB0byBtYXhpbXVtIEZpcmUgUmVzaXN0YW5jZSIsCiAgICAgICAgIisyNCUgdG8gRmlyZSBSZXNpc3RhbmNlIiwKICAgICAgICAiKzI1JSB0byBGaXJlIFJlc2lzdGFuY2Ugd2hpbGUgb24gTG93IExpZmUiLAogICAgICAgICIxMCUgaW5jcmVhc2VkIE1vdmVtZW50IFNwZWVkIHdoZW4gb24gTG93IExpZmUiLAogICAgICAgICJDYW5ub3QgYmUgSWduaXRlZCB3aGlsZSBvbiBMb3cgTGlmZSIKICAgICAgXSwKICAgICAgImZsYXZvdXJUZXh0IjogWwogICAgICAgICJNeSBiZWFyZXIgc2hhbGwgYmUgZ3VhcmRlZCBieSBmbGFtZSxcciIsCiAgICAgICAgImZvciBJIGFtIHRoZSBwaG9lbml4LCBmb3JldmVyIHJhZGlhbnQgaW4gZ2xvcnkuIgogICAgICBdLAogICAgICAiZnJhbWVUeXBlIjogMywKICAgICAgIngiOiAwLAogICAgICAieSI6IDAsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJPZmZoYW5kIiwKICAgICAgInNvY2tldGVkSXRlbXMiOiBbCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvRnJvc3RGdXJ5LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj03N2FhYWJmYmI0YzFiZWJjZDhlMjE0N2I4Y2VjOWZmZCIsCiAgICAgICAgICAic3VwcG9ydCI6IGZhbHNlLAogICAgICAgICAgImlkIjogImQwZjc2OGRiZmNjYjA1ZTZhMmZkMjM4OTg1NjY5YWJiMzc3MmJiY2MxY2E0M2VhZjI5NWVjZDFiNjc5ZmI1M2EiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJXaW50ZXIgT3JiIiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ29sZCwgU3BlbGwsIENoYW5uZWxsaW5nLCBBb0UsIER1cmF0aW9uLCBQcm9qZWN0aWxlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMiIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIzIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ2FzdCBUaW1lIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMC4yNSBzZWMiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJDcml0aWNhbCBTdHJpa2UgQ2hhbmNlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNi4wMCUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJFZmZlY3RpdmVuZXNzIG9mIEFkZGVkIERhbWFnZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjUwJSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJhZGRpdGlvbmFsUHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkV4cGVyaWVuY2UiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI5MzY0ODcvMTk1NjY0OCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDIsCiAgICAgICAgICAgICAgInByb2dyZXNzIjogMC40Nzg2MTgwMjU3Nzk3MjQxLAogICAgICAgICAgICAgICJ0eXBlIjogMjAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjU0IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTIxIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJDaGFubmVsIHRvIGNvbmp1cmUgYW4gb3JiIGFib3ZlIHlvdSwgd2hpY2ggcGVsdHMgbmVhcmJ5IGVuZW1pZXMgd2l0aCBwcm9qZWN0aWxlcyB0aGF0IGltcGFjdCB0aGUgZ3JvdW5kIGFuZCBleHBsb2RlLiBDaGFubmVsbGluZyBmb3IgbG9uZ2VyIGJ1aWxkcyB1cCBzdGFnZXMgb24gdGhlIHNraWxsLiBXaGVuIHlvdSBzdG9wIGNoYW5uZWxsaW5nLCB0aGUgc3RhZ2VzIGRlY2F5IG92ZXIgYSBkdXJhdGlvbi4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIkRlYWxzIDExMyB0byAxNDEgQ29sZCBEYW1hZ2UiLAogICAgICAgICAgICAiQmFzZSBkdXJhdGlvbiBpcyAxLjIwIHNlY29uZHMiLAogICAgICAgICAgICAiSW5jcmVhc2VzIGFuZCBSZWR1Y3Rpb25zIHRvIENhc3QgU3BlZWQgYWxzbyBhcHBseSB0byBQcm9qZWN0aWxlIEZyZXF1ZW5jeSIsCiAgICAgICAgICAgICJGaXJlcyBwcm9qZWN0aWxlcyBldmVyeSAxLjYgc2Vjb25kcyIsCiAgICAgICAgICAgICIyNSUgaW5jcmVhc2VkIER1cmF0aW9uIHBlciBTdGFnZSIsCiAgICAgICAgICAgICIxMDAlIG1vcmUgUHJvamVjdGlsZSBGcmVxdWVuY3kgd2hpbGUgQ2hhbm5lbGxpbmciLAogICAgICAgICAgICAiMTUlIGluY3JlYXNlZCBQcm9qZWN0aWxlIEZyZXF1ZW5jeSBwZXIgU3RhZ2UiLAogICAgICAgICAgICAiTWF4aW11bSAxMCBTdGFnZXMiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMCwKICAgICAgICAgICJjb2xvdXIiOiAiSSIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL1N1cHBvcnQvSW5jcmVhc2VkRHVyYXRpb24ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTE0YjNhOTE5MzNmMGI5MjFkMWI1NzMzNThlMzFmNzY4IiwKICAgICAgICAgICJzdXBwb3J0IjogdHJ1ZSwKICAgICAgICAgICJpZCI6ICI4YTg4ZTk2NTQxMzU2YjJmZjJlYWY0NzAxM2YwOGU1MjEyNjIzYjA5YmNkYTMyZWRkOThjZWMwYjI5ZDcwNTE1IiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiSW5jcmVhc2VkIER1cmF0aW9uIFN1cHBvcnQiLAogICAgICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAgICAgImlsdmwiOiAwLAogICAgICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJTdXBwb3J0LCBEdXJhdGlvbiIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjAgKE1heCkiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWFuYSBNdWx0aXBsaWVyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTQwJSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3RyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTExIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdXBwb3J0cyBhbnkgc2tpbGwgd2l0aCBhIGR1cmF0aW9uLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBoYXZlIDY0JSBpbmNyZWFzZWQgU2tpbGwgRWZmZWN0IER1cmF0aW9uIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAxLAogICAgICAgICAgImNvbG91ciI6ICJTIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvU3VwcG9ydC9GYXN0ZXJDYXN0LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1mNzBlMzBjZDM3NTVkMTFhODExMDNiNzA5NTdlNTc5YyIsCiAgICAgICAgICAic3VwcG9ydCI6IHRydWUsCiAgICAgICAgICAiaWQiOiAiYmJkOWU3ZmIyYTgwMmIxY2JiYzgzNTM2MGQ2MTEwYWUzZDBiNmJkY2U4YjZhMTI2OTg3NDg2Y2U0YTczMTFiMiIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkZhc3RlciBDYXN0aW5nIFN1cHBvcnQiLAogICAgICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAgICAgImlsdmwiOiAwLAogICAgICAgICAgImNvcnJ1cHRlZCI6IHRydWUsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlN1cHBvcnQsIFNwZWxsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMSAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIE11bHRpcGxpZXIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMjAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzIiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJJbnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMTQiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIlN1cHBvcnRzIG5vbi1pbnN0YW50IHNwZWxsIHNraWxscy4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIlN1cHBvcnRlZCBTa2lsbHMgaGF2ZSA0MCUgaW5jcmVhc2VkIENhc3QgU3BlZWQiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDIsCiAgICAgICAgICAiY29sb3VyIjogIkkiCiAgICAgICAgfQogICAgICBdCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDEsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvUmluZ3MvRW1wdHktU29ja2V0LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0zNjcxZjZkNzlkNzE5MGI0Mzg3OWI4MzBlNjNmYzA3OCIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiZDJkYzYzZmE3MGVlY2RiYTczODcwY2E2NzRiNjI0N2E1NWExNzQ4ZWVhODk2ZGU3NGFmMjMxOGUzNjE0MTM1YiIsCiAgICAgICJzb2NrZXRzIjogWwogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJJIiwKICAgICAgICAgICJzQ29sb3VyIjogIkIiCiAgICAgICAgfQogICAgICBdLAogICAgICAibmFtZSI6ICJQbGFndWUgQ2lyY2xlIiwKICAgICAgInR5cGVMaW5lIjogIlVuc2V0IFJpbmciLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogNjcsCiAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTU1IiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfQogICAgICBdLAogICAgICAiaW1wbGljaXRNb2RzIjogWwogICAgICAgICJIYXMgMSBTb2NrZXQiCiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIisyIHRvIExldmVsIG9mIFNvY2tldGVkIEdlbXMiLAogICAgICAgICJBZGRzIDQgdG8gNiBQaHlzaWNhbCBEYW1hZ2UgdG8gQXR0YWNrcyIsCiAgICAgICAgIis1MiB0byBtYXhpbXVtIExpZmUiLAogICAgICAgICIxNCUgaW5jcmVhc2VkIFJhcml0eSBvZiBJdGVtcyBmb3VuZCIsCiAgICAgICAgIis0MCUgdG8gRmlyZSBSZXNpc3RhbmNlIgogICAgICBdLAogICAgICAiY3JhZnRlZE1vZHMiOiBbCiAgICAgICAgIisyNCB0byBTdHJlbmd0aCIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDIsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiUmluZyIsCiAgICAgICJzb2NrZXRlZEl0ZW1zIjogWwogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL0NsYXJpdHkucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PWY2MDljMTI4ZTVlZTA1M2U3NzNiMzkxNjI1NzU3ZDZhIiwKICAgICAgICAgICJzdXBwb3J0IjogZmFsc2UsCiAgICAgICAgICAiaWQiOiAiNTQxZGI1MzdjMTg4NTc1MzQ2ZjlkNTE4NDU5M2YyNWJiZjg5NGRkMzAwNjQwYTExODY3YWJmYmNhOTc5OGZkMiIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkNsYXJpdHkiLAogICAgICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAgICAgImlsdmwiOiAwLAogICAgICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJBdXJhLCBTcGVsbCwgQW9FIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIFJlc2VydmVkIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjc5IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ29vbGRvd24gVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEuMjAgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ2FzdCBUaW1lIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiSW5zdGFudCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTU1IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJDYXN0cyBhbiBhdXJhIHRoYXQgZ3JhbnRzIG1hbmEgcmVnZW5lcmF0aW9uIHRvIHlvdSBhbmQgeW91ciBhbGxpZXMuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICIrMTkgdG8gcmFkaXVzIiwKICAgICAgICAgICAgIllvdSBhbmQgbmVhcmJ5IEFsbGllcyBSZWdlbmVyYXRlIDMzIE1hbmEgcGVyIHNlY29uZCIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAwLAogICAgICAgICAgImNvbG91ciI6ICJJIgogICAgICAgIH0KICAgICAgXQogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMiwKICAgICAgImgiOiAyLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0FybW91cnMvSGVsbWV0cy9IZWxtZXRTdHJJbnQ3LnBuZz9zY2FsZT0xJnc9MiZoPTImdj1iZjI5NjNlNzgzNzlmNmViMzViYmZlZGUzYWRlMDE2NCIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiZDU3ZTFjZDg2ZjIxODJhNDVmMzMyMmY4YzJlOWViYThiZjg2M2Q2MDNmYWI1MGIzNGQzMjBiZDBlOTgwOTY0NyIsCiAgICAgICJzb2NrZXRzIjogWwogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJEIiwKICAgICAgICAgICJzQ29sb3VyIjogIkciCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiSSIsCiAgICAgICAgICAic0NvbG91ciI6ICJCIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMCwKICAgICAgICAgICJhdHRyIjogIkkiLAogICAgICAgICAgInNDb2xvdXIiOiAiQiIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJJIiwKICAgICAgICAgICJzQ29sb3VyIjogIkIiCiAgICAgICAgfQogICAgICBdLAogICAgICAibmFtZSI6ICJTa3VsbCBWaXNhZ2UiLAogICAgICAidHlwZUxpbmUiOiAiR3JlYXQgQ3Jvd24iLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogNTcsCiAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlF1YWxpdHkiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIrMjAlIiwKICAgICAgICAgICAgICAxCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiA2CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJBcm1vdXIiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIyNDIiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDE2CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJFbmVyZ3kgU2hpZWxkIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMzkiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDE4CiAgICAgICAgfQogICAgICBdLAogICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlN0ciIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjY4IiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJEZXgiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI1NCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTExIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfQogICAgICBdLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICIyMCUgaW5jcmVhc2VkIEFybW91ciBhbmQgRW5lcmd5IFNoaWVsZCIsCiAgICAgICAgIis4OSB0byBtYXhpbXVtIExpZmUiLAogICAgICAgICIrMzMlIHRvIENvbGQgUmVzaXN0YW5jZSIsCiAgICAgICAgIisxMCUgdG8gTGlnaHRuaW5nIFJlc2lzdGFuY2UiCiAgICAgIF0sCiAgICAgICJjcmFmdGVkTW9kcyI6IFsKICAgICAgICAiKzMwIHRvIEFybW91ciIKICAgICAgXSwKICAgICAgImVuY2hhbnRNb2RzIjogWwogICAgICAgICJCb25lIE9mZmVyaW5nIGdyYW50cyBhbiBhZGRpdGlvbmFsICs2JSBDaGFuY2UgdG8gQmxvY2sgQXR0YWNrIERhbWFnZSIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDIsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiSGVsbSIsCiAgICAgICJzb2NrZXRlZEl0ZW1zIjogWwogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL1N1cHBvcnQvQ3VsbGluZ1N0cmlrZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9N2NiNzU2ZWQwMzNiNjA3Mzk4NmZkOGJhYmZjMTBmNDMiLAogICAgICAgICAgInN1cHBvcnQiOiB0cnVlLAogICAgICAgICAgImlkIjogIjJmNWM4ZGY1NjA5NDM5NGQxYWEyOWNlM2IxYjVhOTgwNjNlYWFlNzZhNjAzMTI0ZWI1MmZmMjAzYWY1YzcyNTEiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJDdWxsaW5nIFN0cmlrZSBTdXBwb3J0IiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3VwcG9ydCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIE11bHRpcGxpZXIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMTAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRXhwZXJpZW5jZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjMyMDY3Mi8zMjA2NzIiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAyLAogICAgICAgICAgICAgICJwcm9ncmVzcyI6IDEsCiAgICAgICAgICAgICAgInR5cGUiOiAyMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMzIiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJEZXgiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI1NCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJuZXh0TGV2ZWxSZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjM1IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRGV4IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNTgiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIlN1cHBvcnRzIGFueSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcy4gSWYgZW5lbWllcyBhcmUgbGVmdCBiZWxvdyAxMCUgb2YgbWF4aW11bSBsaWZlIGFmdGVyIGJlaW5nIGhpdCBieSB0aGVzZSBza2lsbHMsIHRoZXkgd2lsbCBiZSBraWxsZWQuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJLaWxsIEVuZW1pZXMgdGhhdCBoYXZlIDEwJSBMaWZlIG9yIGxvd2VyIHdoZW4gSGl0IGJ5IFN1cHBvcnRlZCBTa2lsbHMiLAogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBkZWFsIDglIGluY3JlYXNlZCBEYW1hZ2UiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDAsCiAgICAgICAgICAiY29sb3VyIjogIkQiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9CYWxsTGlnaHRuaW5nLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj00ZWU5OGNjMWUwNDQzMGNmZDQ1NjM5YWUxYWQ1NWEzOCIsCiAgICAgICAgICAic3VwcG9ydCI6IGZhbHNlLAogICAgICAgICAgImlkIjogIjAwZGMxMGUxNjdhN2MyN2Y3NTEyYjk1MjFhZjhkNmQzMzUxZGMwOGRiODhiMWRmNmU4YzczYTMyMWI3OGYzNDYiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJCYWxsIExpZ2h0bmluZyIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlNwZWxsLCBQcm9qZWN0aWxlLCBBb0UsIExpZ2h0bmluZyIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMiIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxNCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjAuNzUgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjUuMDAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRWZmZWN0aXZlbmVzcyBvZiBBZGRlZCBEYW1hZ2UiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI0MCUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAiYWRkaXRpb25hbFByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJFeHBlcmllbmNlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjg1ODE1LzI4NTgxNSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDIsCiAgICAgICAgICAgICAgInByb2dyZXNzIjogMSwKICAgICAgICAgICAgICAidHlwZSI6IDIwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIzMSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjczIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgIm5leHRMZXZlbFJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMzQiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJJbnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI3OSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiRmlyZXMgYSBzbG93LW1vdmluZyBwcm9qZWN0aWxlIHRoYXQgcGVyaW9kaWNhbGx5IGRhbWFnZXMgZW5lbWllcyBpbiBhbiBhcmVhIGFyb3VuZCBpdCB3aXRoIGJvbHRzIG9mIGxpZ2h0bmluZy4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIkRlYWxzIDMgdG8gNTggTGlnaHRuaW5nIERhbWFnZSIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAxLAogICAgICAgICAgImNvbG91ciI6ICJJIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvU3VwcG9ydC9DdXJzZU9uSGl0LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0zYjRkMDBhM2Y1ZTM4ZGM5MTMxMTQ4ZDc2YmE4MWNkOSIsCiAgICAgICAgICAic3VwcG9ydCI6IHRydWUsCiAgICAgICAgICAiaWQiOiAiN2NjZTE3NDllMzUwODVmOGVhYTU1Y2M5NmQ1MjUzYzQ0ZmQ2N2M4MWRkZWZhYjVkOGQ5ZmJiNGYwMTMzYzJkMSIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkN1cnNlIE9uIEhpdCBTdXBwb3J0IiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJjb3JydXB0ZWQiOiB0cnVlLAogICAgICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJDdXJzZSwgVHJpZ2dlciwgU3VwcG9ydCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjAgKE1heCkiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJJbnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMTEiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIk11c3Qgc3VwcG9ydCBib3RoIGEgc2tpbGwgdGhhdCBoaXRzIGVuZW1pZXMsIGFuZCBhIGN1cnNlIHNraWxsIHRvIHdvcmsuIFRoZSBjdXJzZSB3aWxsIGJlIGFwcGxpZWQgd2hlbiBlbmVtaWVzIGFyZSBoaXQgYnkgdGhlIG90aGVyIHNraWxsLiBDYW5ub3Qgc3VwcG9ydCB0b3RlbXMsIHRyYXBzLCBvciBtaW5lcy4gTWluaW9ucyBjYW5ub3QgYXBwbHkgY3Vyc2VzIHRoaXMgd2F5LiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBhcHBseSBzdXBwb3J0ZWQgQ3Vyc2VzIG9uIEhpdCIsCiAgICAgICAgICAgICJZb3UgY2Fubm90IENhc3QgU3VwcG9ydGVkIEN1cnNlcyIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGhhdmUgMTIlIHJlZHVjZWQgQ3Vyc2UgRHVyYXRpb24iCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDIsCiAgICAgICAgICAiY29sb3VyIjogIkkiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9GbGFtbWFiaWxpdHkucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTZmYmY3Y2ZjZGRjYzI2N2IxZjQ0ZmI4NDMxNGRiZTA0IiwKICAgICAgICAgICJzdXBwb3J0IjogZmFsc2UsCiAgICAgICAgICAiaWQiOiAiYzZlOGM3NGFiOWExNjk0MmZiNWZkNDhmZDQxMzQ5NzMxNjZjOGY1YzRlMDZkOGMxMDM0MWFmYTFiMWEyN2MwNyIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkZsYW1tYWJpbGl0eSIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAiY29ycnVwdGVkIjogdHJ1ZSwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3BlbGwsIEFvRSwgRHVyYXRpb24sIEN1cnNlLCBGaXJlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI1MCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjAuNTAgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJTdHIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI2OCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjk4IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJDdXJzZXMgYWxsIHRhcmdldHMgaW4gYW4gYXJlYSwgbWFraW5nIHRoZW0gbGVzcyByZXNpc3RhbnQgdG8gZmlyZSBkYW1hZ2UgYW5kIGdpdmluZyB0aGVtIGEgY2hhbmNlIHRvIGJlIGlnbml0ZWQgYnkgZmlyZSBkYW1hZ2UuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJCYXNlIGR1cmF0aW9uIGlzIDEwLjkwIHNlY29uZHMiLAogICAgICAgICAgICAiKzEwIHRvIHJhZGl1cyIsCiAgICAgICAgICAgICJDdXJzZWQgZW5lbWllcyBoYXZlIC00NCUgdG8gRmlyZSBSZXNpc3RhbmNlIiwKICAgICAgICAgICAgIkN1cnNlZCBlbmVtaWVzIGhhdmUgKzE0JSBjaGFuY2UgdG8gYmUgSWduaXRlZCBieSBGaXJlIERhbWFnZSIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAzLAogICAgICAgICAgImNvbG91ciI6ICJJIgogICAgICAgIH0KICAgICAgXQogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMiwKICAgICAgImgiOiAzLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0FybW91cnMvQm9keUFybW91cnMvVmlzTW9ydGlzLnBuZz9zY2FsZT0xJnc9MiZoPTMmdj1kOTY1NWNkMjg5NTg5NzE2YmI0NTYwYmJjYzVkYTBlMyIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiNmY4N2Y1Y2JjMGI2NjNhZWE0MDYyNmM2ZjUyNjE0NTIxMmQ3NTk5MDk3Yjg0YzY5ZjM4ZTM2OTkwYTRjMTEyMSIsCiAgICAgICJzb2NrZXRzIjogWwogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJJIiwKICAgICAgICAgICJzQ29sb3VyIjogIkIiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiSSIsCiAgICAgICAgICAic0NvbG91ciI6ICJCIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMCwKICAgICAgICAgICJhdHRyIjogIkkiLAogICAgICAgICAgInNDb2xvdXIiOiAiQiIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJEIiwKICAgICAgICAgICJzQ29sb3VyIjogIkciCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiSSIsCiAgICAgICAgICAic0NvbG91ciI6ICJCIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMSwKICAgICAgICAgICJhdHRyIjogIkkiLAogICAgICAgICAgInNDb2xvdXIiOiAiQiIKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJuYW1lIjogIlZpcyBNb3J0aXMiLAogICAgICAidHlwZUxpbmUiOiAiTmVjcm9tYW5jZXIgU2lsa3MiLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogNzUsCiAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkVuZXJneSBTaGllbGQiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIzNTIiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDE4CiAgICAgICAgfQogICAgICBdLAogICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNzIiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkRleCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjExMSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTYwIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfQogICAgICBdLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICIyMDElIGluY3JlYXNlZCBFbmVyZ3kgU2hpZWxkIiwKICAgICAgICAiKzI5IHRvIG1heGltdW0gTWFuYSIsCiAgICAgICAgIk1pbmlvbnMgaGF2ZSAyMCUgcmVkdWNlZCBtYXhpbXVtIExpZmUiLAogICAgICAgICJNaW5pb25zIGRlYWwgMTUlIGluY3JlYXNlZCBEYW1hZ2UiLAogICAgICAgICIrMSB0byBtYXhpbXVtIG51bWJlciBvZiBTcGVjdHJlcyIsCiAgICAgICAgIk1pbmlvbnMgZ2FpbiBVbmhvbHkgTWlnaHQgZm9yIDEwIHNlY29uZHMgb24gS2lsbCIKICAgICAgXSwKICAgICAgImZsYXZvdXJUZXh0IjogWwogICAgICAgICJSZWFwIHdoYXQgb3RoZXJzIGhhdmUgc293blxyIiwKICAgICAgICAiTXVzdGVyIHRoZW0gZnJvbSB0aGVpciBncmF2ZXNcciIsCiAgICAgICAgIlBhcmFkZSB0aGVtIGZvciB5b3VyIHBsZWFzdXJlXHIiLAogICAgICAgICJaZWFsb3RzIGluIG1vcnRpcyBlbnNsYXZlZCIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDMsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiQm9keUFybW91ciIsCiAgICAgICJzb2NrZXRlZEl0ZW1zIjogWwogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL1JhaXNlU3BlY3RyZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MjQ3ODlhOWVjYWE1ODdiNDE4OGEwMjcyZDBhYWQ1YWIiLAogICAgICAgICAgInN1cHBvcnQiOiBmYWxzZSwKICAgICAgICAgICJpZCI6ICI5MjkzNGU3MGM2NzM2ZTAyZWFkZWZiOWYxMzNkZTM1NjgxZTFhMzAzNDEyMzg2MWQzMzE4YjExMThjMDIzNDRiIiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiUmFpc2UgU3BlY3RyZSIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlNwZWxsLCBNaW5pb24iLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjIwIChNYXgpIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1hbmEgQ29zdCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjI4IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ2FzdCBUaW1lIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMC44NSBzZWMiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjE1NSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiUmFpc2VzIGEgc3BlY3RyYWwgdmVyc2lvbiBvZiBhIGRlZmVhdGVkIGZvZSBhcyBhIG1pbmlvbiB0byBmaWdodCBmb3IgeW91IGluIGJhdHRsZS4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIk1pbmlvbnMgaGF2ZSArMzAlIHRvIGFsbCBFbGVtZW50YWwgUmVzaXN0YW5jZXMiLAogICAgICAgICAgICAiTWF4aW11bSAyIFJhaXNlZCBTcGVjdHJlcyIsCiAgICAgICAgICAgICI1NSUgbW9yZSBNaW5pb24gTW92ZW1lbnQgU3BlZWQiLAogICAgICAgICAgICAiTWluaW9uIE1vdmVtZW50IFNwZWVkIGlzIENhcHBlZCIsCiAgICAgICAgICAgICJSYWlzZWQgU3BlY3RyZXMgYXJlIExldmVsIDgwIiwKICAgICAgICAgICAgIk1pbmlvbnMgaGF2ZSAxMTA5IGFkZGl0aW9uYWwgQWNjdXJhY3kgUmF0aW5nIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDAsCiAgICAgICAgICAiY29sb3VyIjogIkkiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9TdXBwb3J0L01pbmlvbkRhbWFnZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MTFhNjI5MGU3NTk4MzcxZjgwNzUwYTAzNmMwN2NjMzgiLAogICAgICAgICAgInN1cHBvcnQiOiB0cnVlLAogICAgICAgICAgImlkIjogIjc0MDU1NjYzMGUwNTNlN2I5ZWE3YWRiY2UwYzA3ZDk2MWYxYjNlMGMxZTNiMTU0ZDdjZTJjNjY5NjA5NGZkMjAiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJNaW5pb24gRGFtYWdlIFN1cHBvcnQiLAogICAgICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAgICAgImlsdmwiOiAwLAogICAgICAgICAgImNvcnJ1cHRlZCI6IHRydWUsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlN1cHBvcnQsIE1pbmlvbiIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjEgKE1heCkiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWFuYSBNdWx0aXBsaWVyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTMwJSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcyIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTE0IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdXBwb3J0cyBza2lsbHMgd2hpY2ggY3JlYXRlIE1pbmlvbnMuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJNaW5pb25zIGZyb20gU3VwcG9ydGVkIFNraWxscyBkZWFsIDUwJSBtb3JlIERhbWFnZSIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMSwKICAgICAgICAgICJjb2xvdXIiOiAiSSIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL1N1cHBvcnQvRWxlbWVudGFsRm9jdXMucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTkwYzA1ZWRhNjdmMTFhNDNiOTVkMjJkN2Q3NzBkMTE1IiwKICAgICAgICAgICJzdXBwb3J0IjogdHJ1ZSwKICAgICAgICAgICJpZCI6ICI0NzU0NTYxZTYwMGM1OTI1ZGE1MjkwYTYxZjFlNWU2MjhjMTUyYzFkZmIzNzYzMDY2YmI1ZmZkMmNmMjliNjk4IiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiRWxlbWVudGFsIEZvY3VzIFN1cHBvcnQiLAogICAgICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAgICAgImlsdmwiOiAwLAogICAgICAgICAgImNvcnJ1cHRlZCI6IHRydWUsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlN1cHBvcnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjIwIChNYXgpIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1hbmEgTXVsdGlwbGllciIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEzMCUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJRdWFsaXR5IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiKzYlIiwKICAgICAgICAgICAgICAgICAgMQogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDYKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTExIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIG9yIGJ1cm5zIGVuZW1pZXMuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgNDklIG1vcmUgRWxlbWVudGFsIERhbWFnZSIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGNhbm5vdCBpbmZsaWN0IEVsZW1lbnRhbCBBaWxtZW50cyIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMyUgaW5jcmVhc2VkIEVsZW1lbnRhbCBEYW1hZ2UiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDIsCiAgICAgICAgICAiY29sb3VyIjogIkkiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9TdXBwb3J0L0dyZWF0ZXJNdWx0aXBsZVByb2plY3RpbGVzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMWI2ZmIyNmU1OTM3OTU3ZTA4Y2U4MGUxMTNmNDFlMiIsCiAgICAgICAgICAic3VwcG9ydCI6IHRydWUsCiAgICAgICAgICAiaWQiOiAiNDcxYWIyNWJlMWM5MzdmN2Y5ZTlhYzI2Nzc4YmYzMmM5ZDkyODI5OThmN2Y5ZWEwY2MwNjBhNmViOWU0YjJmMyIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkdyZWF0ZXIgTXVsdGlwbGUgUHJvamVjdGlsZXMgU3VwcG9ydCIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAiY29ycnVwdGVkIjogdHJ1ZSwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3VwcG9ydCwgUHJvamVjdGlsZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjAgKE1heCkiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWFuYSBNdWx0aXBsaWVyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTY1JSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRGV4IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTExIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdXBwb3J0cyBwcm9qZWN0aWxlIHNraWxscy4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAyNiUgbGVzcyBQcm9qZWN0aWxlIERhbWFnZSIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGZpcmUgNCBhZGRpdGlvbmFsIFByb2plY3RpbGVzIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAzLAogICAgICAgICAgImNvbG91ciI6ICJEIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvU3VwcG9ydC9FY2hvLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0xNzI2NWZiMTIyNjNmY2EzNDFkZGM0NDNhMjQyZDMwNiIsCiAgICAgICAgICAic3VwcG9ydCI6IHRydWUsCiAgICAgICAgICAiaWQiOiAiN2UwYmU5ZjA5ZmYyMzM3N2VkY2EzOTdlZDIxN2Y3MDgyNWMxZGE2MjQ3NGJhOTM2MGRkMGZjZjc0ODA1OGVhMSIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIlNwZWxsIEVjaG8gU3VwcG9ydCIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAiY29ycnVwdGVkIjogdHJ1ZSwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3BlbGwsIFN1cHBvcnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjIwIChNYXgpIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1hbmEgTXVsdGlwbGllciIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjE0MCUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJRdWFsaXR5IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiKzUlIiwKICAgICAgICAgICAgICAgICAgMQogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDYKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTExIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdXBwb3J0cyBzcGVsbCBza2lsbHMsIG1ha2luZyB0aGVtIHJlcGVhdCB3aGVuIGNhc3QuIENhbm5vdCBzdXBwb3J0IFZhYWwgc2tpbGxzLCB0b3RlbSBza2lsbHMsIGNoYW5uZWxsaW5nIHNraWxscywgdHJpZ2dlcmVkIHNraWxscywgaW5zdGFudCBza2lsbHMsIG9yIHNraWxscyB3aGljaCByZXNlcnZlIG1hbmEuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMTAlIGxlc3MgRGFtYWdlIiwKICAgICAgICAgICAgIlN1cHBvcnRlZCBTa2lsbHMgUmVwZWF0IGFuIGFkZGl0aW9uYWwgdGltZSIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGhhdmUgNzAlIG1vcmUgQ2FzdCBTcGVlZCIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMiUgaW5jcmVhc2VkIFNwZWxsIERhbWFnZSIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogNCwKICAgICAgICAgICJjb2xvdXIiOiAiSSIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL0NvbnZvY2F0aW9uLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0yODAyMzc0ZTYwNjM0NjA4ZjNkMjY2MDcyZjk3ZGY0MSIsCiAgICAgICAgICAic3VwcG9ydCI6IGZhbHNlLAogICAgICAgICAgImlkIjogIjdjZWIyZTIwMTQ1YTQ3YWE2ZmRkY2Q4NzFlYzg4YzczM2U1ZDYwZDMyMzc2ZGNlYTJhOTFlY2JjZmU2YzQ5MmMiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJDb252b2NhdGlvbiIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1pbmlvbiwgU3BlbGwsIER1cmF0aW9uIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMyIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNvb2xkb3duIFRpbWUiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIzLjAwIHNlYyIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIkluc3RhbnQiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjE1NSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiUmVjYWxscyBhbGwgbWluaW9ucyB0aGF0IGFyZSBmb2xsb3dpbmcgeW91IHRvIHlvdXIgbG9jYXRpb24sIGFuZCBncmFudHMgdGhlbSBhIHRlbXBvcmFyeSBsaWZlIHJlZ2VuZXJhdGlvbiBlZmZlY3QuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJCYXNlIGR1cmF0aW9uIGlzIDIuMDAgc2Vjb25kcyIsCiAgICAgICAgICAgICJSZWdlbmVyYXRlIDEuNjUlIG9mIExpZmUgcGVyIHNlY29uZCIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiA1LAogICAgICAgICAgImNvbG91ciI6ICJJIgogICAgICAgIH0KICAgICAgXQogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMiwKICAgICAgImgiOiAxLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0JlbHRzL0Jpc2Nvc0xlYXNoLnBuZz9zY2FsZT0xJnc9MiZoPTEmdj03NTMyOWU1ZjI4NTVkNTViZTQ1OTNhMzBhYWVhZDlmZCIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiYTgwOThjOTBhOWIzMGNiNTM0ODM3NmJiMjI3NWViZWRiZmI0YzIzMGMwNjg5ZjI4ODcwN2UzMGE0MzdlMzMzZSIsCiAgICAgICJuYW1lIjogIkJpc2NvJ3MgTGVhc2giLAogICAgICAidHlwZUxpbmUiOiAiSGVhdnkgQmVsdCIsCiAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgImlsdmwiOiA4NCwKICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjMwIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgfQogICAgICBdLAogICAgICAiaW1wbGljaXRNb2RzIjogWwogICAgICAgICIrMjcgdG8gU3RyZW5ndGgiCiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIjUlIGluY3JlYXNlZCBRdWFudGl0eSBvZiBJdGVtcyBmb3VuZCIsCiAgICAgICAgIisyOSUgdG8gQ29sZCBSZXNpc3RhbmNlIiwKICAgICAgICAiMSUgaW5jcmVhc2VkIFJhcml0eSBvZiBJdGVtcyBmb3VuZCBwZXIgMTUgUmFtcGFnZSBLaWxscyIsCiAgICAgICAgIlJhbXBhZ2UiCiAgICAgIF0sCiAgICAgICJmbGF2b3VyVGV4dCI6IFsKICAgICAgICAiWW91IHdlcmUgYWx3YXlzIHRoZXJlLCBieSBteSBzaWRlLFxyIiwKICAgICAgICAid2hldGhlciB3ZSBzYXQgb3IgcGxheWVkLlxyIiwKICAgICAgICAiT3VyIGZyaWVuZHNoaXAgd2FzIGEgam95ZnVsIHJpZGUsXHIiLAogICAgICAgICJJIHdpc2ggeW91IGNvdWxkIGhhdmUgc3RheWVkLiIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDMsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiQmVsdCIKICAgIH0sCiAgICB7CiAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAidyI6IDIsCiAgICAgICJoIjogMiwKICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9XZWFwb25zL09uZUhhbmRXZWFwb25zL0NsYXdzL0NsYXdzaGllbGQzLnBuZz9zY2FsZT0xJnc9MiZoPTImdj02YmNiMTA2MzFjNTFjNGFlYWYxMTM0NWJiNjA0MWVkMSIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiNDA1MWViNmU0NjQxMDVmMDE5MjUxMjhmODdmMTNjZmVmMjAzZmFkZjU2YzgyNTNmYzYxNjkxNTUxNGE1Y2QwOCIsCiAgICAgICJzb2NrZXRzIjogWwogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJTIiwKICAgICAgICAgICJzQ29sb3VyIjogIlIiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiSSIsCiAgICAgICAgICAic0NvbG91ciI6ICJCIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMSwKICAgICAgICAgICJhdHRyIjogIkQiLAogICAgICAgICAgInNDb2xvdXIiOiAiRyIKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJuYW1lIjogIkFkdmFuY2luZyBGb3J0cmVzcyIsCiAgICAgICJ0eXBlTGluZSI6ICJHdXQgUmlwcGVyIiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDU1LAogICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJDbGF3IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlF1YWxpdHkiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIrMjAlIiwKICAgICAgICAgICAgICAxCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiA2CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJQaHlzaWNhbCBEYW1hZ2UiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI0NC0xMTciLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDkKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI2LjMwJSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogMTIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkF0dGFja3MgcGVyIFNlY29uZCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjEuNTAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDEzCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJXZWFwb24gUmFuZ2UiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIxMSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogMTQKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiU3RyIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkRleCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjk4IiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJJbnQiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIxNTEiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJpbXBsaWNpdE1vZHMiOiBbCiAgICAgICAgIis0NCBMaWZlIGdhaW5lZCBmb3IgZWFjaCBFbmVteSBoaXQgYnkgQXR0YWNrcyIKICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiU29ja2V0ZWQgR2VtcyBhcmUgU3VwcG9ydGVkIGJ5IExldmVsIDEyIEZvcnRpZnkiLAogICAgICAgICIxNSUgQ2hhbmNlIHRvIEJsb2NrIEF0dGFjayBEYW1hZ2UiLAogICAgICAgICIxMDElIGluY3JlYXNlZCBQaHlzaWNhbCBEYW1hZ2UiLAogICAgICAgICIrMTEwIHRvIEV2YXNpb24gUmF0aW5nIiwKICAgICAgICAiKzM1IHRvIG1heGltdW0gRW5lcmd5IFNoaWVsZCIsCiAgICAgICAgIis0NiB0byBtYXhpbXVtIExpZmUiLAogICAgICAgICJSZWZsZWN0cyA4MiBQaHlzaWNhbCBEYW1hZ2UgdG8gTWVsZWUgQXR0YWNrZXJzIgogICAgICBdLAogICAgICAiZmxhdm91clRleHQiOiBbCiAgICAgICAgIlwiQSBtYW4gY293ZXJzIGJlaGluZCBoaXMgd2FsbHMuXHIiLAogICAgICAgICJBIHdvbWFuIGNhcnJpZXMgaGVyIGZvcnRyZXNzIHdpdGggaGVyLlxyIiwKICAgICAgICAiSW4gaGVhcnQsIGluIG1pbmQsIGluIGhhbmQuXCJcciIsCiAgICAgICAgIi0gU2VraGVtYSBEZXNocmV0IgogICAgICBdLAogICAgICAiZnJhbWVUeXBlIjogMywKICAgICAgIngiOiAwLAogICAgICAieSI6IDAsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJXZWFwb24iLAogICAgICAic29ja2V0ZWRJdGVtcyI6IFsKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9TdXBwb3J0L0Nhc3RPbkRtZ1Rha2VuLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMDNjZWIxYTMxZTQ1N2RiZmUzMGU5NjhjNzZmYjBkNiIsCiAgICAgICAgICAic3VwcG9ydCI6IHRydWUsCiAgICAgICAgICAiaWQiOiAiODU2NWVlOTRjYWQ1MWM0YTc5NDY3NmNkYTVmYmJiZjM2ZTk3ZTc1NWI0NmExOWI3YjA4Y2U4MDExYzhlODZjNSIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkNhc3Qgd2hlbiBEYW1hZ2UgVGFrZW4gU3VwcG9ydCIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlN1cHBvcnQsIFNwZWxsLCBUcmlnZ2VyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJDb29sZG93biBUaW1lIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMC4yNSBzZWMiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlN0ciIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNDgiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIkVhY2ggc3VwcG9ydGVkIHNwZWxsIHNraWxsIHdpbGwgdHJhY2sgZGFtYWdlIHlvdSB0YWtlLCBhbmQgYmUgdHJpZ2dlcmVkIHdoZW4gdGhlIHRvdGFsIGRhbWFnZSB0YWtlbiByZWFjaGVzIGEgdGhyZXNob2xkLiBDYW5ub3Qgc3VwcG9ydCBza2lsbHMgdXNlZCBieSB0b3RlbXMsIHRyYXBzLCBvciBtaW5lcy4gVmFhbCBza2lsbHMsIGNoYW5uZWxsaW5nIHNraWxscywgYW5kIHNraWxscyB0aGF0IHJlc2VydmUgbWFuYSBjYW5ub3QgYmUgdHJpZ2dlcmVkLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiVGhpcyBHZW0gY2FuIG9ubHkgU3VwcG9ydCBTa2lsbCBHZW1zIHJlcXVpcmluZyBMZXZlbCA3MCBvciBsb3dlciIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgNiUgbW9yZSBEYW1hZ2UiLAogICAgICAgICAgICAiWW91IGNhbm5vdCBDYXN0IFN1cHBvcnRlZCBUcmlnZ2VyYWJsZSBTcGVsbHMgZGlyZWN0bHkiLAogICAgICAgICAgICAiVHJpZ2dlciBTdXBwb3J0ZWQgU3BlbGxzIHdoZW4geW91IHRha2UgYSB0b3RhbCBvZiAzMjcyIERhbWFnZSIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMCwKICAgICAgICAgICJjb2xvdXIiOiAiUyIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL0JvbmVPZmZlcmluZy5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NTYxZGRjNmYxNjNhMTc1NjIxOWNmZGU3OGM1MjUzYmIiLAogICAgICAgICAgInN1cHBvcnQiOiBmYWxzZSwKICAgICAgICAgICJpZCI6ICIwYWJlYzA3NTZjYTZmODAyNGQ3NDJiYWI3OTg0NDU1NjdhODBlMGNiNWJjYTA4ODY3YTZiODZlZTQ1NWY3ZTE0IiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiQm9uZSBPZmZlcmluZyIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAiY29ycnVwdGVkIjogdHJ1ZSwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWluaW9uLCBTcGVsbCwgRHVyYXRpb24iLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjE5IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1hbmEgQ29zdCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjMyIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ2FzdCBUaW1lIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMS4wMCBzZWMiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAiYWRkaXRpb25hbFByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJFeHBlcmllbmNlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTU3MDM5NS8yMTIwNDYwMTciLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAyLAogICAgICAgICAgICAgICJwcm9ncmVzcyI6IDAuMDA3NDA1OTE2MjI4ODkwNDE5LAogICAgICAgICAgICAgICJ0eXBlIjogMjAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjY4IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTUxIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJDb25zdW1lcyBhIGNvcnBzZSwgZ3JhbnRpbmcgYWxsIG9mIHlvdXIgbWluaW9ucyB0aGUgcG93ZXIgdG8gYmxvY2sgYm90aCBhdHRhY2tzIGFuZCBzcGVsbHMuIFRoZSBza2lsbCBjb25zdW1lcyBvdGhlciBuZWFyYnkgY29ycHNlcywgaW5jcmVhc2luZyB0aGUgZHVyYXRpb24gZm9yIGVhY2ggY29ycHNlIGNvbnN1bWVkLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiTWluaW9ucyByZWNvdmVyIDUyOCBMaWZlIHdoZW4gdGhleSBCbG9jayIsCiAgICAgICAgICAgICJCYXNlIGR1cmF0aW9uIGlzIDUuMDAgc2Vjb25kcyIsCiAgICAgICAgICAgICJBZGRpdGlvbmFsIDEuMDAgc2Vjb25kcyBCYXNlIER1cmF0aW9uIHBlciBleHRyYSBjb3Jwc2UgQ29uc3VtZWQiLAogICAgICAgICAgICAiR3JhbnRzIE1pbmlvbnMgKzM0JSBDaGFuY2UgdG8gQmxvY2sgQXR0YWNrIERhbWFnZSIsCiAgICAgICAgICAgICJHcmFudHMgTWluaW9ucyArMzQlIENoYW5jZSB0byBCbG9jayBTcGVsbCBEYW1hZ2UiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMSwKICAgICAgICAgICJjb2xvdXIiOiAiSSIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL0Rlc2VjcmF0ZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9ZGZjMmE3NDY4MDk5NGM5ZDlmMDBiNzg3MmIwN2Y3NmIiLAogICAgICAgICAgInN1cHBvcnQiOiBmYWxzZSwKICAgICAgICAgICJpZCI6ICJhYjE4NGMwYTZkYjU3MTA3MzMwODA5OGQ2MTMxZjUwMmVhZjViZGY0MTJiMjk1ZTdlMjVjNTQ3MGRhNzFmYWNlIiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiRGVzZWNyYXRlIiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3BlbGwsIEFvRSwgRHVyYXRpb24sIENoYW9zIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyNiIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjAuNjAgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJEZXgiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI5OCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjY4IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJEZXNlY3JhdGVzIHRoZSBncm91bmQsIHN1bW1vbmluZyBjb3Jwc2VzIGFuZCBkZWFsaW5nIGNoYW9zIGRhbWFnZSB0byBhbGwgZW5lbWllcyBpbiB0aGUgYXJlYS4gVGhlIGNvcnBzZXMgd2lsbCBiZSBjaG9zZW4gZnJvbSB0aGUgbW9uc3RlcnMgaW4gdGhlIGN1cnJlbnQgYXJlYSBhbmQgYW55IFNwZWN0cmVzIHRoYXQgaGF2ZSBleGlzdGVkIGluIHRoaXMgaW5zdGFuY2UuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJEZWFscyAyOTQgQmFzZSBDaGFvcyBEYW1hZ2UgcGVyIHNlY29uZCIsCiAgICAgICAgICAgICJCYXNlIGR1cmF0aW9uIGlzIDQuMDAgc2Vjb25kcyIsCiAgICAgICAgICAgICJTcGF3bnMgNSBjb3Jwc2VzIiwKICAgICAgICAgICAgIlNwYXducyBjb3Jwc2VzIHVwIHRvIExldmVsIDEwMCIsCiAgICAgICAgICAgICJNYXhpbXVtIG9mIDEwIGNvcnBzZXMgYWxsb3dlZCIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAyLAogICAgICAgICAgImNvbG91ciI6ICJEIgogICAgICAgIH0KICAgICAgXQogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMSwKICAgICAgImgiOiAyLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2dlbi9pbWFnZS9XemtzTkN4N0ltWWlPaUpCY25SY0x6SkVTWFJsYlhOY0wwWnNZWE5yYzF3dmJXRnVZV1pzWVhOck1UQnZiR1FpTENKemNDSTZNQzQyTURnMUxDSnNaWFpsYkNJNk1YMWQvMmExYTRiZmQ1OS9JdGVtLnBuZyIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiZDVhZDI0MWRmMmFiY2Q1OWI0YjgwMGFhZTg1YWQ0OGE4ZTM5ZGU5MjZlMzMxNjJjMDYyZDljZTkzMTkxZWQ0NCIsCiAgICAgICJuYW1lIjogIiIsCiAgICAgICJ0eXBlTGluZSI6ICJCdWJibGluZyBEaXZpbmUgTWFuYSBGbGFzayBvZiBIZWF0IiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDYyLAogICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJSZWNvdmVycyAlMCBNYW5hIG92ZXIgJTEgU2Vjb25kcyIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjcwMCIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdLAogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjIuMTAiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDMKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiOCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdLAogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjQyIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAzCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJDdXJyZW50bHkgaGFzICUwIENoYXJnZXMiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI0MiIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMwogICAgICAgIH0KICAgICAgXSwKICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjYwIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgfQogICAgICBdLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICI1MCUgcmVkdWNlZCBBbW91bnQgUmVjb3ZlcmVkIiwKICAgICAgICAiMTM1JSBpbmNyZWFzZWQgUmVjb3ZlcnkgcmF0ZSIsCiAgICAgICAgIjUwJSBvZiBSZWNvdmVyeSBhcHBsaWVkIEluc3RhbnRseSIsCiAgICAgICAgIkltbXVuaXR5IHRvIEZyZWV6ZSBhbmQgQ2hpbGwgZHVyaW5nIEZsYXNrIGVmZmVjdFxuUmVtb3ZlcyBGcmVlemUgYW5kIENoaWxsIG9uIHVzZSIKICAgICAgXSwKICAgICAgImRlc2NyVGV4dCI6ICJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwKICAgICAgImZyYW1lVHlwZSI6IDEsCiAgICAgICJ4IjogMywKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiRmxhc2siCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDIsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vZ2VuL2ltYWdlL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YzJGd2NHaHBjbVVpTENKemNDSTZNQzQyTURnMUxDSnNaWFpsYkNJNk1YMWQvMDlkODNjNjJhZC9JdGVtLnBuZyIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiMTZkNzY1NGVmNWFkMTYxMGZhZTllM2UyYjk5YmZiNDUyZDc2MTZlOTM2NTVjNmVkMjljZWJmYmNhYWMwMWQzYiIsCiAgICAgICJuYW1lIjogIiIsCiAgICAgICJ0eXBlTGluZSI6ICJFeHBlcmltZW50ZXIncyBTYXBwaGlyZSBGbGFzayBvZiBIZWF0IiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDc0LAogICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMYXN0cyAlMCBTZWNvbmRzIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNS42MCIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMwogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiQ29uc3VtZXMgJTAgb2YgJTEgQ2hhcmdlcyBvbiB1c2UiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIzMCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdLAogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjYwIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAzCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJDdXJyZW50bHkgaGFzICUwIENoYXJnZXMiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI2MCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMwogICAgICAgIH0KICAgICAgXSwKICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjE4IiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgfQogICAgICBdLAogICAgICAidXRpbGl0eU1vZHMiOiBbCiAgICAgICAgIis1MCUgdG8gQ29sZCBSZXNpc3RhbmNlIiwKICAgICAgICAiMjAlIGxlc3MgQ29sZCBEYW1hZ2UgdGFrZW4iCiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIkltbXVuaXR5IHRvIEZyZWV6ZSBhbmQgQ2hpbGwgZHVyaW5nIEZsYXNrIGVmZmVjdFxuUmVtb3ZlcyBGcmVlemUgYW5kIENoaWxsIG9uIHVzZSIsCiAgICAgICAgIjQwJSBpbmNyZWFzZWQgRHVyYXRpb24iCiAgICAgIF0sCiAgICAgICJkZXNjclRleHQiOiAiUmlnaHQgY2xpY2sgdG8gZHJpbmsuIENhbiBvbmx5IGhvbGQgY2hhcmdlcyB3aGlsZSBpbiBiZWx0LiBSZWZpbGxzIGFzIHlvdSBraWxsIG1vbnN0ZXJzLiIsCiAgICAgICJmcmFtZVR5cGUiOiAxLAogICAgICAieCI6IDEsCiAgICAgICJ5IjogMCwKICAgICAgImludmVudG9yeUlkIjogIkZsYXNrIgogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMSwKICAgICAgImgiOiAzLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL1dlYXBvbnMvT25lSGFuZFdlYXBvbnMvRGFnZ2Vycy9EYWdnZXI3LnBuZz9zY2FsZT0xJnc9MSZoPTMmdj0yNTI0ZjUzNThkOWM5ZjQ2ZDQwYzZkZmU2MWMzZjE3ZSIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiYTJmYjQ2N2M4NTc1MmViNTFjYzFhYzc1MmIyYzg0NmU1ZjY3NzhlNDMwZmM4ZjAxNWZiOTY3YzQ2MjVjNzE3NSIsCiAgICAgICJzb2NrZXRzIjogWwogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJEIiwKICAgICAgICAgICJzQ29sb3VyIjogIkciCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiRCIsCiAgICAgICAgICAic0NvbG91ciI6ICJHIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMSwKICAgICAgICAgICJhdHRyIjogIkkiLAogICAgICAgICAgInNDb2xvdXIiOiAiQiIKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJuYW1lIjogIiIsCiAgICAgICJ0eXBlTGluZSI6ICJSb3lhbCBTa2VhbiIsCiAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgImlsdmwiOiA1MCwKICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiUnVuZSBEYWdnZXIiLAogICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiUGh5c2ljYWwgRGFtYWdlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTYtNjQiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDkKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI2LjMwJSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogMTIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkF0dGFja3MgcGVyIFNlY29uZCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjEuNDUiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDEzCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJXZWFwb24gUmFuZ2UiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIxMCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogMTQKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI2OCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiRGV4IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiOTUiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjE1MSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgIH0KICAgICAgXSwKICAgICAgImltcGxpY2l0TW9kcyI6IFsKICAgICAgICAiMzAlIGluY3JlYXNlZCBHbG9iYWwgQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDAsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiV2VhcG9uMiIsCiAgICAgICJzb2NrZXRlZEl0ZW1zIjogWwogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL0JvbmVMYW5jZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NWEwNjU2NzcyNjIzZTQyZTYzNWYyODIxMWUzODUwMTciLAogICAgICAgICAgInN1cHBvcnQiOiBmYWxzZSwKICAgICAgICAgICJpZCI6ICJkYTQzZDdkZmUwYzY1MTYyNWNiNjY2NWE0ZDNhYzdhMmVmZGM4MzdjM2JjYjA0YjFmMDM2OWUyNTEzODYzMWM3IiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiVW5lYXJ0aCIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlNwZWxsLCBQcm9qZWN0aWxlLCBBb0UsIFBoeXNpY2FsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxOSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxOSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjAuNjAgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjYuMDAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRWZmZWN0aXZlbmVzcyBvZiBBZGRlZCBEYW1hZ2UiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMjUlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRXhwZXJpZW5jZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjc5NTg0OTc1LzIxMjA1MTU5OSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDIsCiAgICAgICAgICAgICAgInByb2dyZXNzIjogMC4zNzUzMDk0NjczMTU2NzM4MywKICAgICAgICAgICAgICAidHlwZSI6IDIwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI2OCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkRleCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjk1IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNjYiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIkZpcmVzIGEgcHJvamVjdGlsZSB0aGF0IHdpbGwgcGllcmNlIHRocm91Z2ggZW5lbWllcyB0byBpbXBhY3QgdGhlIGdyb3VuZCBhdCB0aGUgdGFyZ2V0ZWQgbG9jYXRpb24sIGNyZWF0aW5nIGEgQm9uZSBBcmNoZXIgY29ycHNlIHdoZXJlIGl0IGxhbmRzLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiRGVhbHMgNjI0IHRvIDkzNiBQaHlzaWNhbCBEYW1hZ2UiLAogICAgICAgICAgICAiUHJvamVjdGlsZXMgUGllcmNlIGFsbCBUYXJnZXRzIiwKICAgICAgICAgICAgIlNwYXducyBjb3Jwc2VzIHdpdGggTGV2ZWwgNzciLAogICAgICAgICAgICAiTWF4aW11bSBvZiAxMCBjb3Jwc2VzIGFsbG93ZWQiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMCwKICAgICAgICAgICJjb2xvdXIiOiAiRCIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL0JvbmVMYW5jZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NWEwNjU2NzcyNjIzZTQyZTYzNWYyODIxMWUzODUwMTciLAogICAgICAgICAgInN1cHBvcnQiOiBmYWxzZSwKICAgICAgICAgICJpZCI6ICIxYTI1MDFhNjBkNmVmMGMzYWUwM2YzMjExYTAxZTU3OWVkMzFlMzE2NDdhZjIzYTE1MTNiYzI3ZTg3ZWZlNGJiIiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiVW5lYXJ0aCIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlNwZWxsLCBQcm9qZWN0aWxlLCBBb0UsIFBoeXNpY2FsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxOSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxOSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjAuNjAgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjYuMDAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRWZmZWN0aXZlbmVzcyBvZiBBZGRlZCBEYW1hZ2UiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMjUlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRXhwZXJpZW5jZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjc5NTc0MDk1LzIxMjA1MTU5OSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDIsCiAgICAgICAgICAgICAgInByb2dyZXNzIjogMC4zNzUyNTgxNzc1MTg4NDQ2LAogICAgICAgICAgICAgICJ0eXBlIjogMjAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjY4IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRGV4IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiOTUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJJbnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI2NiIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiRmlyZXMgYSBwcm9qZWN0aWxlIHRoYXQgd2lsbCBwaWVyY2UgdGhyb3VnaCBlbmVtaWVzIHRvIGltcGFjdCB0aGUgZ3JvdW5kIGF0IHRoZSB0YXJnZXRlZCBsb2NhdGlvbiwgY3JlYXRpbmcgYSBCb25lIEFyY2hlciBjb3Jwc2Ugd2hlcmUgaXQgbGFuZHMuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJEZWFscyA2MjQgdG8gOTM2IFBoeXNpY2FsIERhbWFnZSIsCiAgICAgICAgICAgICJQcm9qZWN0aWxlcyBQaWVyY2UgYWxsIFRhcmdldHMiLAogICAgICAgICAgICAiU3Bhd25zIGNvcnBzZXMgd2l0aCBMZXZlbCA3NyIsCiAgICAgICAgICAgICJNYXhpbXVtIG9mIDEwIGNvcnBzZXMgYWxsb3dlZCIKICAgICAgICAgIF0sCiAgICAgICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAxLAogICAgICAgICAgImNvbG91ciI6ICJEIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvTGlnaHRuaW5nUmVzaXN0QXVyYS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9Y2EwZmQ4OTYxMTViNjRjNWQyMWIxMDRiNWE4NDYzMGIiLAogICAgICAgICAgInN1cHBvcnQiOiBmYWxzZSwKICAgICAgICAgICJpZCI6ICIzMTFmODVjMTcyZWZiNzBkYzI0OTc5NTE1ZWNlZmVmNGRlM2M2ODlhYTczNDRkMDNlZGEzY2ZjY2I4MDI5NWQwIiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiUHVyaXR5IG9mIExpZ2h0bmluZyIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkF1cmEsIFNwZWxsLCBBb0UsIExpZ2h0bmluZyIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTkiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWFuYSBSZXNlcnZlZCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjM1JSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNvb2xkb3duIFRpbWUiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxLjIwIHNlYyIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIkluc3RhbnQiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAiYWRkaXRpb25hbFByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJFeHBlcmllbmNlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzk5NzQ2OTcvMjExODc3NjgzIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMiwKICAgICAgICAgICAgICAicHJvZ3Jlc3MiOiAwLjM3NzQ1NjkwMzQ1NzY0MTYsCiAgICAgICAgICAgICAgInR5cGUiOiAyMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNjgiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJJbnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxNTEiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAic2VjRGVzY3JUZXh0IjogIkNhc3RzIGFuIGF1cmEgdGhhdCBncmFudHMgbGlnaHRuaW5nIHJlc2lzdGFuY2UgdG8geW91IGFuZCB5b3VyIGFsbGllcy4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIisxOCB0byByYWRpdXMiLAogICAgICAgICAgICAiWW91IGFuZCBuZWFyYnkgYWxsaWVzIGdhaW4gNDAlIGFkZGl0aW9uYWwgTGlnaHRuaW5nIFJlc2lzdGFuY2UiLAogICAgICAgICAgICAiWW91IGFuZCBuZWFyYnkgYWxsaWVzIGdhaW4gMyUgYWRkaXRpb25hbCBtYXhpbXVtIExpZ2h0bmluZyBSZXNpc3RhbmNlIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDIsCiAgICAgICAgICAiY29sb3VyIjogIkkiCiAgICAgICAgfQogICAgICBdCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDMsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvV2VhcG9ucy9PbmVIYW5kV2VhcG9ucy9PbmVIYW5kTWFjZXMvT25lSGFuZE1hY2UzLnBuZz9zY2FsZT0xJnc9MSZoPTMmdj0wZGEyMTE1MTNiNTNkYzhhY2MxYTA0MGVlMThiNjM0NyIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiODE2NmU1YTE3YjNiYzUyZjk3OWY0ZmY0MjdkMTZkZTM1YjVlMzY2MWRjMTEwYzAzNzAwOWZhODg1YzJlYWE3YyIsCiAgICAgICJzb2NrZXRzIjogWwogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJJIiwKICAgICAgICAgICJzQ29sb3VyIjogIkIiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiUyIsCiAgICAgICAgICAic0NvbG91ciI6ICJSIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMSwKICAgICAgICAgICJhdHRyIjogIlMiLAogICAgICAgICAgInNDb2xvdXIiOiAiUiIKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJuYW1lIjogIiIsCiAgICAgICJ0eXBlTGluZSI6ICJCYXJiZWQgQ2x1YiIsCiAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgImlsdmwiOiA1MCwKICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiT25lIEhhbmRlZCBNYWNlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlBoeXNpY2FsIERhbWFnZSIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjMzLTQyIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiA5CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJDcml0aWNhbCBTdHJpa2UgQ2hhbmNlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNS4wMCUiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDEyCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJBdHRhY2tzIHBlciBTZWNvbmQiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIxLjQwIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiAxMwogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiV2VhcG9uIFJhbmdlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTEiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAidHlwZSI6IDE0CiAgICAgICAgfQogICAgICBdLAogICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNjkiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlN0ciIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjEyNSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTUxIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfQogICAgICBdLAogICAgICAiaW1wbGljaXRNb2RzIjogWwogICAgICAgICIxMCUgcmVkdWNlZCBFbmVteSBTdHVuIFRocmVzaG9sZCIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDAsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiT2ZmaGFuZDIiLAogICAgICAic29ja2V0ZWRJdGVtcyI6IFsKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9MaWdodG5pbmdSZXNpc3RBdXJhLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1jYTBmZDg5NjExNWI2NGM1ZDIxYjEwNGI1YTg0NjMwYiIsCiAgICAgICAgICAic3VwcG9ydCI6IGZhbHNlLAogICAgICAgICAgImlkIjogIjUwODE1NWI0YTUxZDQwZjA2MDc2Yzk5NzUwOTBiNjcwMDQzY2JlZmY0OTllMGU2NzM3NzQxMjFhMDM4ZTUxMWIiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJQdXJpdHkgb2YgTGlnaHRuaW5nIiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQXVyYSwgU3BlbGwsIEFvRSwgTGlnaHRuaW5nIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxOSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIFJlc2VydmVkIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMzUlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ29vbGRvd24gVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEuMjAgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiQ2FzdCBUaW1lIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiSW5zdGFudCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJhZGRpdGlvbmFsUHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkV4cGVyaWVuY2UiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI3OTk3NDY5Ny8yMTE4Nzc2ODMiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAyLAogICAgICAgICAgICAgICJwcm9ncmVzcyI6IDAuMzc3NDU2OTAzNDU3NjQxNiwKICAgICAgICAgICAgICAidHlwZSI6IDIwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI2OCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjE1MSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiQ2FzdHMgYW4gYXVyYSB0aGF0IGdyYW50cyBsaWdodG5pbmcgcmVzaXN0YW5jZSB0byB5b3UgYW5kIHlvdXIgYWxsaWVzLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiKzE4IHRvIHJhZGl1cyIsCiAgICAgICAgICAgICJZb3UgYW5kIG5lYXJieSBhbGxpZXMgZ2FpbiA0MCUgYWRkaXRpb25hbCBMaWdodG5pbmcgUmVzaXN0YW5jZSIsCiAgICAgICAgICAgICJZb3UgYW5kIG5lYXJieSBhbGxpZXMgZ2FpbiAzJSBhZGRpdGlvbmFsIG1heGltdW0gTGlnaHRuaW5nIFJlc2lzdGFuY2UiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMCwKICAgICAgICAgICJjb2xvdXIiOiAiSSIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL1N1cHBvcnQvQnJ1dGFsaXR5LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMzljM2FiZWMyOTkyMzM4YWNiYmVhYzM3ZTMxZmFlNyIsCiAgICAgICAgICAic3VwcG9ydCI6IHRydWUsCiAgICAgICAgICAiaWQiOiAiOWI5YWM0MWQ3NTBkMjMwNTYxZjg0YjljMzMwMmUwN2I0Mjg4N2Q2NzYwOGEyZjU4MmRmYTJiY2Y5YTE3ZmNlMyIsCiAgICAgICAgICAibmFtZSI6ICIiLAogICAgICAgICAgInR5cGVMaW5lIjogIkJydXRhbGl0eSBTdXBwb3J0IiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiUGh5c2ljYWwsIFN1cHBvcnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjE5IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1hbmEgTXVsdGlwbGllciIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEzMCUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAiYWRkaXRpb25hbFByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJFeHBlcmllbmNlIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMzAzMzQyNDUvMTU3NDA1MDYzIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMiwKICAgICAgICAgICAgICAicHJvZ3Jlc3MiOiAwLjE5MjcxNDU0MjE1MDQ5NzQ0LAogICAgICAgICAgICAgICJ0eXBlIjogMjAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjY5IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3RyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTA5IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIGVuZW1pZXMuIiwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgNTglIG1vcmUgUGh5c2ljYWwgRGFtYWdlIiwKICAgICAgICAgICAgIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCBubyBDaGFvcyBEYW1hZ2UiLAogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBkZWFsIG5vIEVsZW1lbnRhbCBEYW1hZ2UiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDEsCiAgICAgICAgICAiY29sb3VyIjogIlMiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9TdXBwb3J0L0JydXRhbGl0eS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9ZTM5YzNhYmVjMjk5MjMzOGFjYmJlYWMzN2UzMWZhZTciLAogICAgICAgICAgInN1cHBvcnQiOiB0cnVlLAogICAgICAgICAgImlkIjogIjg3Mjk1YzJmMTk4MzlhODMzYzMwZjY1Zjg2ODUwODI5MTE0YzFhZmIwNmI2ZTI5YjUxZTFlZGI5NGM2Mzg2NDAiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJCcnV0YWxpdHkgU3VwcG9ydCIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlBoeXNpY2FsLCBTdXBwb3J0IiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxOSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIE11bHRpcGxpZXIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIxMzAlIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRXhwZXJpZW5jZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjMwMTk2MTYwLzE1NzQwNTA2MyIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDIsCiAgICAgICAgICAgICAgInByb2dyZXNzIjogMC4xOTE4MzcyOTU4ODk4NTQ0MywKICAgICAgICAgICAgICAidHlwZSI6IDIwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI2OSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlN0ciIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEwOSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiU3VwcG9ydHMgYW55IHNraWxsIHRoYXQgaGl0cyBlbmVtaWVzLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBkZWFsIDU4JSBtb3JlIFBoeXNpY2FsIERhbWFnZSIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgbm8gQ2hhb3MgRGFtYWdlIiwKICAgICAgICAgICAgIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCBubyBFbGVtZW50YWwgRGFtYWdlIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAyLAogICAgICAgICAgImNvbG91ciI6ICJTIgogICAgICAgIH0KICAgICAgXQogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMSwKICAgICAgImgiOiAxLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL1JpbmdzL1RvcGF6UnVieS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MGJkM2M3MTk3Yjk1Mjk4MWViMjFjYjBkYzI3OGMwNGQiLAogICAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICAgImlkIjogIjgxOWZiN2NlN2UxY2IwYzM5OTkxMWQwYTczMWVkZTFiNmIwNmZjYThmN2IyYTNiOWZiZDkyMzA2N2NiYTU3YzYiLAogICAgICAibmFtZSI6ICJGb2UgS251Y2tsZSIsCiAgICAgICJ0eXBlTGluZSI6ICJUd28tU3RvbmUgUmluZyIsCiAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgImlsdmwiOiA3MywKICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjQ4IiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgfQogICAgICBdLAogICAgICAiaW1wbGljaXRNb2RzIjogWwogICAgICAgICIrMTYlIHRvIEZpcmUgYW5kIExpZ2h0bmluZyBSZXNpc3RhbmNlcyIKICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiKzEyIHRvIFN0cmVuZ3RoIiwKICAgICAgICAiQWRkcyAxIHRvIDIgUGh5c2ljYWwgRGFtYWdlIHRvIEF0dGFja3MiLAogICAgICAgICIrMzklIHRvIEZpcmUgUmVzaXN0YW5jZSIsCiAgICAgICAgIiszNiUgdG8gQ29sZCBSZXNpc3RhbmNlIiwKICAgICAgICAiMC4yMyUgb2YgUGh5c2ljYWwgQXR0YWNrIERhbWFnZSBMZWVjaGVkIGFzIExpZmUiCiAgICAgIF0sCiAgICAgICJjcmFmdGVkTW9kcyI6IFsKICAgICAgICAiKzI0IHRvIG1heGltdW0gTGlmZSIKICAgICAgXSwKICAgICAgImZyYW1lVHlwZSI6IDIsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiUmluZzIiCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDIsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vZ2VuL2ltYWdlL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YkdsbVpXWnNZWE5yTVRCdmJHUWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZC9lNWU3ZmQwMTVhL0l0ZW0ucG5nIiwKICAgICAgImxlYWd1ZSI6ICJTdGFuZGFyZCIsCiAgICAgICJpZCI6ICI0NTcxZWFjNDY4NTkxNGIwN2VkZjA1MmQ0ZDVkM2I3MjM4MTFiMThiNGY3YmNjNTI0NTEzN2NmOGU5NTlmNzgxIiwKICAgICAgIm5hbWUiOiAiIiwKICAgICAgInR5cGVMaW5lIjogIlNlZXRoaW5nIERpdmluZSBMaWZlIEZsYXNrIG9mIERvdXNpbmciLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogNjMsCiAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIlF1YWxpdHkiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIrMjAlIiwKICAgICAgICAgICAgICAxCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiA2CiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJSZWNvdmVycyAlMCBMaWZlIG92ZXIgJTEgU2Vjb25kcyIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjk3OSIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdLAogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjcuMDAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDMKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTUiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXSwKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI0NSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMwogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNDUiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDMKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI2MCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0KICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiNjYlIHJlZHVjZWQgQW1vdW50IFJlY292ZXJlZCIsCiAgICAgICAgIkluc3RhbnQgUmVjb3ZlcnkiLAogICAgICAgICJJbW11bml0eSB0byBJZ25pdGUgZHVyaW5nIEZsYXNrIGVmZmVjdFxuUmVtb3ZlcyBCdXJuaW5nIG9uIHVzZSIKICAgICAgXSwKICAgICAgImRlc2NyVGV4dCI6ICJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwKICAgICAgImZyYW1lVHlwZSI6IDEsCiAgICAgICJ4IjogMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiRmxhc2siCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDIsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vZ2VuL2ltYWdlL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YzNSdmJtVWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZC9hNDhlYmRjZGVkL0l0ZW0ucG5nIiwKICAgICAgImxlYWd1ZSI6ICJTdGFuZGFyZCIsCiAgICAgICJpZCI6ICI4OWJkYmE4OTRkNWRlOGQwNzFhY2IxMzQ1MTZlMzQ5MzM2ZjI5YjAxMzBmZmIxZWYzOGI0MjMzZTNlMmY0NzQ4IiwKICAgICAgIm5hbWUiOiAiIiwKICAgICAgInR5cGVMaW5lIjogIkV4cGVyaW1lbnRlcidzIEJhc2FsdCBGbGFzayBvZiBIZWF0IiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDY4LAogICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJRdWFsaXR5IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiKzEyJSIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogNgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGFzdHMgJTAgU2Vjb25kcyIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjYuNjAiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDMKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNDAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXSwKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI2MCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMwogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNjAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDMKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI0MCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0KICAgICAgXSwKICAgICAgInV0aWxpdHlNb2RzIjogWwogICAgICAgICIxNSUgYWRkaXRpb25hbCBQaHlzaWNhbCBEYW1hZ2UgUmVkdWN0aW9uIiwKICAgICAgICAiMjAlIG9mIE1lbGVlIFBoeXNpY2FsIERhbWFnZSB0YWtlbiByZWZsZWN0ZWQgdG8gQXR0YWNrZXIiCiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIkltbXVuaXR5IHRvIEZyZWV6ZSBhbmQgQ2hpbGwgZHVyaW5nIEZsYXNrIGVmZmVjdFxuUmVtb3ZlcyBGcmVlemUgYW5kIENoaWxsIG9uIHVzZSIsCiAgICAgICAgIjM0JSBpbmNyZWFzZWQgRHVyYXRpb24iCiAgICAgIF0sCiAgICAgICJkZXNjclRleHQiOiAiUmlnaHQgY2xpY2sgdG8gZHJpbmsuIENhbiBvbmx5IGhvbGQgY2hhcmdlcyB3aGlsZSBpbiBiZWx0LiBSZWZpbGxzIGFzIHlvdSBraWxsIG1vbnN0ZXJzLiIsCiAgICAgICJmcmFtZVR5cGUiOiAxLAogICAgICAieCI6IDQsCiAgICAgICJ5IjogMCwKICAgICAgImludmVudG9yeUlkIjogIkZsYXNrIgogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMSwKICAgICAgImgiOiAyLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2dlbi9pbWFnZS9XemtzTkN4N0ltWWlPaUpCY25SY0x6SkVTWFJsYlhOY0wwWnNZWE5yYzF3dmMybHNkbVZ5SWl3aWMzQWlPakF1TmpBNE5Td2liR1YyWld3aU9qRjlYUS9iNGVkY2JhODBmL0l0ZW0ucG5nIiwKICAgICAgImxlYWd1ZSI6ICJTdGFuZGFyZCIsCiAgICAgICJpZCI6ICIzZGQwMGQ0YzVjNGYyM2MyY2UyZWM0YTI1ZjcyMjhiZTM4MmJkNWJhM2VmMDVmODc1NjI5MWUwNjk2MDc1MzIwIiwKICAgICAgIm5hbWUiOiAiIiwKICAgICAgInR5cGVMaW5lIjogIkFtcGxlIFNpbHZlciBGbGFzayBvZiBIZWF0IiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDY1LAogICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJRdWFsaXR5IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiKzIwJSIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogNgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGFzdHMgJTAgU2Vjb25kcyIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjYuMDAiLAogICAgICAgICAgICAgIDEKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDMKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiNDAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXSwKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI4MCIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMwogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiODAiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDMKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIyMiIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0KICAgICAgXSwKICAgICAgInV0aWxpdHlNb2RzIjogWwogICAgICAgICJPbnNsYXVnaHQiCiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIisyMCB0byBNYXhpbXVtIENoYXJnZXMiLAogICAgICAgICJJbW11bml0eSB0byBGcmVlemUgYW5kIENoaWxsIGR1cmluZyBGbGFzayBlZmZlY3RcblJlbW92ZXMgRnJlZXplIGFuZCBDaGlsbCBvbiB1c2UiCiAgICAgIF0sCiAgICAgICJkZXNjclRleHQiOiAiUmlnaHQgY2xpY2sgdG8gZHJpbmsuIENhbiBvbmx5IGhvbGQgY2hhcmdlcyB3aGlsZSBpbiBiZWx0LiBSZWZpbGxzIGFzIHlvdSBraWxsIG1vbnN0ZXJzLiIsCiAgICAgICJmcmFtZVR5cGUiOiAxLAogICAgICAieCI6IDIsCiAgICAgICJ5IjogMCwKICAgICAgImludmVudG9yeUlkIjogIkZsYXNrIgogICAgfSwKICAgIHsKICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICJ3IjogMSwKICAgICAgImgiOiAxLAogICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0FtdWxldHMvQW11bGV0NC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9Y2MzZWMxNjdjYTllYTEwZGZhMDViMmQ1NWI1ZGNmNjIiLAogICAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICAgImlkIjogIjdjYWIyNjgwZDY1NWQ2ZGYwZWFjNzA4ZGQ2ZTNiMzE4MDY2YzE3MDUyOTNiMWM0NDgxMGE1ODQwNmQzYzc1NDAiLAogICAgICAibmFtZSI6ICJEYW1uYXRpb24gTWVkYWxsaW9uIiwKICAgICAgInR5cGVMaW5lIjogIkphZGUgQW11bGV0IiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDcwLAogICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMzkiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJpbXBsaWNpdE1vZHMiOiBbCiAgICAgICAgIisyNSB0byBEZXh0ZXJpdHkiCiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIkFkZHMgMSB0byAyOCBMaWdodG5pbmcgRGFtYWdlIHRvIEF0dGFja3MiLAogICAgICAgICIzJSBpbmNyZWFzZWQgbWF4aW11bSBFbmVyZ3kgU2hpZWxkIiwKICAgICAgICAiKzYlIHRvIGFsbCBFbGVtZW50YWwgUmVzaXN0YW5jZXMiLAogICAgICAgICIrMzUlIHRvIExpZ2h0bmluZyBSZXNpc3RhbmNlIiwKICAgICAgICAiKzMgTWFuYSBnYWluZWQgb24gS2lsbCIKICAgICAgXSwKICAgICAgImNyYWZ0ZWRNb2RzIjogWwogICAgICAgICIrMzAgdG8gbWF4aW11bSBMaWZlIgogICAgICBdLAogICAgICAiZnJhbWVUeXBlIjogMiwKICAgICAgIngiOiAwLAogICAgICAieSI6IDAsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJBbXVsZXQiCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAyLAogICAgICAiaCI6IDIsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvQXJtb3Vycy9Cb290cy9ib290dW5pcXVlLnBuZz9zY2FsZT0xJnc9MiZoPTImdj01YjU4MWMyY2I3ZDc3ZDA2M2IzNmUwOGY1OWFkZWE3ZSIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiYTFkNjgxZDRhMzFlMjM0MGVmODlkNTZkYTk4OTUzZDE3NDBjMWZhZTJkNDVmY2YyNTAzYTkwM2VhMjE2NWM3MyIsCiAgICAgICJzb2NrZXRzIjogWwogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJEIiwKICAgICAgICAgICJzQ29sb3VyIjogIkciCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAiZ3JvdXAiOiAwLAogICAgICAgICAgImF0dHIiOiAiSSIsCiAgICAgICAgICAic0NvbG91ciI6ICJCIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgImdyb3VwIjogMCwKICAgICAgICAgICJhdHRyIjogIkkiLAogICAgICAgICAgInNDb2xvdXIiOiAiQiIKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJncm91cCI6IDAsCiAgICAgICAgICAiYXR0ciI6ICJTIiwKICAgICAgICAgICJzQ29sb3VyIjogIlIiCiAgICAgICAgfQogICAgICBdLAogICAgICAibmFtZSI6ICJCb25lcyBvZiBVbGxyIiwKICAgICAgInR5cGVMaW5lIjogIlNpbGsgU2xpcHBlcnMiLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogMzMsCiAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkVuZXJneSBTaGllbGQiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICIyMiIsCiAgICAgICAgICAgICAgMQogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICJ0eXBlIjogMTgKICAgICAgICB9CiAgICAgIF0sCiAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgWwogICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiU3RyIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiOTgiLAogICAgICAgICAgICAgIDAKICAgICAgICAgICAgXQogICAgICAgICAgXSwKICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICB9LAogICAgICAgIHsKICAgICAgICAgICJuYW1lIjogIkRleCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjExMSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiSW50IiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMTExIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAxCiAgICAgICAgfQogICAgICBdLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICI0OSUgaW5jcmVhc2VkIEVuZXJneSBTaGllbGQiLAogICAgICAgICIrMjAgdG8gbWF4aW11bSBMaWZlIiwKICAgICAgICAiKzIwIHRvIG1heGltdW0gTWFuYSIsCiAgICAgICAgIisxIHRvIExldmVsIG9mIGFsbCBSYWlzZSBab21iaWUgR2VtcyIsCiAgICAgICAgIisxIHRvIExldmVsIG9mIGFsbCBSYWlzZSBTcGVjdHJlIEdlbXMiLAogICAgICAgICI1JSBpbmNyZWFzZWQgTW92ZW1lbnQgU3BlZWQiCiAgICAgIF0sCiAgICAgICJlbmNoYW50TW9kcyI6IFsKICAgICAgICAiQWRkcyAxNiB0byAyNCBDb2xkIERhbWFnZSBpZiB5b3UndmUgYmVlbiBIaXQgUmVjZW50bHkiCiAgICAgIF0sCiAgICAgICJmbGF2b3VyVGV4dCI6IFsKICAgICAgICAiVGhlIGRlYWQgbWFuIHdhbGtzIHdoZXJlXHIiLAogICAgICAgICJ0aGUgbGl2aW5nIGZlYXIgdG8gdHJlYWQuIgogICAgICBdLAogICAgICAiZnJhbWVUeXBlIjogMywKICAgICAgIngiOiAwLAogICAgICAieSI6IDAsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJCb290cyIsCiAgICAgICJzb2NrZXRlZEl0ZW1zIjogWwogICAgICAgIHsKICAgICAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAgICAgInciOiAxLAogICAgICAgICAgImgiOiAxLAogICAgICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9HZW1zL1N1cHBvcnQvR3JlYXRlck11bHRpcGxlUHJvamVjdGlsZXMucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PWUxYjZmYjI2ZTU5Mzc5NTdlMDhjZTgwZTExM2Y0MWUyIiwKICAgICAgICAgICJzdXBwb3J0IjogdHJ1ZSwKICAgICAgICAgICJpZCI6ICIyZjU2NTVhMmMyNzM3YzhkNjc5MzBkMzZiZjg5YjQ5NGY5OTRlOTc1NWI3Y2I5MjQ2ZjRlYTQzNzFjYTk5NjMxIiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiR3JlYXRlciBNdWx0aXBsZSBQcm9qZWN0aWxlcyBTdXBwb3J0IiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3VwcG9ydCwgUHJvamVjdGlsZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMjAgKE1heCkiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgICAgICJ0eXBlIjogNQogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTWFuYSBNdWx0aXBsaWVyIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTY1JSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJyZXF1aXJlbWVudHMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjcwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiRGV4IiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiMTExIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdXBwb3J0cyBwcm9qZWN0aWxlIHNraWxscy4iLAogICAgICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAgICAgIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAyNiUgbGVzcyBQcm9qZWN0aWxlIERhbWFnZSIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIGZpcmUgNCBhZGRpdGlvbmFsIFByb2plY3RpbGVzIgogICAgICAgICAgXSwKICAgICAgICAgICJkZXNjclRleHQiOiAiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsCiAgICAgICAgICAiZnJhbWVUeXBlIjogNCwKICAgICAgICAgICJzb2NrZXQiOiAwLAogICAgICAgICAgImNvbG91ciI6ICJEIgogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgInZlcmlmaWVkIjogZmFsc2UsCiAgICAgICAgICAidyI6IDEsCiAgICAgICAgICAiaCI6IDEsCiAgICAgICAgICAiaWNvbiI6ICJodHRwczovL3dlYi5wb2VjZG4uY29tL2ltYWdlL0FydC8yREl0ZW1zL0dlbXMvU3VwcG9ydC9FbGVtZW50YWxGb2N1cy5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9OTBjMDVlZGE2N2YxMWE0M2I5NWQyMmQ3ZDc3MGQxMTUiLAogICAgICAgICAgInN1cHBvcnQiOiB0cnVlLAogICAgICAgICAgImlkIjogImYxOWZkODUwYWY5MTAwOTNmZmZlMmE3MTMxNGFiZDZjMjIwZGYyYjBkMDQ3YmViM2RkY2E2NGZhOTE2YzE1NjkiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJFbGVtZW50YWwgRm9jdXMgU3VwcG9ydCIsCiAgICAgICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICAgICAiaWx2bCI6IDAsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIlN1cHBvcnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjIwIChNYXgpIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1hbmEgTXVsdGlwbGllciIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEzMCUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjExMSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiU3VwcG9ydHMgYW55IHNraWxsIHRoYXQgaGl0cyBvciBidXJucyBlbmVtaWVzLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBkZWFsIDQ5JSBtb3JlIEVsZW1lbnRhbCBEYW1hZ2UiLAogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBjYW5ub3QgaW5mbGljdCBFbGVtZW50YWwgQWlsbWVudHMiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDEsCiAgICAgICAgICAiY29sb3VyIjogIkkiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9TdXBwb3J0L0VjaG8ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTE3MjY1ZmIxMjI2M2ZjYTM0MWRkYzQ0M2EyNDJkMzA2IiwKICAgICAgICAgICJzdXBwb3J0IjogdHJ1ZSwKICAgICAgICAgICJpZCI6ICI2NTRlNTFkMDliNjYwY2QyYmI5OWZiZTQxZWFiZjI5NmRmMTc3MTJlOWJmMmI2ODRkNjc0NDZlOTFhMzExNzM0IiwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiU3BlbGwgRWNobyBTdXBwb3J0IiwKICAgICAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJwcm9wZXJ0aWVzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiU3BlbGwsIFN1cHBvcnQiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjIwIChNYXgpIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIk1hbmEgTXVsdGlwbGllciIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjE0MCUiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0KICAgICAgICAgIF0sCiAgICAgICAgICAicmVxdWlyZW1lbnRzIjogWwogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI3MCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjExMSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfQogICAgICAgICAgXSwKICAgICAgICAgICJzZWNEZXNjclRleHQiOiAiU3VwcG9ydHMgc3BlbGwgc2tpbGxzLCBtYWtpbmcgdGhlbSByZXBlYXQgd2hlbiBjYXN0LiBDYW5ub3Qgc3VwcG9ydCBWYWFsIHNraWxscywgdG90ZW0gc2tpbGxzLCBjaGFubmVsbGluZyBza2lsbHMsIHRyaWdnZXJlZCBza2lsbHMsIGluc3RhbnQgc2tpbGxzLCBvciBza2lsbHMgd2hpY2ggcmVzZXJ2ZSBtYW5hLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBkZWFsIDEwJSBsZXNzIERhbWFnZSIsCiAgICAgICAgICAgICJTdXBwb3J0ZWQgU2tpbGxzIFJlcGVhdCBhbiBhZGRpdGlvbmFsIHRpbWUiLAogICAgICAgICAgICAiU3VwcG9ydGVkIFNraWxscyBoYXZlIDcwJSBtb3JlIENhc3QgU3BlZWQiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgInNvY2tldCI6IDIsCiAgICAgICAgICAiY29sb3VyIjogIkkiCiAgICAgICAgfSwKICAgICAgICB7CiAgICAgICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgICAgICJ3IjogMSwKICAgICAgICAgICJoIjogMSwKICAgICAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvR2Vtcy9GaXJlRWxlbWVudGFsU3VtbW9uLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj01YTk1Nzg0Y2M0ZWI2MDY3YzE1ZTFhOGJjYTYwMmY4NyIsCiAgICAgICAgICAic3VwcG9ydCI6IGZhbHNlLAogICAgICAgICAgImlkIjogIjk1YzUwZjNlYWE0NWJmZDIzZGQ0MjQ5OWNjMWZmNjU3Zjc4NzUzNzBlY2I5MGFlNTEwNjc2ZWQ3Njc2MDgxOWYiLAogICAgICAgICAgIm5hbWUiOiAiIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJTdW1tb24gRmxhbWUgR29sZW0iLAogICAgICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAgICAgImlsdmwiOiAwLAogICAgICAgICAgImNvcnJ1cHRlZCI6IHRydWUsCiAgICAgICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkZpcmUsIE1pbmlvbiwgU3BlbGwsIEdvbGVtIiwKICAgICAgICAgICAgICAidmFsdWVzIjogW10sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9LAogICAgICAgICAgICB7CiAgICAgICAgICAgICAgIm5hbWUiOiAiTGV2ZWwiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICIyMCAoTWF4KSIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAsCiAgICAgICAgICAgICAgInR5cGUiOiA1CiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJNYW5hIENvc3QiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI1NCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNvb2xkb3duIFRpbWUiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI2LjAwIHNlYyIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDAKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkNhc3QgVGltZSIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjEuMDAgc2VjIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkxldmVsIiwKICAgICAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICAgICAgWwogICAgICAgICAgICAgICAgICAiNzAiLAogICAgICAgICAgICAgICAgICAwCiAgICAgICAgICAgICAgICBdCiAgICAgICAgICAgICAgXSwKICAgICAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgICAgIH0sCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJTdHIiLAogICAgICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgICAgICBbCiAgICAgICAgICAgICAgICAgICI5OCIsCiAgICAgICAgICAgICAgICAgIDAKICAgICAgICAgICAgICAgIF0KICAgICAgICAgICAgICBdLAogICAgICAgICAgICAgICJkaXNwbGF5TW9kZSI6IDEKICAgICAgICAgICAgfSwKICAgICAgICAgICAgewogICAgICAgICAgICAgICJuYW1lIjogIkludCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjY4IiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMQogICAgICAgICAgICB9CiAgICAgICAgICBdLAogICAgICAgICAgInNlY0Rlc2NyVGV4dCI6ICJTdW1tb25zIGEgRmxhbWUgR29sZW0gdGhhdCBncmFudHMgeW91IGluY3JlYXNlZCBEYW1hZ2UuIFRoZSBGbGFtZSBHb2xlbSBjYW4gdXNlIGEgZmlyZSBzcHJheSwgYSB3YXZlIG9mIGZpcmUgZGFtYWdlLCBhbmQgYW4gZXhwbG9zaXZlIGFyY2luZyBwcm9qZWN0aWxlLiIsCiAgICAgICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICAgICAiTWF4aW11bSAxIFN1bW1vbmVkIEdvbGVtIiwKICAgICAgICAgICAgIjY4JSBpbmNyZWFzZWQgTWluaW9uIE1heGltdW0gTGlmZSIsCiAgICAgICAgICAgICJHb2xlbXMgR3JhbnQgMjAlIGluY3JlYXNlZCBEYW1hZ2UiCiAgICAgICAgICBdLAogICAgICAgICAgImRlc2NyVGV4dCI6ICJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLAogICAgICAgICAgImZyYW1lVHlwZSI6IDQsCiAgICAgICAgICAic29ja2V0IjogMywKICAgICAgICAgICJjb2xvdXIiOiAiUyIKICAgICAgICB9CiAgICAgIF0KICAgIH0KICBdLAogICJjaGFyYWN0ZXIiOiB7CiAgICAibmFtZSI6ICJTbGVlcGVyU3BlY3RyZUJvaSIsCiAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICJjbGFzc0lkIjogMywKICAgICJhc2NlbmRhbmN5Q2xhc3MiOiAzLAogICAgImNsYXNzIjogIk5lY3JvbWFuY2VyIiwKICAgICJsZXZlbCI6IDkxLAogICAgImV4cGVyaWVuY2UiOiAyMTI3Mjk4MTQzCiAgfQp9Cg==\"")
 	packr.PackJSONBytes(".", "get-items.raw.json", "\"eyJpdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoyLCJoIjoyLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9Bcm1vdXJzXC9HbG92ZXNcL0dsb3Zlc1N0ckRleDMucG5nP3NjYWxlPTEmdz0yJmg9MiZ2PTI2YTliMTU5YTFhNTNlMGNhZTc5ZDMwZDZmYTUwY2RkIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6IjhlYzBlYjVlMGU4YWY0NTczZWUwYjEwMTRhOWQxYTY4NGMwZDlkOGE2YjIyOTYxNGM5ZTA5MWYzZmEwNGM0MWYiLCJpbmZsdWVuY2VzIjp7ImVsZGVyIjp0cnVlfSwiZWxkZXIiOnRydWUsInNvY2tldHMiOlt7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn0seyJncm91cCI6MCwiYXR0ciI6IlMiLCJzQ29sb3VyIjoiUiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJEIiwic0NvbG91ciI6IkcifSx7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn1dLCJuYW1lIjoiQnJhbWJsZSBGaW5nZXJzIiwidHlwZUxpbmUiOiJEcmFnb25zY2FsZSBHYXVudGxldHMiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3NiwicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IkFybW91ciIsInZhbHVlcyI6W1siMTY4IiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNn0seyJuYW1lIjoiRXZhc2lvbiBSYXRpbmciLCJ2YWx1ZXMiOltbIjE2OSIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTd9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMjMiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiZXhwbGljaXRNb2RzIjpbIlNvY2tldGVkIEdlbXMgaGF2ZSAxNiUgbW9yZSBBdHRhY2sgYW5kIENhc3QgU3BlZWQiLCIrMjIgdG8gRGV4dGVyaXR5IiwiKzE5IHRvIEFybW91ciIsIisyMCB0byBFdmFzaW9uIFJhdGluZyIsIisyOCUgdG8gTGlnaHRuaW5nIFJlc2lzdGFuY2UiXSwiY3JhZnRlZE1vZHMiOlsiKzQ1IHRvIG1heGltdW0gTGlmZSJdLCJlbmNoYW50TW9kcyI6WyJUcmlnZ2VyIENvbW1hbmRtZW50IG9mIHRoZSBHcmF2ZSB3aGVuIHlvdXIgU2tpbGxzIG9yIE1pbmlvbnMgS2lsbCJdLCJmcmFtZVR5cGUiOjIsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiR2xvdmVzIiwic29ja2V0ZWRJdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9GYXN0ZXJBdHRhY2tzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1jMTQyMDNmN2IxOTY1MDg2MTkwN2EzMGE5MmUzYjZmZSIsInN1cHBvcnQiOnRydWUsImlkIjoiNWIyZDc2ZGI4MTU2ZTA2YTU2YzM0MTk5ZDQ0ZTAwMjUwMDk4NzIyZWU4MzY2MTQ3MDEzNzdiYzc2ZjdjMmExNCIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkZhc3RlciBBdHRhY2tzIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJBdHRhY2ssIFN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjExNSUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIis5JSIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6Nn1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMTExIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1cHBvcnRzIGF0dGFjayBza2lsbHMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgaGF2ZSA0OCUgaW5jcmVhc2VkIEF0dGFjayBTcGVlZCJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjAsImNvbG91ciI6IkQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvRm9ydGlmeUdlbS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NmE2OTg1M2FhZjcyNzg2NjdiZGU0MzUzMTU0MWVmN2QiLCJzdXBwb3J0Ijp0cnVlLCJpZCI6IjMyNDg1MDU5MzY4M2EwZjIwZGU5MzNkYjA1ZTgxNjBmMjE4ZmNjNTQ1NzI3NGRkODU1NWQzOTE4M2M1MmUyNDciLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJGb3J0aWZ5IFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJBdHRhY2ssIFN1cHBvcnQsIE1lbGVlIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgbWVsZWUgYXR0YWNrIHNraWxscyB0aGF0IGFyZSBub3QgdHJpZ2dlcmVkLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGdyYW50IEZvcnRpZnkgb24gTWVsZWUgaGl0IiwiU3VwcG9ydGVkIFNraWxscyBoYXZlIDM0JSBpbmNyZWFzZWQgRm9ydGlmeSBkdXJhdGlvbiIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAzNCUgbW9yZSBEYW1hZ2Ugd2l0aCBBaWxtZW50cyBjYXVzZWQgYnkgTWVsZWUgSGl0cyIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAzNCUgbW9yZSBNZWxlZSBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoxLCJjb2xvdXIiOiJTIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0N1bGxpbmdTdHJpa2UucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTdjYjc1NmVkMDMzYjYwNzM5ODZmZDhiYWJmYzEwZjQzIiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiJjNDhhYmZlMWNiMzJkYTAwNDZiOTQ5MzUwZGFlMTU5MjA2MzRjNTM4NDk0ZjBjYzE1YmEyNjUzYWNkYWIwMTFhIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQ3VsbGluZyBTdHJpa2UgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjExMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIGVuZW1pZXMuIElmIGVuZW1pZXMgYXJlIGxlZnQgYmVsb3cgMTAlIG9mIG1heGltdW0gbGlmZSBhZnRlciBiZWluZyBoaXQgYnkgdGhlc2Ugc2tpbGxzLCB0aGV5IHdpbGwgYmUga2lsbGVkLiIsImV4cGxpY2l0TW9kcyI6WyJLaWxsIEVuZW1pZXMgdGhhdCBoYXZlIDEwJSBMaWZlIG9yIGxvd2VyIHdoZW4gSGl0IGJ5IFN1cHBvcnRlZCBTa2lsbHMiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMzglIGluY3JlYXNlZCBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJEIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1doaXJsaW5nQmxhZGVzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lNDU2MDRlODA4YmM5OGY1MTJmZDQ4MTgyNjVjZGQ3YSIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjNmOGNhYWI4M2Q5OGJkMjlkOTc3NTM5ZmM4OGExOTNjNWE4NjI4MzVmMjEzMjY3YmNkYmNhMGVhM2JiZDhjYjEiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJXaGlybGluZyBCbGFkZXMiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQXR0YWNrLCBNb3ZlbWVudCwgVHJhdmVsLCBNZWxlZSIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNCIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxMCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRWZmZWN0aXZlbmVzcyBvZiBBZGRlZCBEYW1hZ2UiLCJ2YWx1ZXMiOltbIjEwMyUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiYWRkaXRpb25hbFByb3BlcnRpZXMiOlt7Im5hbWUiOiJFeHBlcmllbmNlIiwidmFsdWVzIjpbWyIxMTExOTJcLzExMTE5MiIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjEsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyIzMyIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIyMyIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJuZXh0TGV2ZWxSZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMzgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMjYiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiRGl2ZSB0aHJvdWdoIGVuZW1pZXMsIGRlYWxpbmcgd2VhcG9uIGRhbWFnZS4gT25seSB3b3JrcyB3aXRoIGRhZ2dlcnMsIGNsYXdzIGFuZCBvbmUgaGFuZGVkIHN3b3Jkcy4gQ2Fubm90IGJlIHN1cHBvcnRlZCBieSBNdWx0aXN0cmlrZS4iLCJleHBsaWNpdE1vZHMiOlsiRGVhbHMgMTAzLjIlIG9mIEJhc2UgRGFtYWdlIiwiKzAuNiBzZWNvbmRzIHRvIEF0dGFjayBUaW1lIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjozLCJjb2xvdXIiOiJEIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MywiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQXJtb3Vyc1wvU2hpZWxkc1wvUmlzZW9mdGhlcGhvZW5peC5wbmc/c2NhbGU9MSZ3PTImaD0zJnY9Yzc3MTdjNGUwMWI3ZTUwNjg3NjE2YzY4NjUwMzk3MWMiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiZTA4Y2U0YzMwNmYyZjljNjdhODZlYTZmMDc2MWIxNGIyZGRkYzIwYTcwMWM5NzIzMmFkMzBlYTM2ZjYxNDRjZCIsInNvY2tldHMiOlt7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IlMiLCJzQ29sb3VyIjoiUiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifV0sIm5hbWUiOiJSaXNlIG9mIHRoZSBQaG9lbml4IiwidHlwZUxpbmUiOiJNb3NhaWMgS2l0ZSBTaGllbGQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2OSwicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IkNoYW5jZSB0byBCbG9jayIsInZhbHVlcyI6W1siMjQlIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNX0seyJuYW1lIjoiQXJtb3VyIiwidmFsdWVzIjpbWyIzMjIiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjE2fSx7Im5hbWUiOiJFbmVyZ3kgU2hpZWxkIiwidmFsdWVzIjpbWyI2MSIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTh9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcyIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMjEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiaW1wbGljaXRNb2RzIjpbIis4JSB0byBhbGwgRWxlbWVudGFsIFJlc2lzdGFuY2VzIl0sImV4cGxpY2l0TW9kcyI6WyI5OSUgaW5jcmVhc2VkIEFybW91ciBhbmQgRW5lcmd5IFNoaWVsZCIsIis1MCB0byBtYXhpbXVtIExpZmUiLCJSZWdlbmVyYXRlIDE3LjUgTGlmZSBwZXIgc2Vjb25kIiwiKzUlIHRvIG1heGltdW0gRmlyZSBSZXNpc3RhbmNlIiwiKzI0JSB0byBGaXJlIFJlc2lzdGFuY2UiLCIrMjUlIHRvIEZpcmUgUmVzaXN0YW5jZSB3aGlsZSBvbiBMb3cgTGlmZSIsIjEwJSBpbmNyZWFzZWQgTW92ZW1lbnQgU3BlZWQgd2hlbiBvbiBMb3cgTGlmZSIsIkNhbm5vdCBiZSBJZ25pdGVkIHdoaWxlIG9uIExvdyBMaWZlIl0sImZsYXZvdXJUZXh0IjpbIk15IGJlYXJlciBzaGFsbCBiZSBndWFyZGVkIGJ5IGZsYW1lLFxyIiwiZm9yIEkgYW0gdGhlIHBob2VuaXgsIGZvcmV2ZXIgcmFkaWFudCBpbiBnbG9yeS4iXSwiZnJhbWVUeXBlIjozLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6Ik9mZmhhbmQiLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0Zyb3N0RnVyeS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NzdhYWFiZmJiNGMxYmViY2Q4ZTIxNDdiOGNlYzlmZmQiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiJkMGY3NjhkYmZjY2IwNWU2YTJmZDIzODk4NTY2OWFiYjM3NzJiYmNjMWNhNDNlYWYyOTVlY2QxYjY3OWZiNTNhIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiV2ludGVyIE9yYiIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJDb2xkLCBTcGVsbCwgQ2hhbm5lbGxpbmcsIEFvRSwgRHVyYXRpb24sIFByb2plY3RpbGUiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjEyIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIENvc3QiLCJ2YWx1ZXMiOltbIjMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siMC4yNSBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjYuMDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJFZmZlY3RpdmVuZXNzIG9mIEFkZGVkIERhbWFnZSIsInZhbHVlcyI6W1siNTAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siOTM2NDg3XC8xOTU2NjQ4IiwwXV0sImRpc3BsYXlNb2RlIjoyLCJwcm9ncmVzcyI6MC40Nzg2MTgwMjU3Nzk3MjQxLCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNTQiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTIxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNoYW5uZWwgdG8gY29uanVyZSBhbiBvcmIgYWJvdmUgeW91LCB3aGljaCBwZWx0cyBuZWFyYnkgZW5lbWllcyB3aXRoIHByb2plY3RpbGVzIHRoYXQgaW1wYWN0IHRoZSBncm91bmQgYW5kIGV4cGxvZGUuIENoYW5uZWxsaW5nIGZvciBsb25nZXIgYnVpbGRzIHVwIHN0YWdlcyBvbiB0aGUgc2tpbGwuIFdoZW4geW91IHN0b3AgY2hhbm5lbGxpbmcsIHRoZSBzdGFnZXMgZGVjYXkgb3ZlciBhIGR1cmF0aW9uLiIsImV4cGxpY2l0TW9kcyI6WyJEZWFscyAxMTMgdG8gMTQxIENvbGQgRGFtYWdlIiwiQmFzZSBkdXJhdGlvbiBpcyAxLjIwIHNlY29uZHMiLCJJbmNyZWFzZXMgYW5kIFJlZHVjdGlvbnMgdG8gQ2FzdCBTcGVlZCBhbHNvIGFwcGx5IHRvIFByb2plY3RpbGUgRnJlcXVlbmN5IiwiRmlyZXMgcHJvamVjdGlsZXMgZXZlcnkgMS42IHNlY29uZHMiLCIyNSUgaW5jcmVhc2VkIER1cmF0aW9uIHBlciBTdGFnZSIsIjEwMCUgbW9yZSBQcm9qZWN0aWxlIEZyZXF1ZW5jeSB3aGlsZSBDaGFubmVsbGluZyIsIjE1JSBpbmNyZWFzZWQgUHJvamVjdGlsZSBGcmVxdWVuY3kgcGVyIFN0YWdlIiwiTWF4aW11bSAxMCBTdGFnZXMiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjAsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvSW5jcmVhc2VkRHVyYXRpb24ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTE0YjNhOTE5MzNmMGI5MjFkMWI1NzMzNThlMzFmNzY4Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI4YTg4ZTk2NTQxMzU2YjJmZjJlYWY0NzAxM2YwOGU1MjEyNjIzYjA5YmNkYTMyZWRkOThjZWMwYjI5ZDcwNTE1IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiSW5jcmVhc2VkIER1cmF0aW9uIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCwgRHVyYXRpb24iLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjE0MCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgd2l0aCBhIGR1cmF0aW9uLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGhhdmUgNjQlIGluY3JlYXNlZCBTa2lsbCBFZmZlY3QgRHVyYXRpb24iXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoxLCJjb2xvdXIiOiJTIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0Zhc3RlckNhc3QucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PWY3MGUzMGNkMzc1NWQxMWE4MTEwM2I3MDk1N2U1NzljIiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiJiYmQ5ZTdmYjJhODAyYjFjYmJjODM1MzYwZDYxMTBhZTNkMGI2YmRjZThiNmExMjY5ODc0ODZjZTRhNzMxMWIyIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiRmFzdGVyIENhc3RpbmcgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQsIFNwZWxsIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMSAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMjAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTQiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgbm9uLWluc3RhbnQgc3BlbGwgc2tpbGxzLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGhhdmUgNDAlIGluY3JlYXNlZCBDYXN0IFNwZWVkIl0sImRlc2NyVGV4dCI6IlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MiwiY29sb3VyIjoiSSJ9XX0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL1JpbmdzXC9FbXB0eS1Tb2NrZXQucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTM2NzFmNmQ3OWQ3MTkwYjQzODc5YjgzMGU2M2ZjMDc4IiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImQyZGM2M2ZhNzBlZWNkYmE3Mzg3MGNhNjc0YjYyNDdhNTVhMTc0OGVlYTg5NmRlNzRhZjIzMThlMzYxNDEzNWIiLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9XSwibmFtZSI6IlBsYWd1ZSBDaXJjbGUiLCJ0eXBlTGluZSI6IlVuc2V0IFJpbmciLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2NywicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjE1NSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJpbXBsaWNpdE1vZHMiOlsiSGFzIDEgU29ja2V0Il0sImV4cGxpY2l0TW9kcyI6WyIrMiB0byBMZXZlbCBvZiBTb2NrZXRlZCBHZW1zIiwiQWRkcyA0IHRvIDYgUGh5c2ljYWwgRGFtYWdlIHRvIEF0dGFja3MiLCIrNTIgdG8gbWF4aW11bSBMaWZlIiwiMTQlIGluY3JlYXNlZCBSYXJpdHkgb2YgSXRlbXMgZm91bmQiLCIrNDAlIHRvIEZpcmUgUmVzaXN0YW5jZSJdLCJjcmFmdGVkTW9kcyI6WyIrMjQgdG8gU3RyZW5ndGgiXSwiZnJhbWVUeXBlIjoyLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IlJpbmciLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0NsYXJpdHkucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PWY2MDljMTI4ZTVlZTA1M2U3NzNiMzkxNjI1NzU3ZDZhIiwic3VwcG9ydCI6ZmFsc2UsImlkIjoiNTQxZGI1MzdjMTg4NTc1MzQ2ZjlkNTE4NDU5M2YyNWJiZjg5NGRkMzAwNjQwYTExODY3YWJmYmNhOTc5OGZkMiIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkNsYXJpdHkiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQXVyYSwgU3BlbGwsIEFvRSIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjAgKE1heCkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgUmVzZXJ2ZWQiLCJ2YWx1ZXMiOltbIjI3OSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siMS4yMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siSW5zdGFudCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTU1IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNhc3RzIGFuIGF1cmEgdGhhdCBncmFudHMgbWFuYSByZWdlbmVyYXRpb24gdG8geW91IGFuZCB5b3VyIGFsbGllcy4iLCJleHBsaWNpdE1vZHMiOlsiKzE5IHRvIHJhZGl1cyIsIllvdSBhbmQgbmVhcmJ5IEFsbGllcyBSZWdlbmVyYXRlIDMzIE1hbmEgcGVyIHNlY29uZCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MCwiY29sb3VyIjoiSSJ9XX0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjIsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0FybW91cnNcL0hlbG1ldHNcL0hlbG1ldFN0ckludDcucG5nP3NjYWxlPTEmdz0yJmg9MiZ2PWJmMjk2M2U3ODM3OWY2ZWIzNWJiZmVkZTNhZGUwMTY0IiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImQ1N2UxY2Q4NmYyMTgyYTQ1ZjMzMjJmOGMyZTllYmE4YmY4NjNkNjAzZmFiNTBiMzRkMzIwYmQwZTk4MDk2NDciLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkQiLCJzQ29sb3VyIjoiRyJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifSx7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9XSwibmFtZSI6IlNrdWxsIFZpc2FnZSIsInR5cGVMaW5lIjoiR3JlYXQgQ3Jvd24iLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo1NywicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IkFybW91ciIsInZhbHVlcyI6W1siMjQyIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNn0seyJuYW1lIjoiRW5lcmd5IFNoaWVsZCIsInZhbHVlcyI6W1siMzkiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjE4fV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyI1NCIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiZXhwbGljaXRNb2RzIjpbIjIwJSBpbmNyZWFzZWQgQXJtb3VyIGFuZCBFbmVyZ3kgU2hpZWxkIiwiKzg5IHRvIG1heGltdW0gTGlmZSIsIiszMyUgdG8gQ29sZCBSZXNpc3RhbmNlIiwiKzEwJSB0byBMaWdodG5pbmcgUmVzaXN0YW5jZSJdLCJjcmFmdGVkTW9kcyI6WyIrMzAgdG8gQXJtb3VyIl0sImVuY2hhbnRNb2RzIjpbIkJvbmUgT2ZmZXJpbmcgZ3JhbnRzIGFuIGFkZGl0aW9uYWwgKzYlIENoYW5jZSB0byBCbG9jayBBdHRhY2sgRGFtYWdlIl0sImZyYW1lVHlwZSI6MiwieCI6MCwieSI6MCwiaW52ZW50b3J5SWQiOiJIZWxtIiwic29ja2V0ZWRJdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9DdWxsaW5nU3RyaWtlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj03Y2I3NTZlZDAzM2I2MDczOTg2ZmQ4YmFiZmMxMGY0MyIsInN1cHBvcnQiOnRydWUsImlkIjoiMmY1YzhkZjU2MDk0Mzk0ZDFhYTI5Y2UzYjFiNWE5ODA2M2VhYWU3NmE2MDMxMjRlYjUyZmYyMDNhZjVjNzI1MSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkN1bGxpbmcgU3RyaWtlIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMTAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siMzIwNjcyXC8zMjA2NzIiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjoxLCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMzIiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siNTQiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwibmV4dExldmVsUmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjM1IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjU4IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1cHBvcnRzIGFueSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcy4gSWYgZW5lbWllcyBhcmUgbGVmdCBiZWxvdyAxMCUgb2YgbWF4aW11bSBsaWZlIGFmdGVyIGJlaW5nIGhpdCBieSB0aGVzZSBza2lsbHMsIHRoZXkgd2lsbCBiZSBraWxsZWQuIiwiZXhwbGljaXRNb2RzIjpbIktpbGwgRW5lbWllcyB0aGF0IGhhdmUgMTAlIExpZmUgb3IgbG93ZXIgd2hlbiBIaXQgYnkgU3VwcG9ydGVkIFNraWxscyIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCA4JSBpbmNyZWFzZWQgRGFtYWdlIl0sImRlc2NyVGV4dCI6IlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MCwiY29sb3VyIjoiRCJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9CYWxsTGlnaHRuaW5nLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj00ZWU5OGNjMWUwNDQzMGNmZDQ1NjM5YWUxYWQ1NWEzOCIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjAwZGMxMGUxNjdhN2MyN2Y3NTEyYjk1MjFhZjhkNmQzMzUxZGMwOGRiODhiMWRmNmU4YzczYTMyMWI3OGYzNDYiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJCYWxsIExpZ2h0bmluZyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgUHJvamVjdGlsZSwgQW9FLCBMaWdodG5pbmciLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgQ29zdCIsInZhbHVlcyI6W1siMTQiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siMC43NSBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjUuMDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJFZmZlY3RpdmVuZXNzIG9mIEFkZGVkIERhbWFnZSIsInZhbHVlcyI6W1siNDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siMjg1ODE1XC8yODU4MTUiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjoxLCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMzEiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siNzMiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwibmV4dExldmVsUmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjM0IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjc5IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkZpcmVzIGEgc2xvdy1tb3ZpbmcgcHJvamVjdGlsZSB0aGF0IHBlcmlvZGljYWxseSBkYW1hZ2VzIGVuZW1pZXMgaW4gYW4gYXJlYSBhcm91bmQgaXQgd2l0aCBib2x0cyBvZiBsaWdodG5pbmcuIiwiZXhwbGljaXRNb2RzIjpbIkRlYWxzIDMgdG8gNTggTGlnaHRuaW5nIERhbWFnZSJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MSwiY29sb3VyIjoiSSJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9DdXJzZU9uSGl0LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0zYjRkMDBhM2Y1ZTM4ZGM5MTMxMTQ4ZDc2YmE4MWNkOSIsInN1cHBvcnQiOnRydWUsImlkIjoiN2NjZTE3NDllMzUwODVmOGVhYTU1Y2M5NmQ1MjUzYzQ0ZmQ2N2M4MWRkZWZhYjVkOGQ5ZmJiNGYwMTMzYzJkMSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkN1cnNlIE9uIEhpdCBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwiY29ycnVwdGVkIjp0cnVlLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQ3Vyc2UsIFRyaWdnZXIsIFN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiTXVzdCBzdXBwb3J0IGJvdGggYSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcywgYW5kIGEgY3Vyc2Ugc2tpbGwgdG8gd29yay4gVGhlIGN1cnNlIHdpbGwgYmUgYXBwbGllZCB3aGVuIGVuZW1pZXMgYXJlIGhpdCBieSB0aGUgb3RoZXIgc2tpbGwuIENhbm5vdCBzdXBwb3J0IHRvdGVtcywgdHJhcHMsIG9yIG1pbmVzLiBNaW5pb25zIGNhbm5vdCBhcHBseSBjdXJzZXMgdGhpcyB3YXkuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgYXBwbHkgc3VwcG9ydGVkIEN1cnNlcyBvbiBIaXQiLCJZb3UgY2Fubm90IENhc3QgU3VwcG9ydGVkIEN1cnNlcyIsIlN1cHBvcnRlZCBTa2lsbHMgaGF2ZSAxMiUgcmVkdWNlZCBDdXJzZSBEdXJhdGlvbiJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjIsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvRmxhbW1hYmlsaXR5LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj02ZmJmN2NmY2RkY2MyNjdiMWY0NGZiODQzMTRkYmUwNCIsInN1cHBvcnQiOmZhbHNlLCJpZCI6ImM2ZThjNzRhYjlhMTY5NDJmYjVmZDQ4ZmQ0MTM0OTczMTY2YzhmNWM0ZTA2ZDhjMTAzNDFhZmExYjFhMjdjMDciLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJGbGFtbWFiaWxpdHkiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgQW9FLCBEdXJhdGlvbiwgQ3Vyc2UsIEZpcmUiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIENvc3QiLCJ2YWx1ZXMiOltbIjUwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJDYXN0IFRpbWUiLCJ2YWx1ZXMiOltbIjAuNTAgc2VjIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyI5OCIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJDdXJzZXMgYWxsIHRhcmdldHMgaW4gYW4gYXJlYSwgbWFraW5nIHRoZW0gbGVzcyByZXNpc3RhbnQgdG8gZmlyZSBkYW1hZ2UgYW5kIGdpdmluZyB0aGVtIGEgY2hhbmNlIHRvIGJlIGlnbml0ZWQgYnkgZmlyZSBkYW1hZ2UuIiwiZXhwbGljaXRNb2RzIjpbIkJhc2UgZHVyYXRpb24gaXMgMTAuOTAgc2Vjb25kcyIsIisxMCB0byByYWRpdXMiLCJDdXJzZWQgZW5lbWllcyBoYXZlIC00NCUgdG8gRmlyZSBSZXNpc3RhbmNlIiwiQ3Vyc2VkIGVuZW1pZXMgaGF2ZSArMTQlIGNoYW5jZSB0byBiZSBJZ25pdGVkIGJ5IEZpcmUgRGFtYWdlIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjozLCJjb2xvdXIiOiJJIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MywiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQXJtb3Vyc1wvQm9keUFybW91cnNcL1Zpc01vcnRpcy5wbmc/c2NhbGU9MSZ3PTImaD0zJnY9ZDk2NTVjZDI4OTU4OTcxNmJiNDU2MGJiY2M1ZGEwZTMiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiNmY4N2Y1Y2JjMGI2NjNhZWE0MDYyNmM2ZjUyNjE0NTIxMmQ3NTk5MDk3Yjg0YzY5ZjM4ZTM2OTkwYTRjMTEyMSIsInNvY2tldHMiOlt7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifSx7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn0seyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9LHsiZ3JvdXAiOjEsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifV0sIm5hbWUiOiJWaXMgTW9ydGlzIiwidHlwZUxpbmUiOiJOZWNyb21hbmNlciBTaWxrcyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjc1LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRW5lcmd5IFNoaWVsZCIsInZhbHVlcyI6W1siMzUyIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxOH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzIiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMTExIiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjE2MCIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJleHBsaWNpdE1vZHMiOlsiMjAxJSBpbmNyZWFzZWQgRW5lcmd5IFNoaWVsZCIsIisyOSB0byBtYXhpbXVtIE1hbmEiLCJNaW5pb25zIGhhdmUgMjAlIHJlZHVjZWQgbWF4aW11bSBMaWZlIiwiTWluaW9ucyBkZWFsIDE1JSBpbmNyZWFzZWQgRGFtYWdlIiwiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgU3BlY3RyZXMiLCJNaW5pb25zIGdhaW4gVW5ob2x5IE1pZ2h0IGZvciAxMCBzZWNvbmRzIG9uIEtpbGwiXSwiZmxhdm91clRleHQiOlsiUmVhcCB3aGF0IG90aGVycyBoYXZlIHNvd25cciIsIk11c3RlciB0aGVtIGZyb20gdGhlaXIgZ3JhdmVzXHIiLCJQYXJhZGUgdGhlbSBmb3IgeW91ciBwbGVhc3VyZVxyIiwiWmVhbG90cyBpbiBtb3J0aXMgZW5zbGF2ZWQiXSwiZnJhbWVUeXBlIjozLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IkJvZHlBcm1vdXIiLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1JhaXNlU3BlY3RyZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MjQ3ODlhOWVjYWE1ODdiNDE4OGEwMjcyZDBhYWQ1YWIiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiI5MjkzNGU3MGM2NzM2ZTAyZWFkZWZiOWYxMzNkZTM1NjgxZTFhMzAzNDEyMzg2MWQzMzE4YjExMThjMDIzNDRiIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiUmFpc2UgU3BlY3RyZSIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgTWluaW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIyOCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjg1IHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTU1IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlJhaXNlcyBhIHNwZWN0cmFsIHZlcnNpb24gb2YgYSBkZWZlYXRlZCBmb2UgYXMgYSBtaW5pb24gdG8gZmlnaHQgZm9yIHlvdSBpbiBiYXR0bGUuIiwiZXhwbGljaXRNb2RzIjpbIk1pbmlvbnMgaGF2ZSArMzAlIHRvIGFsbCBFbGVtZW50YWwgUmVzaXN0YW5jZXMiLCJNYXhpbXVtIDIgUmFpc2VkIFNwZWN0cmVzIiwiNTUlIG1vcmUgTWluaW9uIE1vdmVtZW50IFNwZWVkIiwiTWluaW9uIE1vdmVtZW50IFNwZWVkIGlzIENhcHBlZCIsIlJhaXNlZCBTcGVjdHJlcyBhcmUgTGV2ZWwgODAiLCJNaW5pb25zIGhhdmUgMTEwOSBhZGRpdGlvbmFsIEFjY3VyYWN5IFJhdGluZyJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MCwiY29sb3VyIjoiSSJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9NaW5pb25EYW1hZ2UucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTExYTYyOTBlNzU5ODM3MWY4MDc1MGEwMzZjMDdjYzM4Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI3NDA1NTY2MzBlMDUzZTdiOWVhN2FkYmNlMGMwN2Q5NjFmMWIzZTBjMWUzYjE1NGQ3Y2UyYzY2OTYwOTRmZDIwIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiTWluaW9uIERhbWFnZSBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwiY29ycnVwdGVkIjp0cnVlLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCwgTWluaW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMSAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTQiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgc2tpbGxzIHdoaWNoIGNyZWF0ZSBNaW5pb25zLiIsImV4cGxpY2l0TW9kcyI6WyJNaW5pb25zIGZyb20gU3VwcG9ydGVkIFNraWxscyBkZWFsIDUwJSBtb3JlIERhbWFnZSJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjEsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvRWxlbWVudGFsRm9jdXMucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTkwYzA1ZWRhNjdmMTFhNDNiOTVkMjJkN2Q3NzBkMTE1Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI0NzU0NTYxZTYwMGM1OTI1ZGE1MjkwYTYxZjFlNWU2MjhjMTUyYzFkZmIzNzYzMDY2YmI1ZmZkMmNmMjliNjk4IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiRWxlbWVudGFsIEZvY3VzIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTdXBwb3J0IiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJRdWFsaXR5IiwidmFsdWVzIjpbWyIrNiUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIG9yIGJ1cm5zIGVuZW1pZXMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCA0OSUgbW9yZSBFbGVtZW50YWwgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBjYW5ub3QgaW5mbGljdCBFbGVtZW50YWwgQWlsbWVudHMiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMyUgaW5jcmVhc2VkIEVsZW1lbnRhbCBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0dyZWF0ZXJNdWx0aXBsZVByb2plY3RpbGVzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMWI2ZmIyNmU1OTM3OTU3ZTA4Y2U4MGUxMTNmNDFlMiIsInN1cHBvcnQiOnRydWUsImlkIjoiNDcxYWIyNWJlMWM5MzdmN2Y5ZTlhYzI2Nzc4YmYzMmM5ZDkyODI5OThmN2Y5ZWEwY2MwNjBhNmViOWU0YjJmMyIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkdyZWF0ZXIgTXVsdGlwbGUgUHJvamVjdGlsZXMgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQsIFByb2plY3RpbGUiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjE2NSUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBwcm9qZWN0aWxlIHNraWxscy4iLCJleHBsaWNpdE1vZHMiOlsiU3VwcG9ydGVkIFNraWxscyBkZWFsIDI2JSBsZXNzIFByb2plY3RpbGUgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBmaXJlIDQgYWRkaXRpb25hbCBQcm9qZWN0aWxlcyJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjMsImNvbG91ciI6IkQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvRWNoby5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MTcyNjVmYjEyMjYzZmNhMzQxZGRjNDQzYTI0MmQzMDYiLCJzdXBwb3J0Ijp0cnVlLCJpZCI6IjdlMGJlOWYwOWZmMjMzNzdlZGNhMzk3ZWQyMTdmNzA4MjVjMWRhNjI0NzRiYTkzNjBkZDBmY2Y3NDgwNThlYTEiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJTcGVsbCBFY2hvIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgU3VwcG9ydCIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjAgKE1heCkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgTXVsdGlwbGllciIsInZhbHVlcyI6W1siMTQwJSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiUXVhbGl0eSIsInZhbHVlcyI6W1siKzUlIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo2fV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgc3BlbGwgc2tpbGxzLCBtYWtpbmcgdGhlbSByZXBlYXQgd2hlbiBjYXN0LiBDYW5ub3Qgc3VwcG9ydCBWYWFsIHNraWxscywgdG90ZW0gc2tpbGxzLCBjaGFubmVsbGluZyBza2lsbHMsIHRyaWdnZXJlZCBza2lsbHMsIGluc3RhbnQgc2tpbGxzLCBvciBza2lsbHMgd2hpY2ggcmVzZXJ2ZSBtYW5hLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMTAlIGxlc3MgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBSZXBlYXQgYW4gYWRkaXRpb25hbCB0aW1lIiwiU3VwcG9ydGVkIFNraWxscyBoYXZlIDcwJSBtb3JlIENhc3QgU3BlZWQiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMiUgaW5jcmVhc2VkIFNwZWxsIERhbWFnZSJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjQsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvQ29udm9jYXRpb24ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTI4MDIzNzRlNjA2MzQ2MDhmM2QyNjYwNzJmOTdkZjQxIiwic3VwcG9ydCI6ZmFsc2UsImlkIjoiN2NlYjJlMjAxNDVhNDdhYTZmZGRjZDg3MWVjODhjNzMzZTVkNjBkMzIzNzZkY2VhMmE5MWVjYmNmZTZjNDkyYyIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkNvbnZvY2F0aW9uIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6Ik1pbmlvbiwgU3BlbGwsIER1cmF0aW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxMyIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siMy4wMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siSW5zdGFudCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTU1IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlJlY2FsbHMgYWxsIG1pbmlvbnMgdGhhdCBhcmUgZm9sbG93aW5nIHlvdSB0byB5b3VyIGxvY2F0aW9uLCBhbmQgZ3JhbnRzIHRoZW0gYSB0ZW1wb3JhcnkgbGlmZSByZWdlbmVyYXRpb24gZWZmZWN0LiIsImV4cGxpY2l0TW9kcyI6WyJCYXNlIGR1cmF0aW9uIGlzIDIuMDAgc2Vjb25kcyIsIlJlZ2VuZXJhdGUgMS42NSUgb2YgTGlmZSBwZXIgc2Vjb25kIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0Ijo1LCJjb2xvdXIiOiJJIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQmVsdHNcL0Jpc2Nvc0xlYXNoLnBuZz9zY2FsZT0xJnc9MiZoPTEmdj03NTMyOWU1ZjI4NTVkNTViZTQ1OTNhMzBhYWVhZDlmZCIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiJhODA5OGM5MGE5YjMwY2I1MzQ4Mzc2YmIyMjc1ZWJlZGJmYjRjMjMwYzA2ODlmMjg4NzA3ZTMwYTQzN2UzMzNlIiwibmFtZSI6IkJpc2NvJ3MgTGVhc2giLCJ0eXBlTGluZSI6IkhlYXZ5IEJlbHQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo4NCwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjMwIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImltcGxpY2l0TW9kcyI6WyIrMjcgdG8gU3RyZW5ndGgiXSwiZXhwbGljaXRNb2RzIjpbIjUlIGluY3JlYXNlZCBRdWFudGl0eSBvZiBJdGVtcyBmb3VuZCIsIisyOSUgdG8gQ29sZCBSZXNpc3RhbmNlIiwiMSUgaW5jcmVhc2VkIFJhcml0eSBvZiBJdGVtcyBmb3VuZCBwZXIgMTUgUmFtcGFnZSBLaWxscyIsIlJhbXBhZ2UiXSwiZmxhdm91clRleHQiOlsiWW91IHdlcmUgYWx3YXlzIHRoZXJlLCBieSBteSBzaWRlLFxyIiwid2hldGhlciB3ZSBzYXQgb3IgcGxheWVkLlxyIiwiT3VyIGZyaWVuZHNoaXAgd2FzIGEgam95ZnVsIHJpZGUsXHIiLCJJIHdpc2ggeW91IGNvdWxkIGhhdmUgc3RheWVkLiJdLCJmcmFtZVR5cGUiOjMsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiQmVsdCJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoyLCJoIjoyLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9XZWFwb25zXC9PbmVIYW5kV2VhcG9uc1wvQ2xhd3NcL0NsYXdzaGllbGQzLnBuZz9zY2FsZT0xJnc9MiZoPTImdj02YmNiMTA2MzFjNTFjNGFlYWYxMTM0NWJiNjA0MWVkMSIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiI0MDUxZWI2ZTQ2NDEwNWYwMTkyNTEyOGY4N2YxM2NmZWYyMDNmYWRmNTZjODI1M2ZjNjE2OTE1NTE0YTVjZDA4Iiwic29ja2V0cyI6W3siZ3JvdXAiOjAsImF0dHIiOiJTIiwic0NvbG91ciI6IlIifSx7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MSwiYXR0ciI6IkQiLCJzQ29sb3VyIjoiRyJ9XSwibmFtZSI6IkFkdmFuY2luZyBGb3J0cmVzcyIsInR5cGVMaW5lIjoiR3V0IFJpcHBlciIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjU1LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQ2xhdyIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJRdWFsaXR5IiwidmFsdWVzIjpbWyIrMjAlIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo2fSx7Im5hbWUiOiJQaHlzaWNhbCBEYW1hZ2UiLCJ2YWx1ZXMiOltbIjQ0LTExNyIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6OX0seyJuYW1lIjoiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsInZhbHVlcyI6W1siNi4zMCUiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjEyfSx7Im5hbWUiOiJBdHRhY2tzIHBlciBTZWNvbmQiLCJ2YWx1ZXMiOltbIjEuNTAiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjEzfSx7Im5hbWUiOiJXZWFwb24gUmFuZ2UiLCJ2YWx1ZXMiOltbIjExIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siOTgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTUxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sImltcGxpY2l0TW9kcyI6WyIrNDQgTGlmZSBnYWluZWQgZm9yIGVhY2ggRW5lbXkgaGl0IGJ5IEF0dGFja3MiXSwiZXhwbGljaXRNb2RzIjpbIlNvY2tldGVkIEdlbXMgYXJlIFN1cHBvcnRlZCBieSBMZXZlbCAxMiBGb3J0aWZ5IiwiMTUlIENoYW5jZSB0byBCbG9jayBBdHRhY2sgRGFtYWdlIiwiMTAxJSBpbmNyZWFzZWQgUGh5c2ljYWwgRGFtYWdlIiwiKzExMCB0byBFdmFzaW9uIFJhdGluZyIsIiszNSB0byBtYXhpbXVtIEVuZXJneSBTaGllbGQiLCIrNDYgdG8gbWF4aW11bSBMaWZlIiwiUmVmbGVjdHMgODIgUGh5c2ljYWwgRGFtYWdlIHRvIE1lbGVlIEF0dGFja2VycyJdLCJmbGF2b3VyVGV4dCI6WyJcIkEgbWFuIGNvd2VycyBiZWhpbmQgaGlzIHdhbGxzLlxyIiwiQSB3b21hbiBjYXJyaWVzIGhlciBmb3J0cmVzcyB3aXRoIGhlci5cciIsIkluIGhlYXJ0LCBpbiBtaW5kLCBpbiBoYW5kLlwiXHIiLCItIFNla2hlbWEgRGVzaHJldCJdLCJmcmFtZVR5cGUiOjMsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiV2VhcG9uIiwic29ja2V0ZWRJdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9DYXN0T25EbWdUYWtlbi5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9ZTAzY2ViMWEzMWU0NTdkYmZlMzBlOTY4Yzc2ZmIwZDYiLCJzdXBwb3J0Ijp0cnVlLCJpZCI6Ijg1NjVlZTk0Y2FkNTFjNGE3OTQ2NzZjZGE1ZmJiYmYzNmU5N2U3NTViNDZhMTliN2IwOGNlODAxMWM4ZTg2YzUiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJDYXN0IHdoZW4gRGFtYWdlIFRha2VuIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCwgU3BlbGwsIFRyaWdnZXIiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJDb29sZG93biBUaW1lIiwidmFsdWVzIjpbWyIwLjI1IHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siNDgiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiRWFjaCBzdXBwb3J0ZWQgc3BlbGwgc2tpbGwgd2lsbCB0cmFjayBkYW1hZ2UgeW91IHRha2UsIGFuZCBiZSB0cmlnZ2VyZWQgd2hlbiB0aGUgdG90YWwgZGFtYWdlIHRha2VuIHJlYWNoZXMgYSB0aHJlc2hvbGQuIENhbm5vdCBzdXBwb3J0IHNraWxscyB1c2VkIGJ5IHRvdGVtcywgdHJhcHMsIG9yIG1pbmVzLiBWYWFsIHNraWxscywgY2hhbm5lbGxpbmcgc2tpbGxzLCBhbmQgc2tpbGxzIHRoYXQgcmVzZXJ2ZSBtYW5hIGNhbm5vdCBiZSB0cmlnZ2VyZWQuIiwiZXhwbGljaXRNb2RzIjpbIlRoaXMgR2VtIGNhbiBvbmx5IFN1cHBvcnQgU2tpbGwgR2VtcyByZXF1aXJpbmcgTGV2ZWwgNzAgb3IgbG93ZXIiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgNiUgbW9yZSBEYW1hZ2UiLCJZb3UgY2Fubm90IENhc3QgU3VwcG9ydGVkIFRyaWdnZXJhYmxlIFNwZWxscyBkaXJlY3RseSIsIlRyaWdnZXIgU3VwcG9ydGVkIFNwZWxscyB3aGVuIHlvdSB0YWtlIGEgdG90YWwgb2YgMzI3MiBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjowLCJjb2xvdXIiOiJTIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0JvbmVPZmZlcmluZy5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NTYxZGRjNmYxNjNhMTc1NjIxOWNmZGU3OGM1MjUzYmIiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiIwYWJlYzA3NTZjYTZmODAyNGQ3NDJiYWI3OTg0NDU1NjdhODBlMGNiNWJjYTA4ODY3YTZiODZlZTQ1NWY3ZTE0IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQm9uZSBPZmZlcmluZyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6Ik1pbmlvbiwgU3BlbGwsIER1cmF0aW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIzMiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIxLjAwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJhZGRpdGlvbmFsUHJvcGVydGllcyI6W3sibmFtZSI6IkV4cGVyaWVuY2UiLCJ2YWx1ZXMiOltbIjE1NzAzOTVcLzIxMjA0NjAxNyIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMDA3NDA1OTE2MjI4ODkwNDE5LCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjgiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTUxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNvbnN1bWVzIGEgY29ycHNlLCBncmFudGluZyBhbGwgb2YgeW91ciBtaW5pb25zIHRoZSBwb3dlciB0byBibG9jayBib3RoIGF0dGFja3MgYW5kIHNwZWxscy4gVGhlIHNraWxsIGNvbnN1bWVzIG90aGVyIG5lYXJieSBjb3Jwc2VzLCBpbmNyZWFzaW5nIHRoZSBkdXJhdGlvbiBmb3IgZWFjaCBjb3Jwc2UgY29uc3VtZWQuIiwiZXhwbGljaXRNb2RzIjpbIk1pbmlvbnMgcmVjb3ZlciA1MjggTGlmZSB3aGVuIHRoZXkgQmxvY2siLCJCYXNlIGR1cmF0aW9uIGlzIDUuMDAgc2Vjb25kcyIsIkFkZGl0aW9uYWwgMS4wMCBzZWNvbmRzIEJhc2UgRHVyYXRpb24gcGVyIGV4dHJhIGNvcnBzZSBDb25zdW1lZCIsIkdyYW50cyBNaW5pb25zICszNCUgQ2hhbmNlIHRvIEJsb2NrIEF0dGFjayBEYW1hZ2UiLCJHcmFudHMgTWluaW9ucyArMzQlIENoYW5jZSB0byBCbG9jayBTcGVsbCBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjEsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvRGVzZWNyYXRlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1kZmMyYTc0NjgwOTk0YzlkOWYwMGI3ODcyYjA3Zjc2YiIsInN1cHBvcnQiOmZhbHNlLCJpZCI6ImFiMTg0YzBhNmRiNTcxMDczMzA4MDk4ZDYxMzFmNTAyZWFmNWJkZjQxMmIyOTVlN2UyNWM1NDcwZGE3MWZhY2UiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJEZXNlY3JhdGUiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3BlbGwsIEFvRSwgRHVyYXRpb24sIENoYW9zIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIyNiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjYwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siOTgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siNjgiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiRGVzZWNyYXRlcyB0aGUgZ3JvdW5kLCBzdW1tb25pbmcgY29ycHNlcyBhbmQgZGVhbGluZyBjaGFvcyBkYW1hZ2UgdG8gYWxsIGVuZW1pZXMgaW4gdGhlIGFyZWEuIFRoZSBjb3Jwc2VzIHdpbGwgYmUgY2hvc2VuIGZyb20gdGhlIG1vbnN0ZXJzIGluIHRoZSBjdXJyZW50IGFyZWEgYW5kIGFueSBTcGVjdHJlcyB0aGF0IGhhdmUgZXhpc3RlZCBpbiB0aGlzIGluc3RhbmNlLiIsImV4cGxpY2l0TW9kcyI6WyJEZWFscyAyOTQgQmFzZSBDaGFvcyBEYW1hZ2UgcGVyIHNlY29uZCIsIkJhc2UgZHVyYXRpb24gaXMgNC4wMCBzZWNvbmRzIiwiU3Bhd25zIDUgY29ycHNlcyIsIlNwYXducyBjb3Jwc2VzIHVwIHRvIExldmVsIDEwMCIsIk1heGltdW0gb2YgMTAgY29ycHNlcyBhbGxvd2VkIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJEIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MiwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvZ2VuXC9pbWFnZVwvV3prc05DeDdJbVlpT2lKQmNuUmNMekpFU1hSbGJYTmNMMFpzWVhOcmMxd3ZiV0Z1WVdac1lYTnJNVEJ2YkdRaUxDSnpjQ0k2TUM0Mk1EZzFMQ0pzWlhabGJDSTZNWDFkXC8yYTFhNGJmZDU5XC9JdGVtLnBuZyIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiJkNWFkMjQxZGYyYWJjZDU5YjRiODAwYWFlODVhZDQ4YThlMzlkZTkyNmUzMzE2MmMwNjJkOWNlOTMxOTFlZDQ0IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQnViYmxpbmcgRGl2aW5lIE1hbmEgRmxhc2sgb2YgSGVhdCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjYyLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiUmVjb3ZlcnMgJTAgTWFuYSBvdmVyICUxIFNlY29uZHMiLCJ2YWx1ZXMiOltbIjcwMCIsMV0sWyIyLjEwIiwxXV0sImRpc3BsYXlNb2RlIjozfSx7Im5hbWUiOiJDb25zdW1lcyAlMCBvZiAlMSBDaGFyZ2VzIG9uIHVzZSIsInZhbHVlcyI6W1siOCIsMF0sWyI0MiIsMF1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwidmFsdWVzIjpbWyI0MiIsMF1dLCJkaXNwbGF5TW9kZSI6M31dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjAiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiZXhwbGljaXRNb2RzIjpbIjUwJSByZWR1Y2VkIEFtb3VudCBSZWNvdmVyZWQiLCIxMzUlIGluY3JlYXNlZCBSZWNvdmVyeSByYXRlIiwiNTAlIG9mIFJlY292ZXJ5IGFwcGxpZWQgSW5zdGFudGx5IiwiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIl0sImRlc2NyVGV4dCI6IlJpZ2h0IGNsaWNrIHRvIGRyaW5rLiBDYW4gb25seSBob2xkIGNoYXJnZXMgd2hpbGUgaW4gYmVsdC4gUmVmaWxscyBhcyB5b3Uga2lsbCBtb25zdGVycy4iLCJmcmFtZVR5cGUiOjEsIngiOjMsInkiOjAsImludmVudG9yeUlkIjoiRmxhc2sifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MiwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvZ2VuXC9pbWFnZVwvV3prc05DeDdJbVlpT2lKQmNuUmNMekpFU1hSbGJYTmNMMFpzWVhOcmMxd3ZjMkZ3Y0docGNtVWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZFwvMDlkODNjNjJhZFwvSXRlbS5wbmciLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiMTZkNzY1NGVmNWFkMTYxMGZhZTllM2UyYjk5YmZiNDUyZDc2MTZlOTM2NTVjNmVkMjljZWJmYmNhYWMwMWQzYiIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkV4cGVyaW1lbnRlcidzIFNhcHBoaXJlIEZsYXNrIG9mIEhlYXQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3NCwicHJvcGVydGllcyI6W3sibmFtZSI6Ikxhc3RzICUwIFNlY29uZHMiLCJ2YWx1ZXMiOltbIjUuNjAiLDFdXSwiZGlzcGxheU1vZGUiOjN9LHsibmFtZSI6IkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwidmFsdWVzIjpbWyIzMCIsMF0sWyI2MCIsMF1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwidmFsdWVzIjpbWyI2MCIsMF1dLCJkaXNwbGF5TW9kZSI6M31dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMTgiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwidXRpbGl0eU1vZHMiOlsiKzUwJSB0byBDb2xkIFJlc2lzdGFuY2UiLCIyMCUgbGVzcyBDb2xkIERhbWFnZSB0YWtlbiJdLCJleHBsaWNpdE1vZHMiOlsiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIiwiNDAlIGluY3JlYXNlZCBEdXJhdGlvbiJdLCJkZXNjclRleHQiOiJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwiZnJhbWVUeXBlIjoxLCJ4IjoxLCJ5IjowLCJpbnZlbnRvcnlJZCI6IkZsYXNrIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjMsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL1dlYXBvbnNcL09uZUhhbmRXZWFwb25zXC9EYWdnZXJzXC9EYWdnZXI3LnBuZz9zY2FsZT0xJnc9MSZoPTMmdj0yNTI0ZjUzNThkOWM5ZjQ2ZDQwYzZkZmU2MWMzZjE3ZSIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiJhMmZiNDY3Yzg1NzUyZWI1MWNjMWFjNzUyYjJjODQ2ZTVmNjc3OGU0MzBmYzhmMDE1ZmI5NjdjNDYyNWM3MTc1Iiwic29ja2V0cyI6W3siZ3JvdXAiOjAsImF0dHIiOiJEIiwic0NvbG91ciI6IkcifSx7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn0seyJncm91cCI6MSwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9XSwibmFtZSI6IiIsInR5cGVMaW5lIjoiUm95YWwgU2tlYW4iLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo1MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlJ1bmUgRGFnZ2VyIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlBoeXNpY2FsIERhbWFnZSIsInZhbHVlcyI6W1siMTYtNjQiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjl9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjYuMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxMn0seyJuYW1lIjoiQXR0YWNrcyBwZXIgU2Vjb25kIiwidmFsdWVzIjpbWyIxLjQ1IiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxM30seyJuYW1lIjoiV2VhcG9uIFJhbmdlIiwidmFsdWVzIjpbWyIxMCIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTR9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjY4IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjk1IiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjE1MSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJpbXBsaWNpdE1vZHMiOlsiMzAlIGluY3JlYXNlZCBHbG9iYWwgQ3JpdGljYWwgU3RyaWtlIENoYW5jZSJdLCJmcmFtZVR5cGUiOjAsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiV2VhcG9uMiIsInNvY2tldGVkSXRlbXMiOlt7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvQm9uZUxhbmNlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj01YTA2NTY3NzI2MjNlNDJlNjM1ZjI4MjExZTM4NTAxNyIsInN1cHBvcnQiOmZhbHNlLCJpZCI6ImRhNDNkN2RmZTBjNjUxNjI1Y2I2NjY1YTRkM2FjN2EyZWZkYzgzN2MzYmNiMDRiMWYwMzY5ZTI1MTM4NjMxYzciLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJVbmVhcnRoIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlNwZWxsLCBQcm9qZWN0aWxlLCBBb0UsIFBoeXNpY2FsIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjYwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsInZhbHVlcyI6W1siNi4wMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkVmZmVjdGl2ZW5lc3Mgb2YgQWRkZWQgRGFtYWdlIiwidmFsdWVzIjpbWyIxMjUlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siNzk1ODQ5NzVcLzIxMjA1MTU5OSIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMzc1MzA5NDY3MzE1NjczODMsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyI5NSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyI2NiIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJGaXJlcyBhIHByb2plY3RpbGUgdGhhdCB3aWxsIHBpZXJjZSB0aHJvdWdoIGVuZW1pZXMgdG8gaW1wYWN0IHRoZSBncm91bmQgYXQgdGhlIHRhcmdldGVkIGxvY2F0aW9uLCBjcmVhdGluZyBhIEJvbmUgQXJjaGVyIGNvcnBzZSB3aGVyZSBpdCBsYW5kcy4iLCJleHBsaWNpdE1vZHMiOlsiRGVhbHMgNjI0IHRvIDkzNiBQaHlzaWNhbCBEYW1hZ2UiLCJQcm9qZWN0aWxlcyBQaWVyY2UgYWxsIFRhcmdldHMiLCJTcGF3bnMgY29ycHNlcyB3aXRoIExldmVsIDc3IiwiTWF4aW11bSBvZiAxMCBjb3Jwc2VzIGFsbG93ZWQiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjAsImNvbG91ciI6IkQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvQm9uZUxhbmNlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj01YTA2NTY3NzI2MjNlNDJlNjM1ZjI4MjExZTM4NTAxNyIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjFhMjUwMWE2MGQ2ZWYwYzNhZTAzZjMyMTFhMDFlNTc5ZWQzMWUzMTY0N2FmMjNhMTUxM2JjMjdlODdlZmU0YmIiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJVbmVhcnRoIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlNwZWxsLCBQcm9qZWN0aWxlLCBBb0UsIFBoeXNpY2FsIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjYwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsInZhbHVlcyI6W1siNi4wMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkVmZmVjdGl2ZW5lc3Mgb2YgQWRkZWQgRGFtYWdlIiwidmFsdWVzIjpbWyIxMjUlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siNzk1NzQwOTVcLzIxMjA1MTU5OSIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMzc1MjU4MTc3NTE4ODQ0NiwidHlwZSI6MjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjY4IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjk1IiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjY2IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkZpcmVzIGEgcHJvamVjdGlsZSB0aGF0IHdpbGwgcGllcmNlIHRocm91Z2ggZW5lbWllcyB0byBpbXBhY3QgdGhlIGdyb3VuZCBhdCB0aGUgdGFyZ2V0ZWQgbG9jYXRpb24sIGNyZWF0aW5nIGEgQm9uZSBBcmNoZXIgY29ycHNlIHdoZXJlIGl0IGxhbmRzLiIsImV4cGxpY2l0TW9kcyI6WyJEZWFscyA2MjQgdG8gOTM2IFBoeXNpY2FsIERhbWFnZSIsIlByb2plY3RpbGVzIFBpZXJjZSBhbGwgVGFyZ2V0cyIsIlNwYXducyBjb3Jwc2VzIHdpdGggTGV2ZWwgNzciLCJNYXhpbXVtIG9mIDEwIGNvcnBzZXMgYWxsb3dlZCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MSwiY29sb3VyIjoiRCJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9MaWdodG5pbmdSZXNpc3RBdXJhLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1jYTBmZDg5NjExNWI2NGM1ZDIxYjEwNGI1YTg0NjMwYiIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjMxMWY4NWMxNzJlZmI3MGRjMjQ5Nzk1MTVlY2VmZWY0ZGUzYzY4OWFhNzM0NGQwM2VkYTNjZmNjYjgwMjk1ZDAiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJQdXJpdHkgb2YgTGlnaHRuaW5nIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IkF1cmEsIFNwZWxsLCBBb0UsIExpZ2h0bmluZyIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMTkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgUmVzZXJ2ZWQiLCJ2YWx1ZXMiOltbIjM1JSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siMS4yMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siSW5zdGFudCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJhZGRpdGlvbmFsUHJvcGVydGllcyI6W3sibmFtZSI6IkV4cGVyaWVuY2UiLCJ2YWx1ZXMiOltbIjc5OTc0Njk3XC8yMTE4Nzc2ODMiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjowLjM3NzQ1NjkwMzQ1NzY0MTYsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxNTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiQ2FzdHMgYW4gYXVyYSB0aGF0IGdyYW50cyBsaWdodG5pbmcgcmVzaXN0YW5jZSB0byB5b3UgYW5kIHlvdXIgYWxsaWVzLiIsImV4cGxpY2l0TW9kcyI6WyIrMTggdG8gcmFkaXVzIiwiWW91IGFuZCBuZWFyYnkgYWxsaWVzIGdhaW4gNDAlIGFkZGl0aW9uYWwgTGlnaHRuaW5nIFJlc2lzdGFuY2UiLCJZb3UgYW5kIG5lYXJieSBhbGxpZXMgZ2FpbiAzJSBhZGRpdGlvbmFsIG1heGltdW0gTGlnaHRuaW5nIFJlc2lzdGFuY2UiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjIsImNvbG91ciI6IkkifV19LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjozLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9XZWFwb25zXC9PbmVIYW5kV2VhcG9uc1wvT25lSGFuZE1hY2VzXC9PbmVIYW5kTWFjZTMucG5nP3NjYWxlPTEmdz0xJmg9MyZ2PTBkYTIxMTUxM2I1M2RjOGFjYzFhMDQwZWUxOGI2MzQ3IiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6IjgxNjZlNWExN2IzYmM1MmY5NzlmNGZmNDI3ZDE2ZGUzNWI1ZTM2NjFkYzExMGMwMzcwMDlmYTg4NWMyZWFhN2MiLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJTIiwic0NvbG91ciI6IlIifSx7Imdyb3VwIjoxLCJhdHRyIjoiUyIsInNDb2xvdXIiOiJSIn1dLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJCYXJiZWQgQ2x1YiIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjUwLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiT25lIEhhbmRlZCBNYWNlIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlBoeXNpY2FsIERhbWFnZSIsInZhbHVlcyI6W1siMzMtNDIiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjl9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjUuMDAlIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxMn0seyJuYW1lIjoiQXR0YWNrcyBwZXIgU2Vjb25kIiwidmFsdWVzIjpbWyIxLjQwIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxM30seyJuYW1lIjoiV2VhcG9uIFJhbmdlIiwidmFsdWVzIjpbWyIxMSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTR9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjY5IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjEyNSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxNTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiaW1wbGljaXRNb2RzIjpbIjEwJSByZWR1Y2VkIEVuZW15IFN0dW4gVGhyZXNob2xkIl0sImZyYW1lVHlwZSI6MCwieCI6MCwieSI6MCwiaW52ZW50b3J5SWQiOiJPZmZoYW5kMiIsInNvY2tldGVkSXRlbXMiOlt7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvTGlnaHRuaW5nUmVzaXN0QXVyYS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9Y2EwZmQ4OTYxMTViNjRjNWQyMWIxMDRiNWE4NDYzMGIiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiI1MDgxNTViNGE1MWQ0MGYwNjA3NmM5OTc1MDkwYjY3MDA0M2NiZWZmNDk5ZTBlNjczNzc0MTIxYTAzOGU1MTFiIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiUHVyaXR5IG9mIExpZ2h0bmluZyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJBdXJhLCBTcGVsbCwgQW9FLCBMaWdodG5pbmciLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjE5IiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIFJlc2VydmVkIiwidmFsdWVzIjpbWyIzNSUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNvb2xkb3duIFRpbWUiLCJ2YWx1ZXMiOltbIjEuMjAgc2VjIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJDYXN0IFRpbWUiLCJ2YWx1ZXMiOltbIkluc3RhbnQiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiYWRkaXRpb25hbFByb3BlcnRpZXMiOlt7Im5hbWUiOiJFeHBlcmllbmNlIiwidmFsdWVzIjpbWyI3OTk3NDY5N1wvMjExODc3NjgzIiwwXV0sImRpc3BsYXlNb2RlIjoyLCJwcm9ncmVzcyI6MC4zNzc0NTY5MDM0NTc2NDE2LCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjgiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTUxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNhc3RzIGFuIGF1cmEgdGhhdCBncmFudHMgbGlnaHRuaW5nIHJlc2lzdGFuY2UgdG8geW91IGFuZCB5b3VyIGFsbGllcy4iLCJleHBsaWNpdE1vZHMiOlsiKzE4IHRvIHJhZGl1cyIsIllvdSBhbmQgbmVhcmJ5IGFsbGllcyBnYWluIDQwJSBhZGRpdGlvbmFsIExpZ2h0bmluZyBSZXNpc3RhbmNlIiwiWW91IGFuZCBuZWFyYnkgYWxsaWVzIGdhaW4gMyUgYWRkaXRpb25hbCBtYXhpbXVtIExpZ2h0bmluZyBSZXNpc3RhbmNlIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjowLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0JydXRhbGl0eS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9ZTM5YzNhYmVjMjk5MjMzOGFjYmJlYWMzN2UzMWZhZTciLCJzdXBwb3J0Ijp0cnVlLCJpZCI6IjliOWFjNDFkNzUwZDIzMDU2MWY4NGI5YzMzMDJlMDdiNDI4ODdkNjc2MDhhMmY1ODJkZmEyYmNmOWExN2ZjZTMiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJCcnV0YWxpdHkgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJQaHlzaWNhbCwgU3VwcG9ydCIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMTkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgTXVsdGlwbGllciIsInZhbHVlcyI6W1siMTMwJSIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJhZGRpdGlvbmFsUHJvcGVydGllcyI6W3sibmFtZSI6IkV4cGVyaWVuY2UiLCJ2YWx1ZXMiOltbIjMwMzM0MjQ1XC8xNTc0MDUwNjMiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjowLjE5MjcxNDU0MjE1MDQ5NzQ0LCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjkiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siMTA5IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1cHBvcnRzIGFueSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcy4iLCJleHBsaWNpdE1vZHMiOlsiU3VwcG9ydGVkIFNraWxscyBkZWFsIDU4JSBtb3JlIFBoeXNpY2FsIERhbWFnZSIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCBubyBDaGFvcyBEYW1hZ2UiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgbm8gRWxlbWVudGFsIERhbWFnZSJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjEsImNvbG91ciI6IlMifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvQnJ1dGFsaXR5LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMzljM2FiZWMyOTkyMzM4YWNiYmVhYzM3ZTMxZmFlNyIsInN1cHBvcnQiOnRydWUsImlkIjoiODcyOTVjMmYxOTgzOWE4MzNjMzBmNjVmODY4NTA4MjkxMTRjMWFmYjA2YjZlMjliNTFlMWVkYjk0YzYzODY0MCIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkJydXRhbGl0eSBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlBoeXNpY2FsLCBTdXBwb3J0IiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siMzAxOTYxNjBcLzE1NzQwNTA2MyIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMTkxODM3Mjk1ODg5ODU0NDMsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2OSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyIxMDkiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgYW55IHNraWxsIHRoYXQgaGl0cyBlbmVtaWVzLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgNTglIG1vcmUgUGh5c2ljYWwgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBkZWFsIG5vIENoYW9zIERhbWFnZSIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCBubyBFbGVtZW50YWwgRGFtYWdlIl0sImRlc2NyVGV4dCI6IlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MiwiY29sb3VyIjoiUyJ9XX0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL1JpbmdzXC9Ub3BhelJ1YnkucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTBiZDNjNzE5N2I5NTI5ODFlYjIxY2IwZGMyNzhjMDRkIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6IjgxOWZiN2NlN2UxY2IwYzM5OTkxMWQwYTczMWVkZTFiNmIwNmZjYThmN2IyYTNiOWZiZDkyMzA2N2NiYTU3YzYiLCJuYW1lIjoiRm9lIEtudWNrbGUiLCJ0eXBlTGluZSI6IlR3by1TdG9uZSBSaW5nIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6NzMsInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI0OCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJpbXBsaWNpdE1vZHMiOlsiKzE2JSB0byBGaXJlIGFuZCBMaWdodG5pbmcgUmVzaXN0YW5jZXMiXSwiZXhwbGljaXRNb2RzIjpbIisxMiB0byBTdHJlbmd0aCIsIkFkZHMgMSB0byAyIFBoeXNpY2FsIERhbWFnZSB0byBBdHRhY2tzIiwiKzM5JSB0byBGaXJlIFJlc2lzdGFuY2UiLCIrMzYlIHRvIENvbGQgUmVzaXN0YW5jZSIsIjAuMjMlIG9mIFBoeXNpY2FsIEF0dGFjayBEYW1hZ2UgTGVlY2hlZCBhcyBMaWZlIl0sImNyYWZ0ZWRNb2RzIjpbIisyNCB0byBtYXhpbXVtIExpZmUiXSwiZnJhbWVUeXBlIjoyLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IlJpbmcyIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2dlblwvaW1hZ2VcL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YkdsbVpXWnNZWE5yTVRCdmJHUWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZFwvZTVlN2ZkMDE1YVwvSXRlbS5wbmciLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiNDU3MWVhYzQ2ODU5MTRiMDdlZGYwNTJkNGQ1ZDNiNzIzODExYjE4YjRmN2JjYzUyNDUxMzdjZjhlOTU5Zjc4MSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IlNlZXRoaW5nIERpdmluZSBMaWZlIEZsYXNrIG9mIERvdXNpbmciLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2MywicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IlJlY292ZXJzICUwIExpZmUgb3ZlciAlMSBTZWNvbmRzIiwidmFsdWVzIjpbWyI5NzkiLDFdLFsiNy4wMCIsMF1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ29uc3VtZXMgJTAgb2YgJTEgQ2hhcmdlcyBvbiB1c2UiLCJ2YWx1ZXMiOltbIjE1IiwwXSxbIjQ1IiwwXV0sImRpc3BsYXlNb2RlIjozfSx7Im5hbWUiOiJDdXJyZW50bHkgaGFzICUwIENoYXJnZXMiLCJ2YWx1ZXMiOltbIjQ1IiwwXV0sImRpc3BsYXlNb2RlIjozfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJleHBsaWNpdE1vZHMiOlsiNjYlIHJlZHVjZWQgQW1vdW50IFJlY292ZXJlZCIsIkluc3RhbnQgUmVjb3ZlcnkiLCJJbW11bml0eSB0byBJZ25pdGUgZHVyaW5nIEZsYXNrIGVmZmVjdFxuUmVtb3ZlcyBCdXJuaW5nIG9uIHVzZSJdLCJkZXNjclRleHQiOiJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwiZnJhbWVUeXBlIjoxLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IkZsYXNrIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2dlblwvaW1hZ2VcL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YzNSdmJtVWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZFwvYTQ4ZWJkY2RlZFwvSXRlbS5wbmciLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiODliZGJhODk0ZDVkZThkMDcxYWNiMTM0NTE2ZTM0OTMzNmYyOWIwMTMwZmZiMWVmMzhiNDIzM2UzZTJmNDc0OCIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkV4cGVyaW1lbnRlcidzIEJhc2FsdCBGbGFzayBvZiBIZWF0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6NjgsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJRdWFsaXR5IiwidmFsdWVzIjpbWyIrMTIlIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo2fSx7Im5hbWUiOiJMYXN0cyAlMCBTZWNvbmRzIiwidmFsdWVzIjpbWyI2LjYwIiwxXV0sImRpc3BsYXlNb2RlIjozfSx7Im5hbWUiOiJDb25zdW1lcyAlMCBvZiAlMSBDaGFyZ2VzIG9uIHVzZSIsInZhbHVlcyI6W1siNDAiLDBdLFsiNjAiLDBdXSwiZGlzcGxheU1vZGUiOjN9LHsibmFtZSI6IkN1cnJlbnRseSBoYXMgJTAgQ2hhcmdlcyIsInZhbHVlcyI6W1siNjAiLDBdXSwiZGlzcGxheU1vZGUiOjN9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjQwIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInV0aWxpdHlNb2RzIjpbIjE1JSBhZGRpdGlvbmFsIFBoeXNpY2FsIERhbWFnZSBSZWR1Y3Rpb24iLCIyMCUgb2YgTWVsZWUgUGh5c2ljYWwgRGFtYWdlIHRha2VuIHJlZmxlY3RlZCB0byBBdHRhY2tlciJdLCJleHBsaWNpdE1vZHMiOlsiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIiwiMzQlIGluY3JlYXNlZCBEdXJhdGlvbiJdLCJkZXNjclRleHQiOiJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwiZnJhbWVUeXBlIjoxLCJ4Ijo0LCJ5IjowLCJpbnZlbnRvcnlJZCI6IkZsYXNrIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2dlblwvaW1hZ2VcL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YzJsc2RtVnlJaXdpYzNBaU9qQXVOakE0TlN3aWJHVjJaV3dpT2pGOVhRXC9iNGVkY2JhODBmXC9JdGVtLnBuZyIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiIzZGQwMGQ0YzVjNGYyM2MyY2UyZWM0YTI1ZjcyMjhiZTM4MmJkNWJhM2VmMDVmODc1NjI5MWUwNjk2MDc1MzIwIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQW1wbGUgU2lsdmVyIEZsYXNrIG9mIEhlYXQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2NSwicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6Ikxhc3RzICUwIFNlY29uZHMiLCJ2YWx1ZXMiOltbIjYuMDAiLDFdXSwiZGlzcGxheU1vZGUiOjN9LHsibmFtZSI6IkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwidmFsdWVzIjpbWyI0MCIsMF0sWyI4MCIsMV1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwidmFsdWVzIjpbWyI4MCIsMF1dLCJkaXNwbGF5TW9kZSI6M31dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjIiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwidXRpbGl0eU1vZHMiOlsiT25zbGF1Z2h0Il0sImV4cGxpY2l0TW9kcyI6WyIrMjAgdG8gTWF4aW11bSBDaGFyZ2VzIiwiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIl0sImRlc2NyVGV4dCI6IlJpZ2h0IGNsaWNrIHRvIGRyaW5rLiBDYW4gb25seSBob2xkIGNoYXJnZXMgd2hpbGUgaW4gYmVsdC4gUmVmaWxscyBhcyB5b3Uga2lsbCBtb25zdGVycy4iLCJmcmFtZVR5cGUiOjEsIngiOjIsInkiOjAsImludmVudG9yeUlkIjoiRmxhc2sifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQW11bGV0c1wvQW11bGV0NC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9Y2MzZWMxNjdjYTllYTEwZGZhMDViMmQ1NWI1ZGNmNjIiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiN2NhYjI2ODBkNjU1ZDZkZjBlYWM3MDhkZDZlM2IzMTgwNjZjMTcwNTI5M2IxYzQ0ODEwYTU4NDA2ZDNjNzU0MCIsIm5hbWUiOiJEYW1uYXRpb24gTWVkYWxsaW9uIiwidHlwZUxpbmUiOiJKYWRlIEFtdWxldCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjcwLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMzkiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiaW1wbGljaXRNb2RzIjpbIisyNSB0byBEZXh0ZXJpdHkiXSwiZXhwbGljaXRNb2RzIjpbIkFkZHMgMSB0byAyOCBMaWdodG5pbmcgRGFtYWdlIHRvIEF0dGFja3MiLCIzJSBpbmNyZWFzZWQgbWF4aW11bSBFbmVyZ3kgU2hpZWxkIiwiKzYlIHRvIGFsbCBFbGVtZW50YWwgUmVzaXN0YW5jZXMiLCIrMzUlIHRvIExpZ2h0bmluZyBSZXNpc3RhbmNlIiwiKzMgTWFuYSBnYWluZWQgb24gS2lsbCJdLCJjcmFmdGVkTW9kcyI6WyIrMzAgdG8gbWF4aW11bSBMaWZlIl0sImZyYW1lVHlwZSI6MiwieCI6MCwieSI6MCwiaW52ZW50b3J5SWQiOiJBbXVsZXQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MiwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQXJtb3Vyc1wvQm9vdHNcL2Jvb3R1bmlxdWUucG5nP3NjYWxlPTEmdz0yJmg9MiZ2PTViNTgxYzJjYjdkNzdkMDYzYjM2ZTA4ZjU5YWRlYTdlIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImExZDY4MWQ0YTMxZTIzNDBlZjg5ZDU2ZGE5ODk1M2QxNzQwYzFmYWUyZDQ1ZmNmMjUwM2E5MDNlYTIxNjVjNzMiLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkQiLCJzQ29sb3VyIjoiRyJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifSx7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IlMiLCJzQ29sb3VyIjoiUiJ9XSwibmFtZSI6IkJvbmVzIG9mIFVsbHIiLCJ0eXBlTGluZSI6IlNpbGsgU2xpcHBlcnMiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjozMywicHJvcGVydGllcyI6W3sibmFtZSI6IkVuZXJneSBTaGllbGQiLCJ2YWx1ZXMiOltbIjIyIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxOH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siOTgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMTExIiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJleHBsaWNpdE1vZHMiOlsiNDklIGluY3JlYXNlZCBFbmVyZ3kgU2hpZWxkIiwiKzIwIHRvIG1heGltdW0gTGlmZSIsIisyMCB0byBtYXhpbXVtIE1hbmEiLCIrMSB0byBMZXZlbCBvZiBhbGwgUmFpc2UgWm9tYmllIEdlbXMiLCIrMSB0byBMZXZlbCBvZiBhbGwgUmFpc2UgU3BlY3RyZSBHZW1zIiwiNSUgaW5jcmVhc2VkIE1vdmVtZW50IFNwZWVkIl0sImVuY2hhbnRNb2RzIjpbIkFkZHMgMTYgdG8gMjQgQ29sZCBEYW1hZ2UgaWYgeW91J3ZlIGJlZW4gSGl0IFJlY2VudGx5Il0sImZsYXZvdXJUZXh0IjpbIlRoZSBkZWFkIG1hbiB3YWxrcyB3aGVyZVxyIiwidGhlIGxpdmluZyBmZWFyIHRvIHRyZWFkLiJdLCJmcmFtZVR5cGUiOjMsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiQm9vdHMiLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0dyZWF0ZXJNdWx0aXBsZVByb2plY3RpbGVzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMWI2ZmIyNmU1OTM3OTU3ZTA4Y2U4MGUxMTNmNDFlMiIsInN1cHBvcnQiOnRydWUsImlkIjoiMmY1NjU1YTJjMjczN2M4ZDY3OTMwZDM2YmY4OWI0OTRmOTk0ZTk3NTViN2NiOTI0NmY0ZWE0MzcxY2E5OTYzMSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkdyZWF0ZXIgTXVsdGlwbGUgUHJvamVjdGlsZXMgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTdXBwb3J0LCBQcm9qZWN0aWxlIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxNjUlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgcHJvamVjdGlsZSBza2lsbHMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAyNiUgbGVzcyBQcm9qZWN0aWxlIERhbWFnZSIsIlN1cHBvcnRlZCBTa2lsbHMgZmlyZSA0IGFkZGl0aW9uYWwgUHJvamVjdGlsZXMiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjowLCJjb2xvdXIiOiJEIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0VsZW1lbnRhbEZvY3VzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj05MGMwNWVkYTY3ZjExYTQzYjk1ZDIyZDdkNzcwZDExNSIsInN1cHBvcnQiOnRydWUsImlkIjoiZjE5ZmQ4NTBhZjkxMDA5M2ZmZmUyYTcxMzE0YWJkNmMyMjBkZjJiMGQwNDdiZWIzZGRjYTY0ZmE5MTZjMTU2OSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkVsZW1lbnRhbCBGb2N1cyBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjEzMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIG9yIGJ1cm5zIGVuZW1pZXMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCA0OSUgbW9yZSBFbGVtZW50YWwgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBjYW5ub3QgaW5mbGljdCBFbGVtZW50YWwgQWlsbWVudHMiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoxLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0VjaG8ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTE3MjY1ZmIxMjI2M2ZjYTM0MWRkYzQ0M2EyNDJkMzA2Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI2NTRlNTFkMDliNjYwY2QyYmI5OWZiZTQxZWFiZjI5NmRmMTc3MTJlOWJmMmI2ODRkNjc0NDZlOTFhMzExNzM0IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiU3BlbGwgRWNobyBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlNwZWxsLCBTdXBwb3J0IiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxNDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgc3BlbGwgc2tpbGxzLCBtYWtpbmcgdGhlbSByZXBlYXQgd2hlbiBjYXN0LiBDYW5ub3Qgc3VwcG9ydCBWYWFsIHNraWxscywgdG90ZW0gc2tpbGxzLCBjaGFubmVsbGluZyBza2lsbHMsIHRyaWdnZXJlZCBza2lsbHMsIGluc3RhbnQgc2tpbGxzLCBvciBza2lsbHMgd2hpY2ggcmVzZXJ2ZSBtYW5hLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMTAlIGxlc3MgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBSZXBlYXQgYW4gYWRkaXRpb25hbCB0aW1lIiwiU3VwcG9ydGVkIFNraWxscyBoYXZlIDcwJSBtb3JlIENhc3QgU3BlZWQiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0ZpcmVFbGVtZW50YWxTdW1tb24ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTVhOTU3ODRjYzRlYjYwNjdjMTVlMWE4YmNhNjAyZjg3Iiwic3VwcG9ydCI6ZmFsc2UsImlkIjoiOTVjNTBmM2VhYTQ1YmZkMjNkZDQyNDk5Y2MxZmY2NTdmNzg3NTM3MGVjYjkwYWU1MTA2NzZlZDc2NzYwODE5ZiIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IlN1bW1vbiBGbGFtZSBHb2xlbSIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IkZpcmUsIE1pbmlvbiwgU3BlbGwsIEdvbGVtIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyI1NCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siNi4wMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siMS4wMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjk4IiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjY4IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1bW1vbnMgYSBGbGFtZSBHb2xlbSB0aGF0IGdyYW50cyB5b3UgaW5jcmVhc2VkIERhbWFnZS4gVGhlIEZsYW1lIEdvbGVtIGNhbiB1c2UgYSBmaXJlIHNwcmF5LCBhIHdhdmUgb2YgZmlyZSBkYW1hZ2UsIGFuZCBhbiBleHBsb3NpdmUgYXJjaW5nIHByb2plY3RpbGUuIiwiZXhwbGljaXRNb2RzIjpbIk1heGltdW0gMSBTdW1tb25lZCBHb2xlbSIsIjY4JSBpbmNyZWFzZWQgTWluaW9uIE1heGltdW0gTGlmZSIsIkdvbGVtcyBHcmFudCAyMCUgaW5jcmVhc2VkIERhbWFnZSJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MywiY29sb3VyIjoiUyJ9XX1dLCJjaGFyYWN0ZXIiOnsibmFtZSI6IlNsZWVwZXJTcGVjdHJlQm9pIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJjbGFzc0lkIjozLCJhc2NlbmRhbmN5Q2xhc3MiOjMsImNsYXNzIjoiTmVjcm9tYW5jZXIiLCJsZXZlbCI6OTEsImV4cGVyaWVuY2UiOjIxMjcyOTgxNDN9fQ==\"")
 	packr.PackJSONBytes(".", "get-ladder.json", "\"ewogICJ0b3RhbCI6IDEwNTgsCiAgImNhY2hlZF9zaW5jZSI6ICIyMDE5LTA4LTMxVDIxOjAwOjA4KzAwOjAwIiwKICAiZW50cmllcyI6IFsKICAgIHsKICAgICAgInJhbmsiOiAxLAogICAgICAiZGVhZCI6IGZhbHNlLAogICAgICAib25saW5lIjogdHJ1ZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJUaGVUb3hpY0hvYm8iLAogICAgICAgICJsZXZlbCI6IDk3LAogICAgICAgICJjbGFzcyI6ICJUcmlja3N0ZXIiLAogICAgICAgICJpZCI6ICI0Nzc0MTI1NzMwMzczZTg0NTY4MzVlOWNiOGVlMzYzNTRlZjM5ZGEwYjkzODNkNjMzY2M2NzM1ZGI3OWJiYjE5IiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDMzNjU5MDQyNDksCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAzMjYsCiAgICAgICAgICAic29sbyI6IDMyNgogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAiWmFubzkwIiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMzYKICAgICAgICB9LAogICAgICAgICJ0d2l0Y2giOiB7CiAgICAgICAgICAibmFtZSI6ICJ6YW5lbGxvOTAiCiAgICAgICAgfQogICAgICB9CiAgICB9LAogICAgewogICAgICAicmFuayI6IDIsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJyZXRpcmVkIjogdHJ1ZSwKICAgICAgIm9ubGluZSI6IHRydWUsCiAgICAgICJjaGFyYWN0ZXIiOiB7CiAgICAgICAgIm5hbWUiOiAiTXVtYmx5X0hvYm8iLAogICAgICAgICJsZXZlbCI6IDk1LAogICAgICAgICJjbGFzcyI6ICJHdWFyZGlhbiIsCiAgICAgICAgImlkIjogIjc2MDcyNzBiMDg5ZDllMzIyMzY5OGZiMzBlMWU2MDc4ZDlmYTk1MzliYjMxN2M0MDdmZjk0ZDZiMTg0OGM2NzkiLAogICAgICAgICJleHBlcmllbmNlIjogMjkyNTQyOTk3MCwKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDI0OSwKICAgICAgICAgICJzb2xvIjogMjQ5CiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJNdW1ibHlfSm9lIiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMjgKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMywKICAgICAgImRlYWQiOiBmYWxzZSwKICAgICAgIm9ubGluZSI6IGZhbHNlLAogICAgICAiY2hhcmFjdGVyIjogewogICAgICAgICJuYW1lIjogIlNoaW5ueUhvYm8iLAogICAgICAgICJsZXZlbCI6IDk1LAogICAgICAgICJjbGFzcyI6ICJCZXJzZXJrZXIiLAogICAgICAgICJpZCI6ICIyNjI1NTFiOTNiZDdlYjJhZjY4YTk3MTMyY2FkNTE0OTg1NTZmNDNhM2JjYzgxM2MwYWUwZGExZDYwNTBlNjU0IiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDI5MjQwMjQ4MjYsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAyMjUsCiAgICAgICAgICAic29sbyI6IDIyNQogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAiZ291cCIsCiAgICAgICAgInJlYWxtIjogInBjIiwKICAgICAgICAiY2hhbGxlbmdlcyI6IHsKICAgICAgICAgICJ0b3RhbCI6IDMzCiAgICAgICAgfSwKICAgICAgICAidHdpdGNoIjogewogICAgICAgICAgIm5hbWUiOiAia291aWduX2FtYW5uIgogICAgICAgIH0KICAgICAgfQogICAgfSwKICAgIHsKICAgICAgInJhbmsiOiA0LAogICAgICAiZGVhZCI6IGZhbHNlLAogICAgICAib25saW5lIjogZmFsc2UsCiAgICAgICJjaGFyYWN0ZXIiOiB7CiAgICAgICAgIm5hbWUiOiAiU2xpcHB5SG9ibyIsCiAgICAgICAgImxldmVsIjogOTUsCiAgICAgICAgImNsYXNzIjogIlRyaWNrc3RlciIsCiAgICAgICAgImlkIjogIjUwOWRiZGIzYWVjMzU5Y2YzMjk4MDg0ODc1YmNlZTdiNjM1NWUxYjcyYTljMWUyMzMxNzk5N2VjYzVjYTBjY2UiLAogICAgICAgICJleHBlcmllbmNlIjogMjkwNTQzNTUxMywKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDM2MywKICAgICAgICAgICJzb2xvIjogMzYzCiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJNaXR0eUp1c3RTYXlpbiIsCiAgICAgICAgInJlYWxtIjogInBjIiwKICAgICAgICAiY2hhbGxlbmdlcyI6IHsKICAgICAgICAgICJ0b3RhbCI6IDkKICAgICAgICB9LAogICAgICAgICJ0d2l0Y2giOiB7CiAgICAgICAgICAibmFtZSI6ICJtaGVzdGVydGhlY29sZXN0ZXIiCiAgICAgICAgfQogICAgICB9CiAgICB9LAogICAgewogICAgICAicmFuayI6IDUsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiBmYWxzZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJWb29kb29KYW1lc05vSG9ibyIsCiAgICAgICAgImxldmVsIjogOTUsCiAgICAgICAgImNsYXNzIjogIkJlcnNlcmtlciIsCiAgICAgICAgImlkIjogIjQ5OTk4NjVmYzIwMTA2N2U5ZTU2MzFkOWIwYTYwNGJhYWQ4OGQyNjA3MDRlNDgxNWRmOWM3NzM3NTZkYmVhYmYiLAogICAgICAgICJleHBlcmllbmNlIjogMjkwMTAxNjQxMiwKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDMxNiwKICAgICAgICAgICJzb2xvIjogMzE2CiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJWb29kb29qZXNzIiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMzYKICAgICAgICB9LAogICAgICAgICJ0d2l0Y2giOiB7CiAgICAgICAgICAibmFtZSI6ICJ2b29kb29qZXN1cyIKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogNiwKICAgICAgImRlYWQiOiBmYWxzZSwKICAgICAgIm9ubGluZSI6IHRydWUsCiAgICAgICJjaGFyYWN0ZXIiOiB7CiAgICAgICAgIm5hbWUiOiAiWnphdE9ubHlVbmlxdWVzTW9ua2EiLAogICAgICAgICJsZXZlbCI6IDk1LAogICAgICAgICJjbGFzcyI6ICJTbGF5ZXIiLAogICAgICAgICJpZCI6ICI0MDg0MmE4YmUzYzM3NDY1ZDM1M2RmNWJkNjhjNjg3NDcwOTFkNGU2YjhmYWRlNGYzOTRlNzBlNmE0ZWI1YmUwIiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDI4Nzc3MDc1NTYsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAxNjAsCiAgICAgICAgICAic29sbyI6IDE2MAogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAienphdDEiLAogICAgICAgICJyZWFsbSI6ICJwYyIsCiAgICAgICAgImNoYWxsZW5nZXMiOiB7CiAgICAgICAgICAidG90YWwiOiAyOAogICAgICAgIH0sCiAgICAgICAgInR3aXRjaCI6IHsKICAgICAgICAgICJuYW1lIjogInp6YXQxIgogICAgICAgIH0KICAgICAgfQogICAgfSwKICAgIHsKICAgICAgInJhbmsiOiA3LAogICAgICAiZGVhZCI6IGZhbHNlLAogICAgICAib25saW5lIjogZmFsc2UsCiAgICAgICJjaGFyYWN0ZXIiOiB7CiAgICAgICAgIm5hbWUiOiAiSmhpcmFIb2JvIiwKICAgICAgICAibGV2ZWwiOiA5NCwKICAgICAgICAiY2xhc3MiOiAiQ2hhbXBpb24iLAogICAgICAgICJpZCI6ICJiNGEwOThlZWY4ZDM1Nzg4MmUwMzI5NTc1ZDAyMmM0MGQwMzZmNWQxYzM4ODEzZjIyMjBiMTAyZDQ3NDgzODEzIiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDI3NjM4NzY0OTcsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAyMTIsCiAgICAgICAgICAic29sbyI6IDIxMgogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAiQmFkSmhpcmEiLAogICAgICAgICJyZWFsbSI6ICJwYyIsCiAgICAgICAgImNoYWxsZW5nZXMiOiB7CiAgICAgICAgICAidG90YWwiOiAyNgogICAgICAgIH0sCiAgICAgICAgInR3aXRjaCI6IHsKICAgICAgICAgICJuYW1lIjogInNjaGl6b2ZyYW50aWMiCiAgICAgICAgfQogICAgICB9CiAgICB9LAogICAgewogICAgICAicmFuayI6IDgsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiBmYWxzZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJQb3RhdG9fSG9ibyIsCiAgICAgICAgImxldmVsIjogOTQsCiAgICAgICAgImNsYXNzIjogIkNoaWVmdGFpbiIsCiAgICAgICAgImlkIjogIjcwZGM0ZjUzYmFhYzlkYTE1ZDZhZDg0MTQ5ZTJmYTgxOGEyMjFjYjBiNjUyYjNhMWU5MmY1ZTE0ZDQ4YWUyM2UiLAogICAgICAgICJleHBlcmllbmNlIjogMjcyOTk2MzM1NCwKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDI4OSwKICAgICAgICAgICJzb2xvIjogMjg5CiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJQaW1tYWxhZ2UiLAogICAgICAgICJyZWFsbSI6ICJwYyIsCiAgICAgICAgImNoYWxsZW5nZXMiOiB7CiAgICAgICAgICAidG90YWwiOiAzOAogICAgICAgIH0sCiAgICAgICAgInR3aXRjaCI6IHsKICAgICAgICAgICJuYW1lIjogInBpbW1hbGFnZSIKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogOSwKICAgICAgImRlYWQiOiBmYWxzZSwKICAgICAgInJldGlyZWQiOiB0cnVlLAogICAgICAib25saW5lIjogZmFsc2UsCiAgICAgICJjaGFyYWN0ZXIiOiB7CiAgICAgICAgIm5hbWUiOiAiQklHQk9JQ1VLQSIsCiAgICAgICAgImxldmVsIjogOTQsCiAgICAgICAgImNsYXNzIjogIlNsYXllciIsCiAgICAgICAgImlkIjogIjU1OTgwYjBjOTZjZDQ2NmIwOWExM2FlNGUzYTgwODg4ODc1YTMwNGY3OTA0YWY4NjhlMmQ5NTA3N2FkYTc0NDEiLAogICAgICAgICJleHBlcmllbmNlIjogMjY3NDY5MTM2OSwKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDE1MiwKICAgICAgICAgICJzb2xvIjogMTUyCiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJIZWVnYXM3IiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMTQKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMTAsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiBmYWxzZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJIZXJhbGRvSG9ibyIsCiAgICAgICAgImxldmVsIjogOTQsCiAgICAgICAgImNsYXNzIjogIkp1Z2dlcm5hdXQiLAogICAgICAgICJpZCI6ICI1ZjZkYWFjNTc5NmIxNTNkMWZkZTcxMzk3NGEzN2ZhMGIzODVjMjNmN2Y4NGQ2ZDA1ZjJmNDQxYmJkY2YwY2Y4IiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDI2Njc5MzkxMDgsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAyMzcsCiAgICAgICAgICAic29sbyI6IDIzNwogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAiRURFMSIsCiAgICAgICAgInJlYWxtIjogInBjIiwKICAgICAgICAiY2hhbGxlbmdlcyI6IHsKICAgICAgICAgICJ0b3RhbCI6IDExCiAgICAgICAgfQogICAgICB9CiAgICB9LAogICAgewogICAgICAicmFuayI6IDExLAogICAgICAiZGVhZCI6IGZhbHNlLAogICAgICAib25saW5lIjogdHJ1ZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJLb290Y2hpZV9Ib2JvIiwKICAgICAgICAibGV2ZWwiOiA5MywKICAgICAgICAiY2xhc3MiOiAiU2Fib3RldXIiLAogICAgICAgICJpZCI6ICJmZDgyYTQwNzM3ZWI4NmYzYmVlZTg5ZDU0MDg0NWVjMTUwN2YwMDZmOWU1N2RlNTFkMzM3MmNiY2FlMmQxY2VmIiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDI1MjMwMjkyNTYsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAxODEsCiAgICAgICAgICAic29sbyI6IDE4MQogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAiVGhlX1NpbiIsCiAgICAgICAgInJlYWxtIjogInBjIiwKICAgICAgICAiY2hhbGxlbmdlcyI6IHsKICAgICAgICAgICJ0b3RhbCI6IDEyCiAgICAgICAgfSwKICAgICAgICAidHdpdGNoIjogewogICAgICAgICAgIm5hbWUiOiAidGhlX3MxbiIKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMTIsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJyZXRpcmVkIjogdHJ1ZSwKICAgICAgIm9ubGluZSI6IHRydWUsCiAgICAgICJjaGFyYWN0ZXIiOiB7CiAgICAgICAgIm5hbWUiOiAiS25vb3BfU2xpcHB5IiwKICAgICAgICAibGV2ZWwiOiA5MywKICAgICAgICAiY2xhc3MiOiAiQ2hhbXBpb24iLAogICAgICAgICJpZCI6ICI5NDJmYjg0ZDhmYmQ0N2U2Y2QwNzk1MzY4OWYxY2RmMzcwNTg0NGViYjliODg1YTUxMWE1Njc0MTc0MzA0ODMxIiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDI1MDgzNzUzMTUsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAyNzUsCiAgICAgICAgICAic29sbyI6IDI3NQogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAidHJpY2t6cHQiLAogICAgICAgICJyZWFsbSI6ICJwYyIsCiAgICAgICAgImNoYWxsZW5nZXMiOiB7CiAgICAgICAgICAidG90YWwiOiAxOQogICAgICAgIH0sCiAgICAgICAgInR3aXRjaCI6IHsKICAgICAgICAgICJuYW1lIjogImhpZ2hsb3dwdCIKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMTMsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiBmYWxzZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJIb2JvUm9ib1h4WCIsCiAgICAgICAgImxldmVsIjogOTMsCiAgICAgICAgImNsYXNzIjogIklucXVpc2l0b3IiLAogICAgICAgICJpZCI6ICIxMDI2YTY5MWY0MGQzZjI4MDQ0NjUyZjlkMTViZGEwODEzNTg1ZmFkMWQ4NmU3NjE3ZDVkNTlhZDc3MDFlMjFkIiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDI0NzMzMjk1NjQsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAyNzIsCiAgICAgICAgICAic29sbyI6IDI3MgogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAiZG1pcmljIiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMwogICAgICAgIH0KICAgICAgfQogICAgfSwKICAgIHsKICAgICAgInJhbmsiOiAxNCwKICAgICAgImRlYWQiOiBmYWxzZSwKICAgICAgIm9ubGluZSI6IGZhbHNlLAogICAgICAiY2hhcmFjdGVyIjogewogICAgICAgICJuYW1lIjogIkhvYm9Sb2JvWCIsCiAgICAgICAgImxldmVsIjogOTMsCiAgICAgICAgImNsYXNzIjogIkNoYW1waW9uIiwKICAgICAgICAiaWQiOiAiMTE5YjZiZGI0Y2MyNmViMDA1Njc2YTZlMjM1YzI2ZDI2ZDBjNzc0NTdlODhlODBlZjE3OTBlNzQ1MDRhYzc5NyIsCiAgICAgICAgImV4cGVyaWVuY2UiOiAyNDU5NDkyMjMyLAogICAgICAgICJkZXB0aCI6IHsKICAgICAgICAgICJkZWZhdWx0IjogMzI4LAogICAgICAgICAgInNvbG8iOiAzMjgKICAgICAgICB9CiAgICAgIH0sCiAgICAgICJhY2NvdW50IjogewogICAgICAgICJuYW1lIjogImRtaXJpYyIsCiAgICAgICAgInJlYWxtIjogInBjIiwKICAgICAgICAiY2hhbGxlbmdlcyI6IHsKICAgICAgICAgICJ0b3RhbCI6IDMKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMTUsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiBmYWxzZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJPZFRoZUhvYm8iLAogICAgICAgICJsZXZlbCI6IDkyLAogICAgICAgICJjbGFzcyI6ICJKdWdnZXJuYXV0IiwKICAgICAgICAiaWQiOiAiNTBhZjA1MmNlMzkzYzI1YWEwYzg3NjMzYmJmYjMxMWE5ZmY1ZWZkNWZiZGIxMzQ2M2JmMGNhM2RhYTg1OWI0MyIsCiAgICAgICAgImV4cGVyaWVuY2UiOiAyNDIyMzU2MzM1LAogICAgICAgICJkZXB0aCI6IHsKICAgICAgICAgICJkZWZhdWx0IjogMTUxLAogICAgICAgICAgInNvbG8iOiAxNTEKICAgICAgICB9CiAgICAgIH0sCiAgICAgICJhY2NvdW50IjogewogICAgICAgICJuYW1lIjogIkJpZ09uaW9uUmluZ3MiLAogICAgICAgICJyZWFsbSI6ICJwYyIsCiAgICAgICAgImNoYWxsZW5nZXMiOiB7CiAgICAgICAgICAidG90YWwiOiAzNwogICAgICAgIH0sCiAgICAgICAgInR3aXRjaCI6IHsKICAgICAgICAgICJuYW1lIjogImdvZG9md2FycGt6MSIKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMTYsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiB0cnVlLAogICAgICAiY2hhcmFjdGVyIjogewogICAgICAgICJuYW1lIjogIkh5cG5vSG9ibyIsCiAgICAgICAgImxldmVsIjogOTIsCiAgICAgICAgImNsYXNzIjogIlNsYXllciIsCiAgICAgICAgImlkIjogIjExYzVkMzFiMjE0ZjIxOWFjMGYyY2MwZGE5YWE4NmUzYzhmNzk4OWU4OTYyYjNjYmY2NjQyODFlZTFhMmFlZWUiLAogICAgICAgICJleHBlcmllbmNlIjogMjM0MjAyOTg4NCwKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDIwMiwKICAgICAgICAgICJzb2xvIjogMjAyCiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJTa2FiYnRvbXRlIiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMzYKICAgICAgICB9LAogICAgICAgICJ0d2l0Y2giOiB7CiAgICAgICAgICAibmFtZSI6ICJoeXBub3g0NyIKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMTcsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiBmYWxzZSwKICAgICAgImNoYXJhY3RlciI6IHsKICAgICAgICAibmFtZSI6ICJGZXN0ZXJCZXN0ZXJ0ZXN0ZXIiLAogICAgICAgICJsZXZlbCI6IDkyLAogICAgICAgICJjbGFzcyI6ICJDaGllZnRhaW4iLAogICAgICAgICJpZCI6ICJmMWMyMjNiYzBmZmM3YTNhYWY2N2ZjNzY0NGY5YjI3M2M4MWY4N2IzNDcyYmRjYzFlZWNmYzUzZWU3MDRmMjJhIiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDIzMTgzNTM5NTcsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAxMTksCiAgICAgICAgICAic29sbyI6IDExOQogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAidGVybWl0ZTY0IiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMTUKICAgICAgICB9LAogICAgICAgICJ0d2l0Y2giOiB7CiAgICAgICAgICAibmFtZSI6ICJ0ZXJtaXRlNjQiCiAgICAgICAgfQogICAgICB9CiAgICB9LAogICAgewogICAgICAicmFuayI6IDE4LAogICAgICAiZGVhZCI6IGZhbHNlLAogICAgICAib25saW5lIjogZmFsc2UsCiAgICAgICJjaGFyYWN0ZXIiOiB7CiAgICAgICAgIm5hbWUiOiAiTWV3elVuaXF1ZU1hbmlhYyIsCiAgICAgICAgImxldmVsIjogOTIsCiAgICAgICAgImNsYXNzIjogIlNsYXllciIsCiAgICAgICAgImlkIjogImVjYjJjYTNlZTdkMjg1YjU3ZjgwOGQ0MDM1YTFjMjFlZDE3OTJjNTQ4ZjkxMTk0MWNlN2QxYmZmOWJlMzUzNGEiLAogICAgICAgICJleHBlcmllbmNlIjogMjMwNzM4MzAzMywKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDIzOCwKICAgICAgICAgICJzb2xvIjogMjM4CiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJ4TWV3em9yIiwKICAgICAgICAicmVhbG0iOiAicGMiLAogICAgICAgICJjaGFsbGVuZ2VzIjogewogICAgICAgICAgInRvdGFsIjogMzcKICAgICAgICB9LAogICAgICAgICJ0d2l0Y2giOiB7CiAgICAgICAgICAibmFtZSI6ICJtdXVzaWthIgogICAgICAgIH0KICAgICAgfQogICAgfSwKICAgIHsKICAgICAgInJhbmsiOiAxOSwKICAgICAgImRlYWQiOiBmYWxzZSwKICAgICAgIm9ubGluZSI6IGZhbHNlLAogICAgICAiY2hhcmFjdGVyIjogewogICAgICAgICJuYW1lIjogIkJpa2luaV9TcG9ydHNfUG9uY2hpbklJIiwKICAgICAgICAibGV2ZWwiOiA5MiwKICAgICAgICAiY2xhc3MiOiAiTmVjcm9tYW5jZXIiLAogICAgICAgICJpZCI6ICJjMWI3MmIyNmZkZDJmOGJjYjhhMTBkNWMxNGE5ZWZmMmRkZjNhMDY0NTEwNjQwNjcwMzk0M2MyMTk5ZGRlOGM1IiwKICAgICAgICAiZXhwZXJpZW5jZSI6IDIyOTIxMzg1MDMsCiAgICAgICAgImRlcHRoIjogewogICAgICAgICAgImRlZmF1bHQiOiAxNDQsCiAgICAgICAgICAic29sbyI6IDE0NAogICAgICAgIH0KICAgICAgfSwKICAgICAgImFjY291bnQiOiB7CiAgICAgICAgIm5hbWUiOiAibmVtZXNpczIwMSIsCiAgICAgICAgInJlYWxtIjogInBjIiwKICAgICAgICAiY2hhbGxlbmdlcyI6IHsKICAgICAgICAgICJ0b3RhbCI6IDIwCiAgICAgICAgfSwKICAgICAgICAidHdpdGNoIjogewogICAgICAgICAgIm5hbWUiOiAibmVtZXNpczIwMSIKICAgICAgICB9CiAgICAgIH0KICAgIH0sCiAgICB7CiAgICAgICJyYW5rIjogMjAsCiAgICAgICJkZWFkIjogZmFsc2UsCiAgICAgICJvbmxpbmUiOiB0cnVlLAogICAgICAiY2hhcmFjdGVyIjogewogICAgICAgICJuYW1lIjogIkhvYm9jY3VsdGlzdCIsCiAgICAgICAgImxldmVsIjogOTIsCiAgICAgICAgImNsYXNzIjogIk9jY3VsdGlzdCIsCiAgICAgICAgImlkIjogIjk5NTc5YzIzMDdmNDhmZjU0MjkwNWJlOWM2MDZkMDUzODVkMGZjNTg4NzdiYThiNzFlMGFjNDk1MTk0MjY2OTQiLAogICAgICAgICJleHBlcmllbmNlIjogMjI4MDQzNDU2NCwKICAgICAgICAiZGVwdGgiOiB7CiAgICAgICAgICAiZGVmYXVsdCI6IDI2MSwKICAgICAgICAgICJzb2xvIjogMjYxCiAgICAgICAgfQogICAgICB9LAogICAgICAiYWNjb3VudCI6IHsKICAgICAgICAibmFtZSI6ICJQb2x5bWVyZSIsCiAgICAgICAgInJlYWxtIjogInBjIiwKICAgICAgICAiY2hhbGxlbmdlcyI6IHsKICAgICAgICAgICJ0b3RhbCI6IDIyCiAgICAgICAgfSwKICAgICAgICAidHdpdGNoIjogewogICAgICAgICAgIm5hbWUiOiAiYW1vbHQiCiAgICAgICAgfQogICAgICB9CiAgICB9CiAgXQp9Cg==\"")
 	packr.PackJSONBytes(".", "get-ladder.raw.json", "\"eyJ0b3RhbCI6MTA1OCwiY2FjaGVkX3NpbmNlIjoiMjAxOS0wOC0zMVQyMTowMDowOCswMDowMCIsImVudHJpZXMiOlt7InJhbmsiOjEsImRlYWQiOmZhbHNlLCJvbmxpbmUiOnRydWUsImNoYXJhY3RlciI6eyJuYW1lIjoiVGhlVG94aWNIb2JvIiwibGV2ZWwiOjk3LCJjbGFzcyI6IlRyaWNrc3RlciIsImlkIjoiNDc3NDEyNTczMDM3M2U4NDU2ODM1ZTljYjhlZTM2MzU0ZWYzOWRhMGI5MzgzZDYzM2NjNjczNWRiNzliYmIxOSIsImV4cGVyaWVuY2UiOjMzNjU5MDQyNDksImRlcHRoIjp7ImRlZmF1bHQiOjMyNiwic29sbyI6MzI2fX0sImFjY291bnQiOnsibmFtZSI6Ilphbm85MCIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjozNn0sInR3aXRjaCI6eyJuYW1lIjoiemFuZWxsbzkwIn19fSx7InJhbmsiOjIsImRlYWQiOmZhbHNlLCJyZXRpcmVkIjp0cnVlLCJvbmxpbmUiOnRydWUsImNoYXJhY3RlciI6eyJuYW1lIjoiTXVtYmx5X0hvYm8iLCJsZXZlbCI6OTUsImNsYXNzIjoiR3VhcmRpYW4iLCJpZCI6Ijc2MDcyNzBiMDg5ZDllMzIyMzY5OGZiMzBlMWU2MDc4ZDlmYTk1MzliYjMxN2M0MDdmZjk0ZDZiMTg0OGM2NzkiLCJleHBlcmllbmNlIjoyOTI1NDI5OTcwLCJkZXB0aCI6eyJkZWZhdWx0IjoyNDksInNvbG8iOjI0OX19LCJhY2NvdW50Ijp7Im5hbWUiOiJNdW1ibHlfSm9lIiwicmVhbG0iOiJwYyIsImNoYWxsZW5nZXMiOnsidG90YWwiOjI4fX19LHsicmFuayI6MywiZGVhZCI6ZmFsc2UsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiU2hpbm55SG9ibyIsImxldmVsIjo5NSwiY2xhc3MiOiJCZXJzZXJrZXIiLCJpZCI6IjI2MjU1MWI5M2JkN2ViMmFmNjhhOTcxMzJjYWQ1MTQ5ODU1NmY0M2EzYmNjODEzYzBhZTBkYTFkNjA1MGU2NTQiLCJleHBlcmllbmNlIjoyOTI0MDI0ODI2LCJkZXB0aCI6eyJkZWZhdWx0IjoyMjUsInNvbG8iOjIyNX19LCJhY2NvdW50Ijp7Im5hbWUiOiJnb3VwIiwicmVhbG0iOiJwYyIsImNoYWxsZW5nZXMiOnsidG90YWwiOjMzfSwidHdpdGNoIjp7Im5hbWUiOiJrb3VpZ25fYW1hbm4ifX19LHsicmFuayI6NCwiZGVhZCI6ZmFsc2UsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiU2xpcHB5SG9ibyIsImxldmVsIjo5NSwiY2xhc3MiOiJUcmlja3N0ZXIiLCJpZCI6IjUwOWRiZGIzYWVjMzU5Y2YzMjk4MDg0ODc1YmNlZTdiNjM1NWUxYjcyYTljMWUyMzMxNzk5N2VjYzVjYTBjY2UiLCJleHBlcmllbmNlIjoyOTA1NDM1NTEzLCJkZXB0aCI6eyJkZWZhdWx0IjozNjMsInNvbG8iOjM2M319LCJhY2NvdW50Ijp7Im5hbWUiOiJNaXR0eUp1c3RTYXlpbiIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjo5fSwidHdpdGNoIjp7Im5hbWUiOiJtaGVzdGVydGhlY29sZXN0ZXIifX19LHsicmFuayI6NSwiZGVhZCI6ZmFsc2UsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiVm9vZG9vSmFtZXNOb0hvYm8iLCJsZXZlbCI6OTUsImNsYXNzIjoiQmVyc2Vya2VyIiwiaWQiOiI0OTk5ODY1ZmMyMDEwNjdlOWU1NjMxZDliMGE2MDRiYWFkODhkMjYwNzA0ZTQ4MTVkZjljNzczNzU2ZGJlYWJmIiwiZXhwZXJpZW5jZSI6MjkwMTAxNjQxMiwiZGVwdGgiOnsiZGVmYXVsdCI6MzE2LCJzb2xvIjozMTZ9fSwiYWNjb3VudCI6eyJuYW1lIjoiVm9vZG9vamVzcyIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjozNn0sInR3aXRjaCI6eyJuYW1lIjoidm9vZG9vamVzdXMifX19LHsicmFuayI6NiwiZGVhZCI6ZmFsc2UsIm9ubGluZSI6dHJ1ZSwiY2hhcmFjdGVyIjp7Im5hbWUiOiJaemF0T25seVVuaXF1ZXNNb25rYSIsImxldmVsIjo5NSwiY2xhc3MiOiJTbGF5ZXIiLCJpZCI6IjQwODQyYThiZTNjMzc0NjVkMzUzZGY1YmQ2OGM2ODc0NzA5MWQ0ZTZiOGZhZGU0ZjM5NGU3MGU2YTRlYjViZTAiLCJleHBlcmllbmNlIjoyODc3NzA3NTU2LCJkZXB0aCI6eyJkZWZhdWx0IjoxNjAsInNvbG8iOjE2MH19LCJhY2NvdW50Ijp7Im5hbWUiOiJ6emF0MSIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjoyOH0sInR3aXRjaCI6eyJuYW1lIjoienphdDEifX19LHsicmFuayI6NywiZGVhZCI6ZmFsc2UsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiSmhpcmFIb2JvIiwibGV2ZWwiOjk0LCJjbGFzcyI6IkNoYW1waW9uIiwiaWQiOiJiNGEwOThlZWY4ZDM1Nzg4MmUwMzI5NTc1ZDAyMmM0MGQwMzZmNWQxYzM4ODEzZjIyMjBiMTAyZDQ3NDgzODEzIiwiZXhwZXJpZW5jZSI6Mjc2Mzg3NjQ5NywiZGVwdGgiOnsiZGVmYXVsdCI6MjEyLCJzb2xvIjoyMTJ9fSwiYWNjb3VudCI6eyJuYW1lIjoiQmFkSmhpcmEiLCJyZWFsbSI6InBjIiwiY2hhbGxlbmdlcyI6eyJ0b3RhbCI6MjZ9LCJ0d2l0Y2giOnsibmFtZSI6InNjaGl6b2ZyYW50aWMifX19LHsicmFuayI6OCwiZGVhZCI6ZmFsc2UsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiUG90YXRvX0hvYm8iLCJsZXZlbCI6OTQsImNsYXNzIjoiQ2hpZWZ0YWluIiwiaWQiOiI3MGRjNGY1M2JhYWM5ZGExNWQ2YWQ4NDE0OWUyZmE4MThhMjIxY2IwYjY1MmIzYTFlOTJmNWUxNGQ0OGFlMjNlIiwiZXhwZXJpZW5jZSI6MjcyOTk2MzM1NCwiZGVwdGgiOnsiZGVmYXVsdCI6Mjg5LCJzb2xvIjoyODl9fSwiYWNjb3VudCI6eyJuYW1lIjoiUGltbWFsYWdlIiwicmVhbG0iOiJwYyIsImNoYWxsZW5nZXMiOnsidG90YWwiOjM4fSwidHdpdGNoIjp7Im5hbWUiOiJwaW1tYWxhZ2UifX19LHsicmFuayI6OSwiZGVhZCI6ZmFsc2UsInJldGlyZWQiOnRydWUsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiQklHQk9JQ1VLQSIsImxldmVsIjo5NCwiY2xhc3MiOiJTbGF5ZXIiLCJpZCI6IjU1OTgwYjBjOTZjZDQ2NmIwOWExM2FlNGUzYTgwODg4ODc1YTMwNGY3OTA0YWY4NjhlMmQ5NTA3N2FkYTc0NDEiLCJleHBlcmllbmNlIjoyNjc0NjkxMzY5LCJkZXB0aCI6eyJkZWZhdWx0IjoxNTIsInNvbG8iOjE1Mn19LCJhY2NvdW50Ijp7Im5hbWUiOiJIZWVnYXM3IiwicmVhbG0iOiJwYyIsImNoYWxsZW5nZXMiOnsidG90YWwiOjE0fX19LHsicmFuayI6MTAsImRlYWQiOmZhbHNlLCJvbmxpbmUiOmZhbHNlLCJjaGFyYWN0ZXIiOnsibmFtZSI6IkhlcmFsZG9Ib2JvIiwibGV2ZWwiOjk0LCJjbGFzcyI6Ikp1Z2dlcm5hdXQiLCJpZCI6IjVmNmRhYWM1Nzk2YjE1M2QxZmRlNzEzOTc0YTM3ZmEwYjM4NWMyM2Y3Zjg0ZDZkMDVmMmY0NDFiYmRjZjBjZjgiLCJleHBlcmllbmNlIjoyNjY3OTM5MTA4LCJkZXB0aCI6eyJkZWZhdWx0IjoyMzcsInNvbG8iOjIzN319LCJhY2NvdW50Ijp7Im5hbWUiOiJFREUxIiwicmVhbG0iOiJwYyIsImNoYWxsZW5nZXMiOnsidG90YWwiOjExfX19LHsicmFuayI6MTEsImRlYWQiOmZhbHNlLCJvbmxpbmUiOnRydWUsImNoYXJhY3RlciI6eyJuYW1lIjoiS29vdGNoaWVfSG9ibyIsImxldmVsIjo5MywiY2xhc3MiOiJTYWJvdGV1ciIsImlkIjoiZmQ4MmE0MDczN2ViODZmM2JlZWU4OWQ1NDA4NDVlYzE1MDdmMDA2ZjllNTdkZTUxZDMzNzJjYmNhZTJkMWNlZiIsImV4cGVyaWVuY2UiOjI1MjMwMjkyNTYsImRlcHRoIjp7ImRlZmF1bHQiOjE4MSwic29sbyI6MTgxfX0sImFjY291bnQiOnsibmFtZSI6IlRoZV9TaW4iLCJyZWFsbSI6InBjIiwiY2hhbGxlbmdlcyI6eyJ0b3RhbCI6MTJ9LCJ0d2l0Y2giOnsibmFtZSI6InRoZV9zMW4ifX19LHsicmFuayI6MTIsImRlYWQiOmZhbHNlLCJyZXRpcmVkIjp0cnVlLCJvbmxpbmUiOnRydWUsImNoYXJhY3RlciI6eyJuYW1lIjoiS25vb3BfU2xpcHB5IiwibGV2ZWwiOjkzLCJjbGFzcyI6IkNoYW1waW9uIiwiaWQiOiI5NDJmYjg0ZDhmYmQ0N2U2Y2QwNzk1MzY4OWYxY2RmMzcwNTg0NGViYjliODg1YTUxMWE1Njc0MTc0MzA0ODMxIiwiZXhwZXJpZW5jZSI6MjUwODM3NTMxNSwiZGVwdGgiOnsiZGVmYXVsdCI6Mjc1LCJzb2xvIjoyNzV9fSwiYWNjb3VudCI6eyJuYW1lIjoidHJpY2t6cHQiLCJyZWFsbSI6InBjIiwiY2hhbGxlbmdlcyI6eyJ0b3RhbCI6MTl9LCJ0d2l0Y2giOnsibmFtZSI6ImhpZ2hsb3dwdCJ9fX0seyJyYW5rIjoxMywiZGVhZCI6ZmFsc2UsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiSG9ib1JvYm9YeFgiLCJsZXZlbCI6OTMsImNsYXNzIjoiSW5xdWlzaXRvciIsImlkIjoiMTAyNmE2OTFmNDBkM2YyODA0NDY1MmY5ZDE1YmRhMDgxMzU4NWZhZDFkODZlNzYxN2Q1ZDU5YWQ3NzAxZTIxZCIsImV4cGVyaWVuY2UiOjI0NzMzMjk1NjQsImRlcHRoIjp7ImRlZmF1bHQiOjI3Miwic29sbyI6MjcyfX0sImFjY291bnQiOnsibmFtZSI6ImRtaXJpYyIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjozfX19LHsicmFuayI6MTQsImRlYWQiOmZhbHNlLCJvbmxpbmUiOmZhbHNlLCJjaGFyYWN0ZXIiOnsibmFtZSI6IkhvYm9Sb2JvWCIsImxldmVsIjo5MywiY2xhc3MiOiJDaGFtcGlvbiIsImlkIjoiMTE5YjZiZGI0Y2MyNmViMDA1Njc2YTZlMjM1YzI2ZDI2ZDBjNzc0NTdlODhlODBlZjE3OTBlNzQ1MDRhYzc5NyIsImV4cGVyaWVuY2UiOjI0NTk0OTIyMzIsImRlcHRoIjp7ImRlZmF1bHQiOjMyOCwic29sbyI6MzI4fX0sImFjY291bnQiOnsibmFtZSI6ImRtaXJpYyIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjozfX19LHsicmFuayI6MTUsImRlYWQiOmZhbHNlLCJvbmxpbmUiOmZhbHNlLCJjaGFyYWN0ZXIiOnsibmFtZSI6Ik9kVGhlSG9ibyIsImxldmVsIjo5MiwiY2xhc3MiOiJKdWdnZXJuYXV0IiwiaWQiOiI1MGFmMDUyY2UzOTNjMjVhYTBjODc2MzNiYmZiMzExYTlmZjVlZmQ1ZmJkYjEzNDYzYmYwY2EzZGFhODU5YjQzIiwiZXhwZXJpZW5jZSI6MjQyMjM1NjMzNSwiZGVwdGgiOnsiZGVmYXVsdCI6MTUxLCJzb2xvIjoxNTF9fSwiYWNjb3VudCI6eyJuYW1lIjoiQmlnT25pb25SaW5ncyIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjozN30sInR3aXRjaCI6eyJuYW1lIjoiZ29kb2Z3YXJwa3oxIn19fSx7InJhbmsiOjE2LCJkZWFkIjpmYWxzZSwib25saW5lIjp0cnVlLCJjaGFyYWN0ZXIiOnsibmFtZSI6Ikh5cG5vSG9ibyIsImxldmVsIjo5MiwiY2xhc3MiOiJTbGF5ZXIiLCJpZCI6IjExYzVkMzFiMjE0ZjIxOWFjMGYyY2MwZGE5YWE4NmUzYzhmNzk4OWU4OTYyYjNjYmY2NjQyODFlZTFhMmFlZWUiLCJleHBlcmllbmNlIjoyMzQyMDI5ODg0LCJkZXB0aCI6eyJkZWZhdWx0IjoyMDIsInNvbG8iOjIwMn19LCJhY2NvdW50Ijp7Im5hbWUiOiJTa2FiYnRvbXRlIiwicmVhbG0iOiJwYyIsImNoYWxsZW5nZXMiOnsidG90YWwiOjM2fSwidHdpdGNoIjp7Im5hbWUiOiJoeXBub3g0NyJ9fX0seyJyYW5rIjoxNywiZGVhZCI6ZmFsc2UsIm9ubGluZSI6ZmFsc2UsImNoYXJhY3RlciI6eyJuYW1lIjoiRmVzdGVyQmVzdGVydGVzdGVyIiwibGV2ZWwiOjkyLCJjbGFzcyI6IkNoaWVmdGFpbiIsImlkIjoiZjFjMjIzYmMwZmZjN2EzYWFmNjdmYzc2NDRmOWIyNzNjODFmODdiMzQ3MmJkY2MxZWVjZmM1M2VlNzA0ZjIyYSIsImV4cGVyaWVuY2UiOjIzMTgzNTM5NTcsImRlcHRoIjp7ImRlZmF1bHQiOjExOSwic29sbyI6MTE5fX0sImFjY291bnQiOnsibmFtZSI6InRlcm1pdGU2NCIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjoxNX0sInR3aXRjaCI6eyJuYW1lIjoidGVybWl0ZTY0In19fSx7InJhbmsiOjE4LCJkZWFkIjpmYWxzZSwib25saW5lIjpmYWxzZSwiY2hhcmFjdGVyIjp7Im5hbWUiOiJNZXd6VW5pcXVlTWFuaWFjIiwibGV2ZWwiOjkyLCJjbGFzcyI6IlNsYXllciIsImlkIjoiZWNiMmNhM2VlN2QyODViNTdmODA4ZDQwMzVhMWMyMWVkMTc5MmM1NDhmOTExOTQxY2U3ZDFiZmY5YmUzNTM0YSIsImV4cGVyaWVuY2UiOjIzMDczODMwMzMsImRlcHRoIjp7ImRlZmF1bHQiOjIzOCwic29sbyI6MjM4fX0sImFjY291bnQiOnsibmFtZSI6InhNZXd6b3IiLCJyZWFsbSI6InBjIiwiY2hhbGxlbmdlcyI6eyJ0b3RhbCI6Mzd9LCJ0d2l0Y2giOnsibmFtZSI6Im11dXNpa2EifX19LHsicmFuayI6MTksImRlYWQiOmZhbHNlLCJvbmxpbmUiOmZhbHNlLCJjaGFyYWN0ZXIiOnsibmFtZSI6IkJpa2luaV9TcG9ydHNfUG9uY2hpbklJIiwibGV2ZWwiOjkyLCJjbGFzcyI6Ik5lY3JvbWFuY2VyIiwiaWQiOiJjMWI3MmIyNmZkZDJmOGJjYjhhMTBkNWMxNGE5ZWZmMmRkZjNhMDY0NTEwNjQwNjcwMzk0M2MyMTk5ZGRlOGM1IiwiZXhwZXJpZW5jZSI6MjI5MjEzODUwMywiZGVwdGgiOnsiZGVmYXVsdCI6MTQ0LCJzb2xvIjoxNDR9fSwiYWNjb3VudCI6eyJuYW1lIjoibmVtZXNpczIwMSIsInJlYWxtIjoicGMiLCJjaGFsbGVuZ2VzIjp7InRvdGFsIjoyMH0sInR3aXRjaCI6eyJuYW1lIjoibmVtZXNpczIwMSJ9fX0seyJyYW5rIjoyMCwiZGVhZCI6ZmFsc2UsIm9ubGluZSI6dHJ1ZSwiY2hhcmFjdGVyIjp7Im5hbWUiOiJIb2JvY2N1bHRpc3QiLCJsZXZlbCI6OTIsImNsYXNzIjoiT2NjdWx0aXN0IiwiaWQiOiI5OTU3OWMyMzA3ZjQ4ZmY1NDI5MDViZTljNjA2ZDA1Mzg1ZDBmYzU4ODc3YmE4YjcxZTBhYzQ5NTE5NDI2Njk0IiwiZXhwZXJpZW5jZSI6MjI4MDQzNDU2NCwiZGVwdGgiOnsiZGVmYXVsdCI6MjYxLCJzb2xvIjoyNjF9fSwiYWNjb3VudCI6eyJuYW1lIjoiUG9seW1lcmUiLCJyZWFsbSI6InBjIiwiY2hhbGxlbmdlcyI6eyJ0b3RhbCI6MjJ9LCJ0d2l0Y2giOnsibmFtZSI6ImFtb2x0In19fV19\"")
 	packr.PackJSONBytes(".", "get-passive-skills.json", "\"ewogICJoYXNoZXMiOiBbCiAgICAyMzgsCiAgICAxMDMxLAogICAgMTIwMywKICAgIDIyOTIsCiAgICA0Mzk3LAogICAgNTE1MiwKICAgIDU0MTUsCiAgICA2MjA0LAogICAgNjIzMCwKICAgIDY3MTIsCiAgICA3Mzg4LAogICAgODkzMCwKICAgIDEwMDMxLAogICAgMTA0OTAsCiAgICAxMDgyOSwKICAgIDExNDIwLAogICAgMTE0OTcsCiAgICAxMzAwOSwKICAgIDEzNzE0LAogICAgMTQ5MzYsCiAgICAxNTA3MywKICAgIDE1NDA1LAogICAgMTU4NjgsCiAgICAxNjE2NywKICAgIDE2Nzc1LAogICAgMTc1NDYsCiAgICAxNzczNSwKICAgIDE3ODIxLAogICAgMTg0MDIsCiAgICAxOTE0NCwKICAgIDE5NjM1LAogICAgMTk5MzksCiAgICAyMDU1MSwKICAgIDIxMzMwLAogICAgMjE2MzQsCiAgICAyMTk1OCwKICAgIDIyNjI3LAogICAgMjMwMjcsCiAgICAyNDM2MiwKICAgIDI0MzgzLAogICAgMjY1MjMsCiAgICAyNjg2NiwKICAgIDI3MjAzLAogICAgMjc2MTEsCiAgICAyNzkyOSwKICAgIDI4MzMwLAogICAgMjkzNTMsCiAgICAzMTY4MywKICAgIDMxNzU4LAogICAgMzE4NzUsCiAgICAzMjI0NSwKICAgIDMzNDc5LAogICAgMzM2MzEsCiAgICAzMzc0MCwKICAgIDMzNzU1LAogICAgMzQwOTgsCiAgICAzNDQ4MywKICAgIDM1NTAzLAogICAgMzYwMTcsCiAgICAzNjA0NywKICAgIDM2NTQyLAogICAgMzY2MzQsCiAgICAzNjg4MSwKICAgIDM2OTQ5LAogICAgMzc1NjksCiAgICAzOTA4NSwKICAgIDM5NjQ4LAogICAgMzk4MTgsCiAgICA0MTQ3MiwKICAgIDQxOTY3LAogICAgNDIwNDEsCiAgICA0MjU4MywKICAgIDQyNzYwLAogICAgNDI4MzcsCiAgICA0NDE4NCwKICAgIDQ0MjAyLAogICAgNDQ2MDYsCiAgICA0NjU3OCwKICAgIDQ2OTEwLAogICAgNDcyNTEsCiAgICA0ODM2MiwKICAgIDQ4NDIzLAogICAgNDg3MTksCiAgICA0OTI1NCwKICAgIDUwNDIyLAogICAgNTA4NjIsCiAgICA1MzI3OSwKICAgIDU0MTU5LAogICAgNTU0ODUsCiAgICA1NTY0NiwKICAgIDU3MjY0LAogICAgNTg0NDksCiAgICA2MDM4OCwKICAgIDYwMzk4LAogICAgNjA1NDcsCiAgICA2MDU1NCwKICAgIDYxMTk4LAogICAgNjE0MTksCiAgICA2MTQ3MSwKICAgIDYyMDIxLAogICAgNjMwNDgsCiAgICA2MzQ0NywKICAgIDYzOTc2LAogICAgNjUwMzQsCiAgICA2NTE2NwogIF0sCiAgIml0ZW1zIjogWwogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDEsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvSmV3ZWxzL0doYXN0bHlFeWUucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTExODgwM2Y0YWE4NzBiMGQ1NTY5NThkNmEwNjM0Y2VlIiwKICAgICAgImxlYWd1ZSI6ICJTdGFuZGFyZCIsCiAgICAgICJpZCI6ICIzNDUyMThmYjRhMTAwMTViNzUyNTQwZjlkNTRjODc3MzgwNTQzNGMwMWQ4MDYzOGNjNDE4OWJmMzc2NTlmM2Y2IiwKICAgICAgImFieXNzSmV3ZWwiOiB0cnVlLAogICAgICAibmFtZSI6ICJGb3VsIE9jdWx1cyIsCiAgICAgICJ0eXBlTGluZSI6ICJHaGFzdGx5IEV5ZSBKZXdlbCIsCiAgICAgICJpZGVudGlmaWVkIjogdHJ1ZSwKICAgICAgImlsdmwiOiA2OSwKICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiQWJ5c3MiLAogICAgICAgICAgInZhbHVlcyI6IFtdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0KICAgICAgXSwKICAgICAgInJlcXVpcmVtZW50cyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAidmFsdWVzIjogWwogICAgICAgICAgICBbCiAgICAgICAgICAgICAgIjI1IiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwCiAgICAgICAgfQogICAgICBdLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICIrMjcgdG8gbWF4aW11bSBMaWZlIiwKICAgICAgICAiKzI1IHRvIG1heGltdW0gTWFuYSIsCiAgICAgICAgIjIlIGFkZGl0aW9uYWwgUGh5c2ljYWwgRGFtYWdlIFJlZHVjdGlvbiBpZiB5b3Ugd2VyZW4ndCBEYW1hZ2VkIGJ5IGEgSGl0IFJlY2VudGx5IiwKICAgICAgICAiTWluaW9ucyBoYXZlIDQlIGNoYW5jZSB0byBCbGluZCBvbiBIaXQgd2l0aCBBdHRhY2tzIgogICAgICBdLAogICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gQWJ5c3NhbCBTb2NrZXQgb24gYW4gSXRlbSBvciBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLAogICAgICAiZnJhbWVUeXBlIjogMiwKICAgICAgIngiOiAxMCwKICAgICAgInkiOiAwLAogICAgICAiaW52ZW50b3J5SWQiOiAiUGFzc2l2ZUpld2VscyIsCiAgICAgICJ0eXBlIjogIkpld2VsQWJ5c3NTdW1tb25lciIKICAgIH0sCiAgICB7CiAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAidyI6IDEsCiAgICAgICJoIjogMSwKICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9KZXdlbHMvR29sZW1JbmZlcm5hbC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9ODk4ZDNkYmU2NmIzYWQ3OTE1Y2JhNzI5ZGY5MGE0MTAiLAogICAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICAgImlkIjogIjU5MjY2YjA4ZDU5MjUxZjUyZGEyZWY2ZDgxYmUxMGYzMWIxNGU3NmVhMTU4NmM5MmQ1ZmQyNTYyMWM2ZDJhNjEiLAogICAgICAibmFtZSI6ICJQcmltb3JkaWFsIE1pZ2h0IiwKICAgICAgInR5cGVMaW5lIjogIkNyaW1zb24gSmV3ZWwiLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogNzMsCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIjMwJSBpbmNyZWFzZWQgRGFtYWdlIGlmIHlvdSBTdW1tb25lZCBhIEdvbGVtIGluIHRoZSBwYXN0IDggc2Vjb25kcyIsCiAgICAgICAgIkdvbGVtcyBTdW1tb25lZCBpbiB0aGUgcGFzdCA4IHNlY29uZHMgZGVhbCAzOSUgaW5jcmVhc2VkIERhbWFnZSIsCiAgICAgICAgIkdvbGVtcyBoYXZlIDIwJSBpbmNyZWFzZWQgTWF4aW11bSBMaWZlIiwKICAgICAgICAiUHJpbW9yZGlhbCIsCiAgICAgICAgIlN1bW1vbmVkIEdvbGVtcyBhcmUgQWdncmVzc2l2ZSIKICAgICAgXSwKICAgICAgImRlc2NyVGV4dCI6ICJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLAogICAgICAiZmxhdm91clRleHQiOiBbCiAgICAgICAgIlwiRG8gbm90IGNhdXNlIHRoZSBsYW5kIHRvIHN0aXIsXHIiLAogICAgICAgICJJdCBob2xkcyBzZWNyZXRzIGJldHRlciB1bmtub3duLlwiXHIiLAogICAgICAgICItIEF6bWVyaWFuIHByb3ZlcmIiCiAgICAgIF0sCiAgICAgICJmcmFtZVR5cGUiOiAzLAogICAgICAieCI6IDE0LAogICAgICAieSI6IDAsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJQYXNzaXZlSmV3ZWxzIiwKICAgICAgInR5cGUiOiAiSmV3ZWxTdHIiCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDEsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvSmV3ZWxzL1NwZWN0cmVKZXdlbC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MjZiOWVhN2NmYTk0ZmQ2MTQwMDdiNTEyNGVjNDQyM2QiLAogICAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICAgImlkIjogImJiMmIwNWQ5YmJiNDI3YmIwNWNkZWVlZTVkY2Y4OTIxZDBjZTQ5YmQ1OTE5NzQxZDc4YWE0MjFhZWM2N2YwYzUiLAogICAgICAibmFtZSI6ICJVbmVuZGluZyBIdW5nZXIiLAogICAgICAidHlwZUxpbmUiOiAiQ29iYWx0IEpld2VsIiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDY5LAogICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMaW1pdGVkIHRvIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMiIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0sCiAgICAgICAgewogICAgICAgICAgIm5hbWUiOiAiUmFkaXVzIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiTWVkaXVtIiwKICAgICAgICAgICAgICAwCiAgICAgICAgICAgIF0KICAgICAgICAgIF0sCiAgICAgICAgICAiZGlzcGxheU1vZGUiOiAwLAogICAgICAgICAgInR5cGUiOiAyNQogICAgICAgIH0KICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiTWluaW9ucyBoYXZlIDclIGluY3JlYXNlZCBBcmVhIG9mIEVmZmVjdCIsCiAgICAgICAgIldpdGggYXQgbGVhc3QgNDAgSW50ZWxsaWdlbmNlIGluIFJhZGl1cywgUmFpc2VkIFNwZWN0cmVzIGhhdmUgYSA1MCUgY2hhbmNlIHRvIGdhaW4gU291bCBFYXRlciBmb3IgMjAgc2Vjb25kcyBvbiBLaWxsIgogICAgICBdLAogICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gYWxsb2NhdGVkIEpld2VsIFNvY2tldCBvbiB0aGUgUGFzc2l2ZSBTa2lsbCBUcmVlLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSB0aGUgU29ja2V0LiIsCiAgICAgICJmbGF2b3VyVGV4dCI6IFsKICAgICAgICAiVGhleSByb2FtZWQsIHRoZXkgZmVkLCB0aGV5IGdyZXcsXHIiLAogICAgICAgICJLaWxsaW5nIGFzIHRoZXkgd2VudCxcciIsCiAgICAgICAgIkNvbnF1ZXIgdGhvdWdoIHRoZXkgbWF5LFxyIiwKICAgICAgICAiVGhlaXIgaGVhcnRzIHdpbGwgbmV2ZXIgYmUgY29udGVudC4iCiAgICAgIF0sCiAgICAgICJmcmFtZVR5cGUiOiAzLAogICAgICAieCI6IDEsCiAgICAgICJ5IjogMCwKICAgICAgImludmVudG9yeUlkIjogIlBhc3NpdmVKZXdlbHMiLAogICAgICAidHlwZSI6ICJKZXdlbEludCIKICAgIH0sCiAgICB7CiAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAidyI6IDEsCiAgICAgICJoIjogMSwKICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9KZXdlbHMvR29sZW1UZW1wZXN0LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj00MmUyNDY3MTgwZDYzZTA0NzlmNTYyNTQzYmFhNDdlMiIsCiAgICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgICAiaWQiOiAiYTllZTA1ZDk2NDE0YjBiYjFhZWM0YjRjOWQxZjNmMzU5M2VjMmU1ZTA2NmFjN2E5OGQwZGU5MzdkOTlhNmNiNSIsCiAgICAgICJuYW1lIjogIlByaW1vcmRpYWwgSGFybW9ueSIsCiAgICAgICJ0eXBlTGluZSI6ICJDb2JhbHQgSmV3ZWwiLAogICAgICAiaWRlbnRpZmllZCI6IHRydWUsCiAgICAgICJpbHZsIjogNzQsCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIkdvbGVtIFNraWxscyBoYXZlIDI5JSBpbmNyZWFzZWQgQ29vbGRvd24gUmVjb3ZlcnkgU3BlZWQiLAogICAgICAgICJHb2xlbXMgaGF2ZSA0MSUgaW5jcmVhc2VkIENvb2xkb3duIFJlY292ZXJ5IFNwZWVkIiwKICAgICAgICAiMTklIGluY3JlYXNlZCBHb2xlbSBEYW1hZ2UgZm9yIGVhY2ggVHlwZSBvZiBHb2xlbSB5b3UgaGF2ZSBTdW1tb25lZCIsCiAgICAgICAgIlN1bW1vbmVkIEdvbGVtcyBSZWdlbmVyYXRlIDIlIG9mIHRoZWlyIExpZmUgcGVyIHNlY29uZCIsCiAgICAgICAgIlByaW1vcmRpYWwiCiAgICAgIF0sCiAgICAgICJkZXNjclRleHQiOiAiUGxhY2UgaW50byBhbiBhbGxvY2F0ZWQgSmV3ZWwgU29ja2V0IG9uIHRoZSBQYXNzaXZlIFNraWxsIFRyZWUuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIHRoZSBTb2NrZXQuIiwKICAgICAgImZsYXZvdXJUZXh0IjogWwogICAgICAgICJcIkEgc3RvbmUgaXMgbm90IGEgc3RvbmUuXHIiLAogICAgICAgICJJdCBpcyBidXQgb25lIHBhcnQgb2YgYSBmb3J0cmVzcy5cIlxyIiwKICAgICAgICAiLSBFem9teXRlIHByb3ZlcmIiCiAgICAgIF0sCiAgICAgICJmcmFtZVR5cGUiOiAzLAogICAgICAieCI6IDgsCiAgICAgICJ5IjogMCwKICAgICAgImludmVudG9yeUlkIjogIlBhc3NpdmVKZXdlbHMiLAogICAgICAidHlwZSI6ICJKZXdlbEludCIKICAgIH0sCiAgICB7CiAgICAgICJ2ZXJpZmllZCI6IGZhbHNlLAogICAgICAidyI6IDEsCiAgICAgICJoIjogMSwKICAgICAgImljb24iOiAiaHR0cHM6Ly93ZWIucG9lY2RuLmNvbS9pbWFnZS9BcnQvMkRJdGVtcy9KZXdlbHMvR29sZW1DaGFvcy5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9OTE1NmFiY2MxYWRkYjg3YWM1ZTQ0YjZkMDdlMDA5NjkiLAogICAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICAgImlkIjogIjdhMTZkYmVhZjlkNDU3ODhjOWJlMzYxZDNkZjE5ODk1NGNlZDFiZGJmN2QyNDAwYTRmN2Y5ODY5YWFhZGJmZmUiLAogICAgICAibmFtZSI6ICJUaGUgQW5pbWEgU3RvbmUiLAogICAgICAidHlwZUxpbmUiOiAiUHJpc21hdGljIEpld2VsIiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDY0LAogICAgICAicHJvcGVydGllcyI6IFsKICAgICAgICB7CiAgICAgICAgICAibmFtZSI6ICJMaW1pdGVkIHRvIiwKICAgICAgICAgICJ2YWx1ZXMiOiBbCiAgICAgICAgICAgIFsKICAgICAgICAgICAgICAiMSIsCiAgICAgICAgICAgICAgMAogICAgICAgICAgICBdCiAgICAgICAgICBdLAogICAgICAgICAgImRpc3BsYXlNb2RlIjogMAogICAgICAgIH0KICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgR29sZW1zIiwKICAgICAgICAiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgU3VtbW9uZWQgR29sZW1zIGlmIHlvdSBoYXZlIDMgUHJpbW9yZGlhbCBJdGVtcyBTb2NrZXRlZCBvciBFcXVpcHBlZCIKICAgICAgXSwKICAgICAgImRlc2NyVGV4dCI6ICJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLAogICAgICAiZmxhdm91clRleHQiOiBbCiAgICAgICAgIkFsbCByZXZlcmUgdGhlIGVhcnRoIHRoZXkgd2FsayxcciIsCiAgICAgICAgIkZvciBpdCB3aWVsZHMgc3RyZW5ndGggYmV5b25kIGNvbXBhcmUsXHIiLAogICAgICAgICJIb2xkcyBrbm93bGVkZ2UgbW9yZSB0aGFuIHRob3VnaHQsXHIiLAogICAgICAgICJTdGFuZHMgbG9uZyBhZnRlciBhbGwgZWxzZSBmYWxscy4iCiAgICAgIF0sCiAgICAgICJmcmFtZVR5cGUiOiAzLAogICAgICAieCI6IDE5LAogICAgICAieSI6IDAsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJQYXNzaXZlSmV3ZWxzIiwKICAgICAgInR5cGUiOiAiSmV3ZWxQcmlzbWF0aWMiCiAgICB9LAogICAgewogICAgICAidmVyaWZpZWQiOiBmYWxzZSwKICAgICAgInciOiAxLAogICAgICAiaCI6IDEsCiAgICAgICJpY29uIjogImh0dHBzOi8vd2ViLnBvZWNkbi5jb20vaW1hZ2UvQXJ0LzJESXRlbXMvSmV3ZWxzL0dvbGVtVGVtcGVzdC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NDJlMjQ2NzE4MGQ2M2UwNDc5ZjU2MjU0M2JhYTQ3ZTIiLAogICAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICAgImlkIjogImU1YTFiMjVjY2RlYmViYjRhMjA0NmFmZDI5YmZiODA5YWM4MWU0N2ZjOWNiN2ZjN2EzZmJkZTA1ZDA5NThkMDEiLAogICAgICAibmFtZSI6ICJQcmltb3JkaWFsIEhhcm1vbnkiLAogICAgICAidHlwZUxpbmUiOiAiQ29iYWx0IEpld2VsIiwKICAgICAgImlkZW50aWZpZWQiOiB0cnVlLAogICAgICAiaWx2bCI6IDc1LAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICJHb2xlbSBTa2lsbHMgaGF2ZSAyNSUgaW5jcmVhc2VkIENvb2xkb3duIFJlY292ZXJ5IFNwZWVkIiwKICAgICAgICAiR29sZW1zIGhhdmUgMzklIGluY3JlYXNlZCBDb29sZG93biBSZWNvdmVyeSBTcGVlZCIsCiAgICAgICAgIjIwJSBpbmNyZWFzZWQgR29sZW0gRGFtYWdlIGZvciBlYWNoIFR5cGUgb2YgR29sZW0geW91IGhhdmUgU3VtbW9uZWQiLAogICAgICAgICJTdW1tb25lZCBHb2xlbXMgUmVnZW5lcmF0ZSAyJSBvZiB0aGVpciBMaWZlIHBlciBzZWNvbmQiLAogICAgICAgICJQcmltb3JkaWFsIgogICAgICBdLAogICAgICAiZGVzY3JUZXh0IjogIlBsYWNlIGludG8gYW4gYWxsb2NhdGVkIEpld2VsIFNvY2tldCBvbiB0aGUgUGFzc2l2ZSBTa2lsbCBUcmVlLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSB0aGUgU29ja2V0LiIsCiAgICAgICJmbGF2b3VyVGV4dCI6IFsKICAgICAgICAiXCJBIHN0b25lIGlzIG5vdCBhIHN0b25lLlxyIiwKICAgICAgICAiSXQgaXMgYnV0IG9uZSBwYXJ0IG9mIGEgZm9ydHJlc3MuXCJcciIsCiAgICAgICAgIi0gRXpvbXl0ZSBwcm92ZXJiIgogICAgICBdLAogICAgICAiZnJhbWVUeXBlIjogMywKICAgICAgIngiOiA2LAogICAgICAieSI6IDAsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJQYXNzaXZlSmV3ZWxzIiwKICAgICAgInR5cGUiOiAiSmV3ZWxJbnQiCiAgICB9CiAgXSwKICAiamV3ZWxfc2xvdHMiOiBbCiAgICAyNjcyNSwKICAgIDM2NjM0LAogICAgMzM5ODksCiAgICA0MTI2MywKICAgIDYwNzM1LAogICAgNjE4MzQsCiAgICAzMTY4MywKICAgIDI4NDc1LAogICAgNjIzMCwKICAgIDQ4NzY4LAogICAgMzQ0ODMsCiAgICA3OTYwLAogICAgNDY4ODIsCiAgICA1NTE5MCwKICAgIDYxNDE5LAogICAgMjQ5MSwKICAgIDU0MTI3LAogICAgMzI3NjMsCiAgICAyNjE5NiwKICAgIDMzNjMxLAogICAgMjE5ODQKICBdLAogICJ2aXN1YWxfb3ZlcnJpZGVzIjogW10KfQo=\"")
 	packr.PackJSONBytes(".", "get-passive-skills.raw.json", "\"eyJoYXNoZXMiOlsyMzgsMTAzMSwxMjAzLDIyOTIsNDM5Nyw1MTUyLDU0MTUsNjIwNCw2MjMwLDY3MTIsNzM4OCw4OTMwLDEwMDMxLDEwNDkwLDEwODI5LDExNDIwLDExNDk3LDEzMDA5LDEzNzE0LDE0OTM2LDE1MDczLDE1NDA1LDE1ODY4LDE2MTY3LDE2Nzc1LDE3NTQ2LDE3NzM1LDE3ODIxLDE4NDAyLDE5MTQ0LDE5NjM1LDE5OTM5LDIwNTUxLDIxMzMwLDIxNjM0LDIxOTU4LDIyNjI3LDIzMDI3LDI0MzYyLDI0MzgzLDI2NTIzLDI2ODY2LDI3MjAzLDI3NjExLDI3OTI5LDI4MzMwLDI5MzUzLDMxNjgzLDMxNzU4LDMxODc1LDMyMjQ1LDMzNDc5LDMzNjMxLDMzNzQwLDMzNzU1LDM0MDk4LDM0NDgzLDM1NTAzLDM2MDE3LDM2MDQ3LDM2NTQyLDM2NjM0LDM2ODgxLDM2OTQ5LDM3NTY5LDM5MDg1LDM5NjQ4LDM5ODE4LDQxNDcyLDQxOTY3LDQyMDQxLDQyNTgzLDQyNzYwLDQyODM3LDQ0MTg0LDQ0MjAyLDQ0NjA2LDQ2NTc4LDQ2OTEwLDQ3MjUxLDQ4MzYyLDQ4NDIzLDQ4NzE5LDQ5MjU0LDUwNDIyLDUwODYyLDUzMjc5LDU0MTU5LDU1NDg1LDU1NjQ2LDU3MjY0LDU4NDQ5LDYwMzg4LDYwMzk4LDYwNTQ3LDYwNTU0LDYxMTk4LDYxNDE5LDYxNDcxLDYyMDIxLDYzMDQ4LDYzNDQ3LDYzOTc2LDY1MDM0LDY1MTY3XSwiaXRlbXMiOlt7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvSmV3ZWxzXC9HaGFzdGx5RXllLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0xMTg4MDNmNGFhODcwYjBkNTU2OTU4ZDZhMDYzNGNlZSIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiIzNDUyMThmYjRhMTAwMTViNzUyNTQwZjlkNTRjODc3MzgwNTQzNGMwMWQ4MDYzOGNjNDE4OWJmMzc2NTlmM2Y2IiwiYWJ5c3NKZXdlbCI6dHJ1ZSwibmFtZSI6IkZvdWwgT2N1bHVzIiwidHlwZUxpbmUiOiJHaGFzdGx5IEV5ZSBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjY5LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQWJ5c3MiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiZXhwbGljaXRNb2RzIjpbIisyNyB0byBtYXhpbXVtIExpZmUiLCIrMjUgdG8gbWF4aW11bSBNYW5hIiwiMiUgYWRkaXRpb25hbCBQaHlzaWNhbCBEYW1hZ2UgUmVkdWN0aW9uIGlmIHlvdSB3ZXJlbid0IERhbWFnZWQgYnkgYSBIaXQgUmVjZW50bHkiLCJNaW5pb25zIGhhdmUgNCUgY2hhbmNlIHRvIEJsaW5kIG9uIEhpdCB3aXRoIEF0dGFja3MiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBBYnlzc2FsIFNvY2tldCBvbiBhbiBJdGVtIG9yIGludG8gYW4gYWxsb2NhdGVkIEpld2VsIFNvY2tldCBvbiB0aGUgUGFzc2l2ZSBTa2lsbCBUcmVlLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSB0aGUgU29ja2V0LiIsImZyYW1lVHlwZSI6MiwieCI6MTAsInkiOjAsImludmVudG9yeUlkIjoiUGFzc2l2ZUpld2VscyIsInR5cGUiOiJKZXdlbEFieXNzU3VtbW9uZXIifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvSmV3ZWxzXC9Hb2xlbUluZmVybmFsLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj04OThkM2RiZTY2YjNhZDc5MTVjYmE3MjlkZjkwYTQxMCIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiI1OTI2NmIwOGQ1OTI1MWY1MmRhMmVmNmQ4MWJlMTBmMzFiMTRlNzZlYTE1ODZjOTJkNWZkMjU2MjFjNmQyYTYxIiwibmFtZSI6IlByaW1vcmRpYWwgTWlnaHQiLCJ0eXBlTGluZSI6IkNyaW1zb24gSmV3ZWwiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3MywiZXhwbGljaXRNb2RzIjpbIjMwJSBpbmNyZWFzZWQgRGFtYWdlIGlmIHlvdSBTdW1tb25lZCBhIEdvbGVtIGluIHRoZSBwYXN0IDggc2Vjb25kcyIsIkdvbGVtcyBTdW1tb25lZCBpbiB0aGUgcGFzdCA4IHNlY29uZHMgZGVhbCAzOSUgaW5jcmVhc2VkIERhbWFnZSIsIkdvbGVtcyBoYXZlIDIwJSBpbmNyZWFzZWQgTWF4aW11bSBMaWZlIiwiUHJpbW9yZGlhbCIsIlN1bW1vbmVkIEdvbGVtcyBhcmUgQWdncmVzc2l2ZSJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLCJmbGF2b3VyVGV4dCI6WyJcIkRvIG5vdCBjYXVzZSB0aGUgbGFuZCB0byBzdGlyLFxyIiwiSXQgaG9sZHMgc2VjcmV0cyBiZXR0ZXIgdW5rbm93bi5cIlxyIiwiLSBBem1lcmlhbiBwcm92ZXJiIl0sImZyYW1lVHlwZSI6MywieCI6MTQsInkiOjAsImludmVudG9yeUlkIjoiUGFzc2l2ZUpld2VscyIsInR5cGUiOiJKZXdlbFN0ciJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9KZXdlbHNcL1NwZWN0cmVKZXdlbC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MjZiOWVhN2NmYTk0ZmQ2MTQwMDdiNTEyNGVjNDQyM2QiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiYmIyYjA1ZDliYmI0MjdiYjA1Y2RlZWVlNWRjZjg5MjFkMGNlNDliZDU5MTk3NDFkNzhhYTQyMWFlYzY3ZjBjNSIsIm5hbWUiOiJVbmVuZGluZyBIdW5nZXIiLCJ0eXBlTGluZSI6IkNvYmFsdCBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjY5LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiTGltaXRlZCB0byIsInZhbHVlcyI6W1siMiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiUmFkaXVzIiwidmFsdWVzIjpbWyJNZWRpdW0iLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjI1fV0sImV4cGxpY2l0TW9kcyI6WyJNaW5pb25zIGhhdmUgNyUgaW5jcmVhc2VkIEFyZWEgb2YgRWZmZWN0IiwiV2l0aCBhdCBsZWFzdCA0MCBJbnRlbGxpZ2VuY2UgaW4gUmFkaXVzLCBSYWlzZWQgU3BlY3RyZXMgaGF2ZSBhIDUwJSBjaGFuY2UgdG8gZ2FpbiBTb3VsIEVhdGVyIGZvciAyMCBzZWNvbmRzIG9uIEtpbGwiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBhbGxvY2F0ZWQgSmV3ZWwgU29ja2V0IG9uIHRoZSBQYXNzaXZlIFNraWxsIFRyZWUuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIHRoZSBTb2NrZXQuIiwiZmxhdm91clRleHQiOlsiVGhleSByb2FtZWQsIHRoZXkgZmVkLCB0aGV5IGdyZXcsXHIiLCJLaWxsaW5nIGFzIHRoZXkgd2VudCxcciIsIkNvbnF1ZXIgdGhvdWdoIHRoZXkgbWF5LFxyIiwiVGhlaXIgaGVhcnRzIHdpbGwgbmV2ZXIgYmUgY29udGVudC4iXSwiZnJhbWVUeXBlIjozLCJ4IjoxLCJ5IjowLCJpbnZlbnRvcnlJZCI6IlBhc3NpdmVKZXdlbHMiLCJ0eXBlIjoiSmV3ZWxJbnQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvSmV3ZWxzXC9Hb2xlbVRlbXBlc3QucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTQyZTI0NjcxODBkNjNlMDQ3OWY1NjI1NDNiYWE0N2UyIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImE5ZWUwNWQ5NjQxNGIwYmIxYWVjNGI0YzlkMWYzZjM1OTNlYzJlNWUwNjZhYzdhOThkMGRlOTM3ZDk5YTZjYjUiLCJuYW1lIjoiUHJpbW9yZGlhbCBIYXJtb255IiwidHlwZUxpbmUiOiJDb2JhbHQgSmV3ZWwiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3NCwiZXhwbGljaXRNb2RzIjpbIkdvbGVtIFNraWxscyBoYXZlIDI5JSBpbmNyZWFzZWQgQ29vbGRvd24gUmVjb3ZlcnkgU3BlZWQiLCJHb2xlbXMgaGF2ZSA0MSUgaW5jcmVhc2VkIENvb2xkb3duIFJlY292ZXJ5IFNwZWVkIiwiMTklIGluY3JlYXNlZCBHb2xlbSBEYW1hZ2UgZm9yIGVhY2ggVHlwZSBvZiBHb2xlbSB5b3UgaGF2ZSBTdW1tb25lZCIsIlN1bW1vbmVkIEdvbGVtcyBSZWdlbmVyYXRlIDIlIG9mIHRoZWlyIExpZmUgcGVyIHNlY29uZCIsIlByaW1vcmRpYWwiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBhbGxvY2F0ZWQgSmV3ZWwgU29ja2V0IG9uIHRoZSBQYXNzaXZlIFNraWxsIFRyZWUuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIHRoZSBTb2NrZXQuIiwiZmxhdm91clRleHQiOlsiXCJBIHN0b25lIGlzIG5vdCBhIHN0b25lLlxyIiwiSXQgaXMgYnV0IG9uZSBwYXJ0IG9mIGEgZm9ydHJlc3MuXCJcciIsIi0gRXpvbXl0ZSBwcm92ZXJiIl0sImZyYW1lVHlwZSI6MywieCI6OCwieSI6MCwiaW52ZW50b3J5SWQiOiJQYXNzaXZlSmV3ZWxzIiwidHlwZSI6Ikpld2VsSW50In0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0pld2Vsc1wvR29sZW1DaGFvcy5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9OTE1NmFiY2MxYWRkYjg3YWM1ZTQ0YjZkMDdlMDA5NjkiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiN2ExNmRiZWFmOWQ0NTc4OGM5YmUzNjFkM2RmMTk4OTU0Y2VkMWJkYmY3ZDI0MDBhNGY3Zjk4NjlhYWFkYmZmZSIsIm5hbWUiOiJUaGUgQW5pbWEgU3RvbmUiLCJ0eXBlTGluZSI6IlByaXNtYXRpYyBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjY0LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiTGltaXRlZCB0byIsInZhbHVlcyI6W1siMSIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJleHBsaWNpdE1vZHMiOlsiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgR29sZW1zIiwiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgU3VtbW9uZWQgR29sZW1zIGlmIHlvdSBoYXZlIDMgUHJpbW9yZGlhbCBJdGVtcyBTb2NrZXRlZCBvciBFcXVpcHBlZCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLCJmbGF2b3VyVGV4dCI6WyJBbGwgcmV2ZXJlIHRoZSBlYXJ0aCB0aGV5IHdhbGssXHIiLCJGb3IgaXQgd2llbGRzIHN0cmVuZ3RoIGJleW9uZCBjb21wYXJlLFxyIiwiSG9sZHMga25vd2xlZGdlIG1vcmUgdGhhbiB0aG91Z2h0LFxyIiwiU3RhbmRzIGxvbmcgYWZ0ZXIgYWxsIGVsc2UgZmFsbHMuIl0sImZyYW1lVHlwZSI6MywieCI6MTksInkiOjAsImludmVudG9yeUlkIjoiUGFzc2l2ZUpld2VscyIsInR5cGUiOiJKZXdlbFByaXNtYXRpYyJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9KZXdlbHNcL0dvbGVtVGVtcGVzdC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NDJlMjQ2NzE4MGQ2M2UwNDc5ZjU2MjU0M2JhYTQ3ZTIiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiZTVhMWIyNWNjZGViZWJiNGEyMDQ2YWZkMjliZmI4MDlhYzgxZTQ3ZmM5Y2I3ZmM3YTNmYmRlMDVkMDk1OGQwMSIsIm5hbWUiOiJQcmltb3JkaWFsIEhhcm1vbnkiLCJ0eXBlTGluZSI6IkNvYmFsdCBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjc1LCJleHBsaWNpdE1vZHMiOlsiR29sZW0gU2tpbGxzIGhhdmUgMjUlIGluY3JlYXNlZCBDb29sZG93biBSZWNvdmVyeSBTcGVlZCIsIkdvbGVtcyBoYXZlIDM5JSBpbmNyZWFzZWQgQ29vbGRvd24gUmVjb3ZlcnkgU3BlZWQiLCIyMCUgaW5jcmVhc2VkIEdvbGVtIERhbWFnZSBmb3IgZWFjaCBUeXBlIG9mIEdvbGVtIHlvdSBoYXZlIFN1bW1vbmVkIiwiU3VtbW9uZWQgR29sZW1zIFJlZ2VuZXJhdGUgMiUgb2YgdGhlaXIgTGlmZSBwZXIgc2Vjb25kIiwiUHJpbW9yZGlhbCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLCJmbGF2b3VyVGV4dCI6WyJcIkEgc3RvbmUgaXMgbm90IGEgc3RvbmUuXHIiLCJJdCBpcyBidXQgb25lIHBhcnQgb2YgYSBmb3J0cmVzcy5cIlxyIiwiLSBFem9teXRlIHByb3ZlcmIiXSwiZnJhbWVUeXBlIjozLCJ4Ijo2LCJ5IjowLCJpbnZlbnRvcnlJZCI6IlBhc3NpdmVKZXdlbHMiLCJ0eXBlIjoiSmV3ZWxJbnQifV0sImpld2VsX3Nsb3RzIjpbMjY3MjUsMzY2MzQsMzM5ODksNDEyNjMsNjA3MzUsNjE4MzQsMzE2ODMsMjg0NzUsNjIzMCw0ODc2OCwzNDQ4Myw3OTYwLDQ2ODgyLDU1MTkwLDYxNDE5LDI0OTEsNTQxMjcsMzI3NjMsMjYxOTYsMzM2MzEsMjE5ODRdLCJ2aXN1YWxfb3ZlcnJpZGVzIjpbXX0=\"")
+	packr.PackJSONBytes(".", "golden/README.md", "\"R29sZGVuIHBvbGljeSBjb3JwdXM7IGV2ZXJ5IGNhc2UgaXMgcnVuIGFnYWluc3QgZXZlcnkgYnVpbHQtaW4gcG9saWN5CmJ5IGBUZXN0R29sZGVuYCBpbiB0aGUgcG9saWN5IHBhY2thZ2UuCgpFYWNoIGRpcmVjdG9yeSBpcyBhIHNpbmdsZSBjYXNlLCBhIHNhdmVkIGNoYXJhY3RlciwgY29udGFpbmluZwoKLSBgZW50cnkuanNvbmAsIHRoZSBsYWRkZXIgZW50cnkgb2YgdGhlIGNoYXJhY3RlcgotIGBnZXQtaXRlbXMuanNvbmAsIG9wdGlvbmFsLCBhcyByZXR1cm5lZCBmcm9tIGdldC1pdGVtcwotIGBnZXQtcGFzc2l2ZS1za2lsbHMuanNvbmAsIG9wdGlvbmFsLCBhcyByZXR1cm5lZCBmcm9tIGdldC1wYXNzaXZlLXNraWxscwotIGBleHBlY3RlZC88cG9saWN5Pi5qc29uYCwgdGhlIGZhaWx1cmVzIGV4cGVjdGVkIGZvciBlYWNoIGJ1aWx0LWluIHBvbGljeQoKRXhwZWN0ZWQgZmFpbHVyZXMgYXJlIHJlZ2VuZXJhdGVkIHdpdGgKCiAgICBnbyB0ZXN0IC4vcG9saWN5IC1ydW4gVGVzdEdvbGRlbiAtdXBkYXRlCgphZnRlciB3aGljaCBgcGFja3JgIG11c3QgYmUgcnVuIGluIGBmaXh0dXJlc2AsIGFzIGBtYWtlIHRlc3RgIGRvZXMuCg==\"")
+	packr.PackJSONBytes(".", "golden/abyss-jewels/entry.json", "\"ewogICJyYW5rIjogMSwKICAiZGVhZCI6IGZhbHNlLAogICJvbmxpbmUiOiBmYWxzZSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiQWJ5c3NNYXJhdWRlciIsCiAgICAibGV2ZWwiOiA3MCwKICAgICJjbGFzcyI6ICJKdWdnZXJuYXV0IgogIH0sCiAgImFjY291bnQiOiB7CiAgICAibmFtZSI6ICJnb2xkZW4tYWNjb3VudCIKICB9Cn0K\"")
+	packr.PackJSONBytes(".", "golden/abyss-jewels/expected/gucci-hobo.json", "\"WwogIHsKICAgICJyZWFzb24iOiAiTm9uVW5pcXVlSXRlbVByZXNlbnQiLAogICAgInJ1bGVJZCI6ICJyYXJpdHkiLAogICAgInNldmVyaXR5IjogInZpb2xhdGlvbiIsCiAgICAiaXRlbU5hbWUiOiAiR3JpbSBFeWUgU2VhcmNoaW5nIEV5ZSBKZXdlbCIsCiAgICAiaXRlbUxldmVsIjogODIsCiAgICAiaXRlbVNsb3QiOiAiIiwKICAgICJwYXJlbnRJdGVtTmFtZSI6ICJEYXJrbmVzcyBFbnRocm9uZWQgU3R5Z2lhbiBWaXNlIiwKICAgICJwYXJlbnRJdGVtU2xvdCI6ICJCZWx0IiwKICAgICJzb2NrZXRJbmRleCI6IDEsCiAgICAiY2hhcmFjdGVyTmFtZSI6ICJBYnlzc01hcmF1ZGVyIiwKICAgICJjaGFyYWN0ZXJMZXZlbCI6IDcwLAogICAgImFjY291bnROYW1lIjogImdvbGRlbi1hY2NvdW50IiwKICAgICJ3aGVuIjogIjIwMjAtMDEtMTVUMDQ6NDM6MjdaIiwKICAgICJldmlkZW5jZSI6IHsKICAgICAgImlkIjogInJhcmUtYWJ5c3MiLAogICAgICAicmFyaXR5IjogInJhcmUiLAogICAgICAibmFtZSI6ICJHcmltIEV5ZSIsCiAgICAgICJ0eXBlTGluZSI6ICJTZWFyY2hpbmcgRXllIEpld2VsIiwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiKzQwIHRvIG1heGltdW0gTGlmZSIsCiAgICAgICAgIkFkZHMgNSB0byAxMCBQaHlzaWNhbCBEYW1hZ2UgdG8gQXR0YWNrcyIKICAgICAgXQogICAgfQogIH0sCiAgewogICAgInJlYXNvbiI6ICJOb25VbmlxdWVJdGVtUHJlc2VudCIsCiAgICAicnVsZUlkIjogInJhcml0eSIsCiAgICAic2V2ZXJpdHkiOiAidmlvbGF0aW9uIiwKICAgICJpdGVtTmFtZSI6ICJNdXJkZXJvdXMgRXllIEpld2VsIG9mIHRoZSBXaGFsZSIsCiAgICAiaXRlbUxldmVsIjogNzUsCiAgICAiaXRlbVNsb3QiOiAiUGFzc2l2ZUpld2VscyIsCiAgICAic29ja2V0SW5kZXgiOiAwLAogICAgImNoYXJhY3Rlck5hbWUiOiAiQWJ5c3NNYXJhdWRlciIsCiAgICAiY2hhcmFjdGVyTGV2ZWwiOiA3MCwKICAgICJhY2NvdW50TmFtZSI6ICJnb2xkZW4tYWNjb3VudCIsCiAgICAid2hlbiI6ICIyMDIwLTAxLTE1VDA0OjQzOjI3WiIsCiAgICAiZXZpZGVuY2UiOiB7CiAgICAgICJpZCI6ICJtYWdpYy1hYnlzcyIsCiAgICAgICJyYXJpdHkiOiAibWFnaWMiLAogICAgICAidHlwZUxpbmUiOiAiTXVyZGVyb3VzIEV5ZSBKZXdlbCBvZiB0aGUgV2hhbGUiLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICIrMzAgdG8gbWF4aW11bSBMaWZlIgogICAgICBdCiAgICB9CiAgfQpdCg==\"")
+	packr.PackJSONBytes(".", "golden/abyss-jewels/get-items.json", "\"ewogICJpdGVtcyI6IFsKICAgIHsKICAgICAgImlkIjogInVuaXF1ZS1iZWx0IiwKICAgICAgImlsdmwiOiA4MCwKICAgICAgIm5hbWUiOiAiRGFya25lc3MgRW50aHJvbmVkIiwKICAgICAgInR5cGVMaW5lIjogIlN0eWdpYW4gVmlzZSIsCiAgICAgICJmcmFtZVR5cGUiOiAzLAogICAgICAiaW52ZW50b3J5SWQiOiAiQmVsdCIsCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIkhhcyAyIEFieXNzYWwgU29ja2V0cyIKICAgICAgXSwKICAgICAgInNvY2tldGVkSXRlbXMiOiBbCiAgICAgICAgewogICAgICAgICAgImlkIjogInJhcmUtYWJ5c3MiLAogICAgICAgICAgImlsdmwiOiA4MiwKICAgICAgICAgICJuYW1lIjogIkdyaW0gRXllIiwKICAgICAgICAgICJ0eXBlTGluZSI6ICJTZWFyY2hpbmcgRXllIEpld2VsIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiAyLAogICAgICAgICAgImludmVudG9yeUlkIjogIiIsCiAgICAgICAgICAiYWJ5c3NKZXdlbCI6IHRydWUsCiAgICAgICAgICAic29ja2V0IjogMSwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgICAgICIrNDAgdG8gbWF4aW11bSBMaWZlIiwKICAgICAgICAgICAgIkFkZHMgNSB0byAxMCBQaHlzaWNhbCBEYW1hZ2UgdG8gQXR0YWNrcyIKICAgICAgICAgIF0KICAgICAgICB9CiAgICAgIF0KICAgIH0KICBdLAogICJjaGFyYWN0ZXIiOiB7CiAgICAibmFtZSI6ICJBYnlzc01hcmF1ZGVyIiwKICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgImNsYXNzIjogIkp1Z2dlcm5hdXQiLAogICAgImxldmVsIjogNzAKICB9Cn0K\"")
+	packr.PackJSONBytes(".", "golden/abyss-jewels/get-passive-skills.json", "\"ewogICJoYXNoZXMiOiBbXSwKICAiaXRlbXMiOiBbCiAgICB7CiAgICAgICJpZCI6ICJtYWdpYy1hYnlzcyIsCiAgICAgICJpbHZsIjogNzUsCiAgICAgICJuYW1lIjogIiIsCiAgICAgICJ0eXBlTGluZSI6ICJNdXJkZXJvdXMgRXllIEpld2VsIG9mIHRoZSBXaGFsZSIsCiAgICAgICJmcmFtZVR5cGUiOiAxLAogICAgICAiaW52ZW50b3J5SWQiOiAiUGFzc2l2ZUpld2VscyIsCiAgICAgICJ4IjogMTIsCiAgICAgICJhYnlzc0pld2VsIjogdHJ1ZSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiKzMwIHRvIG1heGltdW0gTGlmZSIKICAgICAgXQogICAgfQogIF0KfQo=\"")
+	packr.PackJSONBytes(".", "golden/fresh-character/entry.json", "\"ewogICJyYW5rIjogMSwKICAiZGVhZCI6IGZhbHNlLAogICJvbmxpbmUiOiBmYWxzZSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiRnJlc2hXaXRjaCIsCiAgICAibGV2ZWwiOiAxLAogICAgImNsYXNzIjogIldpdGNoIgogIH0sCiAgImFjY291bnQiOiB7CiAgICAibmFtZSI6ICJnb2xkZW4tYWNjb3VudCIKICB9Cn0K\"")
+	packr.PackJSONBytes(".", "golden/fresh-character/expected/gucci-hobo.json", "\"W10K\"")
+	packr.PackJSONBytes(".", "golden/fresh-character/get-items.json", "\"ewogICJpdGVtcyI6IFtdLAogICJjaGFyYWN0ZXIiOiB7CiAgICAibmFtZSI6ICJGcmVzaFdpdGNoIiwKICAgICJsZWFndWUiOiAiU3RhbmRhcmQiLAogICAgImNsYXNzIjogIldpdGNoIiwKICAgICJsZXZlbCI6IDEKICB9Cn0K\"")
+	packr.PackJSONBytes(".", "golden/sleeper-spectre/entry.json", "\"ewogICJyYW5rIjogMSwKICAiZGVhZCI6IGZhbHNlLAogICJvbmxpbmUiOiBmYWxzZSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiU2xlZXBlclNwZWN0cmVCb2kiLAogICAgImxldmVsIjogOTEsCiAgICAiY2xhc3MiOiAiTmVjcm9tYW5jZXIiCiAgfSwKICAiYWNjb3VudCI6IHsKICAgICJuYW1lIjogIkV2ZXJsYWciCiAgfQp9Cg==\"")
+	packr.PackJSONBytes(".", "golden/sleeper-spectre/expected/gucci-hobo.json", "\"WwogIHsKICAgICJyZWFzb24iOiAiTm9uVW5pcXVlSXRlbVByZXNlbnQiLAogICAgInJ1bGVJZCI6ICJyYXJpdHkiLAogICAgInNldmVyaXR5IjogInZpb2xhdGlvbiIsCiAgICAiaXRlbU5hbWUiOiAiQnJhbWJsZSBGaW5nZXJzIERyYWdvbnNjYWxlIEdhdW50bGV0cyIsCiAgICAiaXRlbUxldmVsIjogNzYsCiAgICAiaXRlbVNsb3QiOiAiR2xvdmVzIiwKICAgICJzb2NrZXRJbmRleCI6IDAsCiAgICAiY2hhcmFjdGVyTmFtZSI6ICJTbGVlcGVyU3BlY3RyZUJvaSIsCiAgICAiY2hhcmFjdGVyTGV2ZWwiOiA5MSwKICAgICJhY2NvdW50TmFtZSI6ICJFdmVybGFnIiwKICAgICJ3aGVuIjogIjIwMjAtMDEtMTVUMDQ6NDM6MjdaIiwKICAgICJldmlkZW5jZSI6IHsKICAgICAgImlkIjogIjhlYzBlYjVlMGU4YWY0NTczZWUwYjEwMTRhOWQxYTY4NGMwZDlkOGE2YjIyOTYxNGM5ZTA5MWYzZmEwNGM0MWYiLAogICAgICAicmFyaXR5IjogInJhcmUiLAogICAgICAibmFtZSI6ICJCcmFtYmxlIEZpbmdlcnMiLAogICAgICAidHlwZUxpbmUiOiAiRHJhZ29uc2NhbGUgR2F1bnRsZXRzIiwKICAgICAgImVuY2hhbnRNb2RzIjogWwogICAgICAgICJUcmlnZ2VyIENvbW1hbmRtZW50IG9mIHRoZSBHcmF2ZSB3aGVuIHlvdXIgU2tpbGxzIG9yIE1pbmlvbnMgS2lsbCIKICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiU29ja2V0ZWQgR2VtcyBoYXZlIDE2JSBtb3JlIEF0dGFjayBhbmQgQ2FzdCBTcGVlZCIsCiAgICAgICAgIisyMiB0byBEZXh0ZXJpdHkiLAogICAgICAgICIrMTkgdG8gQXJtb3VyIiwKICAgICAgICAiKzIwIHRvIEV2YXNpb24gUmF0aW5nIiwKICAgICAgICAiKzI4JSB0byBMaWdodG5pbmcgUmVzaXN0YW5jZSIKICAgICAgXSwKICAgICAgImNyYWZ0ZWRNb2RzIjogWwogICAgICAgICIrNDUgdG8gbWF4aW11bSBMaWZlIgogICAgICBdCiAgICB9CiAgfSwKICB7CiAgICAicmVhc29uIjogIk5vblVuaXF1ZUl0ZW1QcmVzZW50IiwKICAgICJydWxlSWQiOiAicmFyaXR5IiwKICAgICJzZXZlcml0eSI6ICJ2aW9sYXRpb24iLAogICAgIml0ZW1OYW1lIjogIlBsYWd1ZSBDaXJjbGUgVW5zZXQgUmluZyIsCiAgICAiaXRlbUxldmVsIjogNjcsCiAgICAiaXRlbVNsb3QiOiAiUmluZyIsCiAgICAic29ja2V0SW5kZXgiOiAwLAogICAgImNoYXJhY3Rlck5hbWUiOiAiU2xlZXBlclNwZWN0cmVCb2kiLAogICAgImNoYXJhY3RlckxldmVsIjogOTEsCiAgICAiYWNjb3VudE5hbWUiOiAiRXZlcmxhZyIsCiAgICAid2hlbiI6ICIyMDIwLTAxLTE1VDA0OjQzOjI3WiIsCiAgICAiZXZpZGVuY2UiOiB7CiAgICAgICJpZCI6ICJkMmRjNjNmYTcwZWVjZGJhNzM4NzBjYTY3NGI2MjQ3YTU1YTE3NDhlZWE4OTZkZTc0YWYyMzE4ZTM2MTQxMzViIiwKICAgICAgInJhcml0eSI6ICJyYXJlIiwKICAgICAgIm5hbWUiOiAiUGxhZ3VlIENpcmNsZSIsCiAgICAgICJ0eXBlTGluZSI6ICJVbnNldCBSaW5nIiwKICAgICAgImltcGxpY2l0TW9kcyI6IFsKICAgICAgICAiSGFzIDEgU29ja2V0IgogICAgICBdLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICIrMiB0byBMZXZlbCBvZiBTb2NrZXRlZCBHZW1zIiwKICAgICAgICAiQWRkcyA0IHRvIDYgUGh5c2ljYWwgRGFtYWdlIHRvIEF0dGFja3MiLAogICAgICAgICIrNTIgdG8gbWF4aW11bSBMaWZlIiwKICAgICAgICAiMTQlIGluY3JlYXNlZCBSYXJpdHkgb2YgSXRlbXMgZm91bmQiLAogICAgICAgICIrNDAlIHRvIEZpcmUgUmVzaXN0YW5jZSIKICAgICAgXSwKICAgICAgImNyYWZ0ZWRNb2RzIjogWwogICAgICAgICIrMjQgdG8gU3RyZW5ndGgiCiAgICAgIF0KICAgIH0KICB9LAogIHsKICAgICJyZWFzb24iOiAiTm9uVW5pcXVlSXRlbVByZXNlbnQiLAogICAgInJ1bGVJZCI6ICJyYXJpdHkiLAogICAgInNldmVyaXR5IjogInZpb2xhdGlvbiIsCiAgICAiaXRlbU5hbWUiOiAiU2t1bGwgVmlzYWdlIEdyZWF0IENyb3duIiwKICAgICJpdGVtTGV2ZWwiOiA1NywKICAgICJpdGVtU2xvdCI6ICJIZWxtIiwKICAgICJzb2NrZXRJbmRleCI6IDAsCiAgICAiY2hhcmFjdGVyTmFtZSI6ICJTbGVlcGVyU3BlY3RyZUJvaSIsCiAgICAiY2hhcmFjdGVyTGV2ZWwiOiA5MSwKICAgICJhY2NvdW50TmFtZSI6ICJFdmVybGFnIiwKICAgICJ3aGVuIjogIjIwMjAtMDEtMTVUMDQ6NDM6MjdaIiwKICAgICJldmlkZW5jZSI6IHsKICAgICAgImlkIjogImQ1N2UxY2Q4NmYyMTgyYTQ1ZjMzMjJmOGMyZTllYmE4YmY4NjNkNjAzZmFiNTBiMzRkMzIwYmQwZTk4MDk2NDciLAogICAgICAicmFyaXR5IjogInJhcmUiLAogICAgICAibmFtZSI6ICJTa3VsbCBWaXNhZ2UiLAogICAgICAidHlwZUxpbmUiOiAiR3JlYXQgQ3Jvd24iLAogICAgICAiZW5jaGFudE1vZHMiOiBbCiAgICAgICAgIkJvbmUgT2ZmZXJpbmcgZ3JhbnRzIGFuIGFkZGl0aW9uYWwgKzYlIENoYW5jZSB0byBCbG9jayBBdHRhY2sgRGFtYWdlIgogICAgICBdLAogICAgICAiZXhwbGljaXRNb2RzIjogWwogICAgICAgICIyMCUgaW5jcmVhc2VkIEFybW91ciBhbmQgRW5lcmd5IFNoaWVsZCIsCiAgICAgICAgIis4OSB0byBtYXhpbXVtIExpZmUiLAogICAgICAgICIrMzMlIHRvIENvbGQgUmVzaXN0YW5jZSIsCiAgICAgICAgIisxMCUgdG8gTGlnaHRuaW5nIFJlc2lzdGFuY2UiCiAgICAgIF0sCiAgICAgICJjcmFmdGVkTW9kcyI6IFsKICAgICAgICAiKzMwIHRvIEFybW91ciIKICAgICAgXQogICAgfQogIH0sCiAgewogICAgInJlYXNvbiI6ICJOb25VbmlxdWVJdGVtUHJlc2VudCIsCiAgICAicnVsZUlkIjogInJhcml0eSIsCiAgICAic2V2ZXJpdHkiOiAidmlvbGF0aW9uIiwKICAgICJpdGVtTmFtZSI6ICJSb3lhbCBTa2VhbiIsCiAgICAiaXRlbUxldmVsIjogNTAsCiAgICAiaXRlbVNsb3QiOiAiV2VhcG9uMiIsCiAgICAic29ja2V0SW5kZXgiOiAwLAogICAgImNoYXJhY3Rlck5hbWUiOiAiU2xlZXBlclNwZWN0cmVCb2kiLAogICAgImNoYXJhY3RlckxldmVsIjogOTEsCiAgICAiYWNjb3VudE5hbWUiOiAiRXZlcmxhZyIsCiAgICAid2hlbiI6ICIyMDIwLTAxLTE1VDA0OjQzOjI3WiIsCiAgICAiZXZpZGVuY2UiOiB7CiAgICAgICJpZCI6ICJhMmZiNDY3Yzg1NzUyZWI1MWNjMWFjNzUyYjJjODQ2ZTVmNjc3OGU0MzBmYzhmMDE1ZmI5NjdjNDYyNWM3MTc1IiwKICAgICAgInJhcml0eSI6ICJub3JtYWwiLAogICAgICAidHlwZUxpbmUiOiAiUm95YWwgU2tlYW4iLAogICAgICAiaW1wbGljaXRNb2RzIjogWwogICAgICAgICIzMCUgaW5jcmVhc2VkIEdsb2JhbCBDcml0aWNhbCBTdHJpa2UgQ2hhbmNlIgogICAgICBdCiAgICB9CiAgfSwKICB7CiAgICAicmVhc29uIjogIk5vblVuaXF1ZUl0ZW1QcmVzZW50IiwKICAgICJydWxlSWQiOiAicmFyaXR5IiwKICAgICJzZXZlcml0eSI6ICJ2aW9sYXRpb24iLAogICAgIml0ZW1OYW1lIjogIkJhcmJlZCBDbHViIiwKICAgICJpdGVtTGV2ZWwiOiA1MCwKICAgICJpdGVtU2xvdCI6ICJPZmZoYW5kMiIsCiAgICAic29ja2V0SW5kZXgiOiAwLAogICAgImNoYXJhY3Rlck5hbWUiOiAiU2xlZXBlclNwZWN0cmVCb2kiLAogICAgImNoYXJhY3RlckxldmVsIjogOTEsCiAgICAiYWNjb3VudE5hbWUiOiAiRXZlcmxhZyIsCiAgICAid2hlbiI6ICIyMDIwLTAxLTE1VDA0OjQzOjI3WiIsCiAgICAiZXZpZGVuY2UiOiB7CiAgICAgICJpZCI6ICI4MTY2ZTVhMTdiM2JjNTJmOTc5ZjRmZjQyN2QxNmRlMzViNWUzNjYxZGMxMTBjMDM3MDA5ZmE4ODVjMmVhYTdjIiwKICAgICAgInJhcml0eSI6ICJub3JtYWwiLAogICAgICAidHlwZUxpbmUiOiAiQmFyYmVkIENsdWIiLAogICAgICAiaW1wbGljaXRNb2RzIjogWwogICAgICAgICIxMCUgcmVkdWNlZCBFbmVteSBTdHVuIFRocmVzaG9sZCIKICAgICAgXQogICAgfQogIH0sCiAgewogICAgInJlYXNvbiI6ICJOb25VbmlxdWVJdGVtUHJlc2VudCIsCiAgICAicnVsZUlkIjogInJhcml0eSIsCiAgICAic2V2ZXJpdHkiOiAidmlvbGF0aW9uIiwKICAgICJpdGVtTmFtZSI6ICJGb2UgS251Y2tsZSBUd28tU3RvbmUgUmluZyIsCiAgICAiaXRlbUxldmVsIjogNzMsCiAgICAiaXRlbVNsb3QiOiAiUmluZzIiLAogICAgInNvY2tldEluZGV4IjogMCwKICAgICJjaGFyYWN0ZXJOYW1lIjogIlNsZWVwZXJTcGVjdHJlQm9pIiwKICAgICJjaGFyYWN0ZXJMZXZlbCI6IDkxLAogICAgImFjY291bnROYW1lIjogIkV2ZXJsYWciLAogICAgIndoZW4iOiAiMjAyMC0wMS0xNVQwNDo0MzoyN1oiLAogICAgImV2aWRlbmNlIjogewogICAgICAiaWQiOiAiODE5ZmI3Y2U3ZTFjYjBjMzk5OTExZDBhNzMxZWRlMWI2YjA2ZmNhOGY3YjJhM2I5ZmJkOTIzMDY3Y2JhNTdjNiIsCiAgICAgICJyYXJpdHkiOiAicmFyZSIsCiAgICAgICJuYW1lIjogIkZvZSBLbnVja2xlIiwKICAgICAgInR5cGVMaW5lIjogIlR3by1TdG9uZSBSaW5nIiwKICAgICAgImltcGxpY2l0TW9kcyI6IFsKICAgICAgICAiKzE2JSB0byBGaXJlIGFuZCBMaWdodG5pbmcgUmVzaXN0YW5jZXMiCiAgICAgIF0sCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIisxMiB0byBTdHJlbmd0aCIsCiAgICAgICAgIkFkZHMgMSB0byAyIFBoeXNpY2FsIERhbWFnZSB0byBBdHRhY2tzIiwKICAgICAgICAiKzM5JSB0byBGaXJlIFJlc2lzdGFuY2UiLAogICAgICAgICIrMzYlIHRvIENvbGQgUmVzaXN0YW5jZSIsCiAgICAgICAgIjAuMjMlIG9mIFBoeXNpY2FsIEF0dGFjayBEYW1hZ2UgTGVlY2hlZCBhcyBMaWZlIgogICAgICBdLAogICAgICAiY3JhZnRlZE1vZHMiOiBbCiAgICAgICAgIisyNCB0byBtYXhpbXVtIExpZmUiCiAgICAgIF0KICAgIH0KICB9LAogIHsKICAgICJyZWFzb24iOiAiTm9uVW5pcXVlSXRlbVByZXNlbnQiLAogICAgInJ1bGVJZCI6ICJyYXJpdHkiLAogICAgInNldmVyaXR5IjogInZpb2xhdGlvbiIsCiAgICAiaXRlbU5hbWUiOiAiRGFtbmF0aW9uIE1lZGFsbGlvbiBKYWRlIEFtdWxldCIsCiAgICAiaXRlbUxldmVsIjogNzAsCiAgICAiaXRlbVNsb3QiOiAiQW11bGV0IiwKICAgICJzb2NrZXRJbmRleCI6IDAsCiAgICAiY2hhcmFjdGVyTmFtZSI6ICJTbGVlcGVyU3BlY3RyZUJvaSIsCiAgICAiY2hhcmFjdGVyTGV2ZWwiOiA5MSwKICAgICJhY2NvdW50TmFtZSI6ICJFdmVybGFnIiwKICAgICJ3aGVuIjogIjIwMjAtMDEtMTVUMDQ6NDM6MjdaIiwKICAgICJldmlkZW5jZSI6IHsKICAgICAgImlkIjogIjdjYWIyNjgwZDY1NWQ2ZGYwZWFjNzA4ZGQ2ZTNiMzE4MDY2YzE3MDUyOTNiMWM0NDgxMGE1ODQwNmQzYzc1NDAiLAogICAgICAicmFyaXR5IjogInJhcmUiLAogICAgICAibmFtZSI6ICJEYW1uYXRpb24gTWVkYWxsaW9uIiwKICAgICAgInR5cGVMaW5lIjogIkphZGUgQW11bGV0IiwKICAgICAgImltcGxpY2l0TW9kcyI6IFsKICAgICAgICAiKzI1IHRvIERleHRlcml0eSIKICAgICAgXSwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiQWRkcyAxIHRvIDI4IExpZ2h0bmluZyBEYW1hZ2UgdG8gQXR0YWNrcyIsCiAgICAgICAgIjMlIGluY3JlYXNlZCBtYXhpbXVtIEVuZXJneSBTaGllbGQiLAogICAgICAgICIrNiUgdG8gYWxsIEVsZW1lbnRhbCBSZXNpc3RhbmNlcyIsCiAgICAgICAgIiszNSUgdG8gTGlnaHRuaW5nIFJlc2lzdGFuY2UiLAogICAgICAgICIrMyBNYW5hIGdhaW5lZCBvbiBLaWxsIgogICAgICBdLAogICAgICAiY3JhZnRlZE1vZHMiOiBbCiAgICAgICAgIiszMCB0byBtYXhpbXVtIExpZmUiCiAgICAgIF0KICAgIH0KICB9LAogIHsKICAgICJyZWFzb24iOiAiTm9uVW5pcXVlSXRlbVByZXNlbnQiLAogICAgInJ1bGVJZCI6ICJyYXJpdHkiLAogICAgInNldmVyaXR5IjogInZpb2xhdGlvbiIsCiAgICAiaXRlbU5hbWUiOiAiRm91bCBPY3VsdXMgR2hhc3RseSBFeWUgSmV3ZWwiLAogICAgIml0ZW1MZXZlbCI6IDY5LAogICAgIml0ZW1TbG90IjogIlBhc3NpdmVKZXdlbHMiLAogICAgInNvY2tldEluZGV4IjogMCwKICAgICJjaGFyYWN0ZXJOYW1lIjogIlNsZWVwZXJTcGVjdHJlQm9pIiwKICAgICJjaGFyYWN0ZXJMZXZlbCI6IDkxLAogICAgImFjY291bnROYW1lIjogIkV2ZXJsYWciLAogICAgIndoZW4iOiAiMjAyMC0wMS0xNVQwNDo0MzoyN1oiLAogICAgImV2aWRlbmNlIjogewogICAgICAiaWQiOiAiMzQ1MjE4ZmI0YTEwMDE1Yjc1MjU0MGY5ZDU0Yzg3NzM4MDU0MzRjMDFkODA2MzhjYzQxODliZjM3NjU5ZjNmNiIsCiAgICAgICJyYXJpdHkiOiAicmFyZSIsCiAgICAgICJuYW1lIjogIkZvdWwgT2N1bHVzIiwKICAgICAgInR5cGVMaW5lIjogIkdoYXN0bHkgRXllIEpld2VsIiwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiKzI3IHRvIG1heGltdW0gTGlmZSIsCiAgICAgICAgIisyNSB0byBtYXhpbXVtIE1hbmEiLAogICAgICAgICIyJSBhZGRpdGlvbmFsIFBoeXNpY2FsIERhbWFnZSBSZWR1Y3Rpb24gaWYgeW91IHdlcmVuJ3QgRGFtYWdlZCBieSBhIEhpdCBSZWNlbnRseSIsCiAgICAgICAgIk1pbmlvbnMgaGF2ZSA0JSBjaGFuY2UgdG8gQmxpbmQgb24gSGl0IHdpdGggQXR0YWNrcyIKICAgICAgXQogICAgfQogIH0KXQo=\"")
+	packr.PackJSONBytes(".", "golden/sleeper-spectre/get-items.json", "\"eyJpdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoyLCJoIjoyLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9Bcm1vdXJzXC9HbG92ZXNcL0dsb3Zlc1N0ckRleDMucG5nP3NjYWxlPTEmdz0yJmg9MiZ2PTI2YTliMTU5YTFhNTNlMGNhZTc5ZDMwZDZmYTUwY2RkIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6IjhlYzBlYjVlMGU4YWY0NTczZWUwYjEwMTRhOWQxYTY4NGMwZDlkOGE2YjIyOTYxNGM5ZTA5MWYzZmEwNGM0MWYiLCJpbmZsdWVuY2VzIjp7ImVsZGVyIjp0cnVlfSwiZWxkZXIiOnRydWUsInNvY2tldHMiOlt7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn0seyJncm91cCI6MCwiYXR0ciI6IlMiLCJzQ29sb3VyIjoiUiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJEIiwic0NvbG91ciI6IkcifSx7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn1dLCJuYW1lIjoiQnJhbWJsZSBGaW5nZXJzIiwidHlwZUxpbmUiOiJEcmFnb25zY2FsZSBHYXVudGxldHMiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3NiwicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IkFybW91ciIsInZhbHVlcyI6W1siMTY4IiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNn0seyJuYW1lIjoiRXZhc2lvbiBSYXRpbmciLCJ2YWx1ZXMiOltbIjE2OSIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTd9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMjMiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiZXhwbGljaXRNb2RzIjpbIlNvY2tldGVkIEdlbXMgaGF2ZSAxNiUgbW9yZSBBdHRhY2sgYW5kIENhc3QgU3BlZWQiLCIrMjIgdG8gRGV4dGVyaXR5IiwiKzE5IHRvIEFybW91ciIsIisyMCB0byBFdmFzaW9uIFJhdGluZyIsIisyOCUgdG8gTGlnaHRuaW5nIFJlc2lzdGFuY2UiXSwiY3JhZnRlZE1vZHMiOlsiKzQ1IHRvIG1heGltdW0gTGlmZSJdLCJlbmNoYW50TW9kcyI6WyJUcmlnZ2VyIENvbW1hbmRtZW50IG9mIHRoZSBHcmF2ZSB3aGVuIHlvdXIgU2tpbGxzIG9yIE1pbmlvbnMgS2lsbCJdLCJmcmFtZVR5cGUiOjIsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiR2xvdmVzIiwic29ja2V0ZWRJdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9GYXN0ZXJBdHRhY2tzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1jMTQyMDNmN2IxOTY1MDg2MTkwN2EzMGE5MmUzYjZmZSIsInN1cHBvcnQiOnRydWUsImlkIjoiNWIyZDc2ZGI4MTU2ZTA2YTU2YzM0MTk5ZDQ0ZTAwMjUwMDk4NzIyZWU4MzY2MTQ3MDEzNzdiYzc2ZjdjMmExNCIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkZhc3RlciBBdHRhY2tzIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJBdHRhY2ssIFN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjExNSUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIis5JSIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6Nn1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMTExIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1cHBvcnRzIGF0dGFjayBza2lsbHMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgaGF2ZSA0OCUgaW5jcmVhc2VkIEF0dGFjayBTcGVlZCJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjAsImNvbG91ciI6IkQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvRm9ydGlmeUdlbS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NmE2OTg1M2FhZjcyNzg2NjdiZGU0MzUzMTU0MWVmN2QiLCJzdXBwb3J0Ijp0cnVlLCJpZCI6IjMyNDg1MDU5MzY4M2EwZjIwZGU5MzNkYjA1ZTgxNjBmMjE4ZmNjNTQ1NzI3NGRkODU1NWQzOTE4M2M1MmUyNDciLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJGb3J0aWZ5IFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJBdHRhY2ssIFN1cHBvcnQsIE1lbGVlIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgbWVsZWUgYXR0YWNrIHNraWxscyB0aGF0IGFyZSBub3QgdHJpZ2dlcmVkLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGdyYW50IEZvcnRpZnkgb24gTWVsZWUgaGl0IiwiU3VwcG9ydGVkIFNraWxscyBoYXZlIDM0JSBpbmNyZWFzZWQgRm9ydGlmeSBkdXJhdGlvbiIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAzNCUgbW9yZSBEYW1hZ2Ugd2l0aCBBaWxtZW50cyBjYXVzZWQgYnkgTWVsZWUgSGl0cyIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAzNCUgbW9yZSBNZWxlZSBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoxLCJjb2xvdXIiOiJTIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0N1bGxpbmdTdHJpa2UucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTdjYjc1NmVkMDMzYjYwNzM5ODZmZDhiYWJmYzEwZjQzIiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiJjNDhhYmZlMWNiMzJkYTAwNDZiOTQ5MzUwZGFlMTU5MjA2MzRjNTM4NDk0ZjBjYzE1YmEyNjUzYWNkYWIwMTFhIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQ3VsbGluZyBTdHJpa2UgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjExMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIGVuZW1pZXMuIElmIGVuZW1pZXMgYXJlIGxlZnQgYmVsb3cgMTAlIG9mIG1heGltdW0gbGlmZSBhZnRlciBiZWluZyBoaXQgYnkgdGhlc2Ugc2tpbGxzLCB0aGV5IHdpbGwgYmUga2lsbGVkLiIsImV4cGxpY2l0TW9kcyI6WyJLaWxsIEVuZW1pZXMgdGhhdCBoYXZlIDEwJSBMaWZlIG9yIGxvd2VyIHdoZW4gSGl0IGJ5IFN1cHBvcnRlZCBTa2lsbHMiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMzglIGluY3JlYXNlZCBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJEIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1doaXJsaW5nQmxhZGVzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lNDU2MDRlODA4YmM5OGY1MTJmZDQ4MTgyNjVjZGQ3YSIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjNmOGNhYWI4M2Q5OGJkMjlkOTc3NTM5ZmM4OGExOTNjNWE4NjI4MzVmMjEzMjY3YmNkYmNhMGVhM2JiZDhjYjEiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJXaGlybGluZyBCbGFkZXMiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQXR0YWNrLCBNb3ZlbWVudCwgVHJhdmVsLCBNZWxlZSIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNCIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxMCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRWZmZWN0aXZlbmVzcyBvZiBBZGRlZCBEYW1hZ2UiLCJ2YWx1ZXMiOltbIjEwMyUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiYWRkaXRpb25hbFByb3BlcnRpZXMiOlt7Im5hbWUiOiJFeHBlcmllbmNlIiwidmFsdWVzIjpbWyIxMTExOTJcLzExMTE5MiIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjEsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyIzMyIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIyMyIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJuZXh0TGV2ZWxSZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMzgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMjYiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiRGl2ZSB0aHJvdWdoIGVuZW1pZXMsIGRlYWxpbmcgd2VhcG9uIGRhbWFnZS4gT25seSB3b3JrcyB3aXRoIGRhZ2dlcnMsIGNsYXdzIGFuZCBvbmUgaGFuZGVkIHN3b3Jkcy4gQ2Fubm90IGJlIHN1cHBvcnRlZCBieSBNdWx0aXN0cmlrZS4iLCJleHBsaWNpdE1vZHMiOlsiRGVhbHMgMTAzLjIlIG9mIEJhc2UgRGFtYWdlIiwiKzAuNiBzZWNvbmRzIHRvIEF0dGFjayBUaW1lIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjozLCJjb2xvdXIiOiJEIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MywiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQXJtb3Vyc1wvU2hpZWxkc1wvUmlzZW9mdGhlcGhvZW5peC5wbmc/c2NhbGU9MSZ3PTImaD0zJnY9Yzc3MTdjNGUwMWI3ZTUwNjg3NjE2YzY4NjUwMzk3MWMiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiZTA4Y2U0YzMwNmYyZjljNjdhODZlYTZmMDc2MWIxNGIyZGRkYzIwYTcwMWM5NzIzMmFkMzBlYTM2ZjYxNDRjZCIsInNvY2tldHMiOlt7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IlMiLCJzQ29sb3VyIjoiUiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifV0sIm5hbWUiOiJSaXNlIG9mIHRoZSBQaG9lbml4IiwidHlwZUxpbmUiOiJNb3NhaWMgS2l0ZSBTaGllbGQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2OSwicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IkNoYW5jZSB0byBCbG9jayIsInZhbHVlcyI6W1siMjQlIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNX0seyJuYW1lIjoiQXJtb3VyIiwidmFsdWVzIjpbWyIzMjIiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjE2fSx7Im5hbWUiOiJFbmVyZ3kgU2hpZWxkIiwidmFsdWVzIjpbWyI2MSIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTh9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcyIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMjEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiaW1wbGljaXRNb2RzIjpbIis4JSB0byBhbGwgRWxlbWVudGFsIFJlc2lzdGFuY2VzIl0sImV4cGxpY2l0TW9kcyI6WyI5OSUgaW5jcmVhc2VkIEFybW91ciBhbmQgRW5lcmd5IFNoaWVsZCIsIis1MCB0byBtYXhpbXVtIExpZmUiLCJSZWdlbmVyYXRlIDE3LjUgTGlmZSBwZXIgc2Vjb25kIiwiKzUlIHRvIG1heGltdW0gRmlyZSBSZXNpc3RhbmNlIiwiKzI0JSB0byBGaXJlIFJlc2lzdGFuY2UiLCIrMjUlIHRvIEZpcmUgUmVzaXN0YW5jZSB3aGlsZSBvbiBMb3cgTGlmZSIsIjEwJSBpbmNyZWFzZWQgTW92ZW1lbnQgU3BlZWQgd2hlbiBvbiBMb3cgTGlmZSIsIkNhbm5vdCBiZSBJZ25pdGVkIHdoaWxlIG9uIExvdyBMaWZlIl0sImZsYXZvdXJUZXh0IjpbIk15IGJlYXJlciBzaGFsbCBiZSBndWFyZGVkIGJ5IGZsYW1lLFxyIiwiZm9yIEkgYW0gdGhlIHBob2VuaXgsIGZvcmV2ZXIgcmFkaWFudCBpbiBnbG9yeS4iXSwiZnJhbWVUeXBlIjozLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6Ik9mZmhhbmQiLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0Zyb3N0RnVyeS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NzdhYWFiZmJiNGMxYmViY2Q4ZTIxNDdiOGNlYzlmZmQiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiJkMGY3NjhkYmZjY2IwNWU2YTJmZDIzODk4NTY2OWFiYjM3NzJiYmNjMWNhNDNlYWYyOTVlY2QxYjY3OWZiNTNhIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiV2ludGVyIE9yYiIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJDb2xkLCBTcGVsbCwgQ2hhbm5lbGxpbmcsIEFvRSwgRHVyYXRpb24sIFByb2plY3RpbGUiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjEyIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIENvc3QiLCJ2YWx1ZXMiOltbIjMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siMC4yNSBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjYuMDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJFZmZlY3RpdmVuZXNzIG9mIEFkZGVkIERhbWFnZSIsInZhbHVlcyI6W1siNTAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siOTM2NDg3XC8xOTU2NjQ4IiwwXV0sImRpc3BsYXlNb2RlIjoyLCJwcm9ncmVzcyI6MC40Nzg2MTgwMjU3Nzk3MjQxLCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNTQiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTIxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNoYW5uZWwgdG8gY29uanVyZSBhbiBvcmIgYWJvdmUgeW91LCB3aGljaCBwZWx0cyBuZWFyYnkgZW5lbWllcyB3aXRoIHByb2plY3RpbGVzIHRoYXQgaW1wYWN0IHRoZSBncm91bmQgYW5kIGV4cGxvZGUuIENoYW5uZWxsaW5nIGZvciBsb25nZXIgYnVpbGRzIHVwIHN0YWdlcyBvbiB0aGUgc2tpbGwuIFdoZW4geW91IHN0b3AgY2hhbm5lbGxpbmcsIHRoZSBzdGFnZXMgZGVjYXkgb3ZlciBhIGR1cmF0aW9uLiIsImV4cGxpY2l0TW9kcyI6WyJEZWFscyAxMTMgdG8gMTQxIENvbGQgRGFtYWdlIiwiQmFzZSBkdXJhdGlvbiBpcyAxLjIwIHNlY29uZHMiLCJJbmNyZWFzZXMgYW5kIFJlZHVjdGlvbnMgdG8gQ2FzdCBTcGVlZCBhbHNvIGFwcGx5IHRvIFByb2plY3RpbGUgRnJlcXVlbmN5IiwiRmlyZXMgcHJvamVjdGlsZXMgZXZlcnkgMS42IHNlY29uZHMiLCIyNSUgaW5jcmVhc2VkIER1cmF0aW9uIHBlciBTdGFnZSIsIjEwMCUgbW9yZSBQcm9qZWN0aWxlIEZyZXF1ZW5jeSB3aGlsZSBDaGFubmVsbGluZyIsIjE1JSBpbmNyZWFzZWQgUHJvamVjdGlsZSBGcmVxdWVuY3kgcGVyIFN0YWdlIiwiTWF4aW11bSAxMCBTdGFnZXMiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjAsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvSW5jcmVhc2VkRHVyYXRpb24ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTE0YjNhOTE5MzNmMGI5MjFkMWI1NzMzNThlMzFmNzY4Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI4YTg4ZTk2NTQxMzU2YjJmZjJlYWY0NzAxM2YwOGU1MjEyNjIzYjA5YmNkYTMyZWRkOThjZWMwYjI5ZDcwNTE1IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiSW5jcmVhc2VkIER1cmF0aW9uIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCwgRHVyYXRpb24iLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjE0MCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgd2l0aCBhIGR1cmF0aW9uLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGhhdmUgNjQlIGluY3JlYXNlZCBTa2lsbCBFZmZlY3QgRHVyYXRpb24iXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoxLCJjb2xvdXIiOiJTIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0Zhc3RlckNhc3QucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PWY3MGUzMGNkMzc1NWQxMWE4MTEwM2I3MDk1N2U1NzljIiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiJiYmQ5ZTdmYjJhODAyYjFjYmJjODM1MzYwZDYxMTBhZTNkMGI2YmRjZThiNmExMjY5ODc0ODZjZTRhNzMxMWIyIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiRmFzdGVyIENhc3RpbmcgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQsIFNwZWxsIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMSAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMjAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTQiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgbm9uLWluc3RhbnQgc3BlbGwgc2tpbGxzLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGhhdmUgNDAlIGluY3JlYXNlZCBDYXN0IFNwZWVkIl0sImRlc2NyVGV4dCI6IlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MiwiY29sb3VyIjoiSSJ9XX0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL1JpbmdzXC9FbXB0eS1Tb2NrZXQucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTM2NzFmNmQ3OWQ3MTkwYjQzODc5YjgzMGU2M2ZjMDc4IiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImQyZGM2M2ZhNzBlZWNkYmE3Mzg3MGNhNjc0YjYyNDdhNTVhMTc0OGVlYTg5NmRlNzRhZjIzMThlMzYxNDEzNWIiLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9XSwibmFtZSI6IlBsYWd1ZSBDaXJjbGUiLCJ0eXBlTGluZSI6IlVuc2V0IFJpbmciLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2NywicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjE1NSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJpbXBsaWNpdE1vZHMiOlsiSGFzIDEgU29ja2V0Il0sImV4cGxpY2l0TW9kcyI6WyIrMiB0byBMZXZlbCBvZiBTb2NrZXRlZCBHZW1zIiwiQWRkcyA0IHRvIDYgUGh5c2ljYWwgRGFtYWdlIHRvIEF0dGFja3MiLCIrNTIgdG8gbWF4aW11bSBMaWZlIiwiMTQlIGluY3JlYXNlZCBSYXJpdHkgb2YgSXRlbXMgZm91bmQiLCIrNDAlIHRvIEZpcmUgUmVzaXN0YW5jZSJdLCJjcmFmdGVkTW9kcyI6WyIrMjQgdG8gU3RyZW5ndGgiXSwiZnJhbWVUeXBlIjoyLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IlJpbmciLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0NsYXJpdHkucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PWY2MDljMTI4ZTVlZTA1M2U3NzNiMzkxNjI1NzU3ZDZhIiwic3VwcG9ydCI6ZmFsc2UsImlkIjoiNTQxZGI1MzdjMTg4NTc1MzQ2ZjlkNTE4NDU5M2YyNWJiZjg5NGRkMzAwNjQwYTExODY3YWJmYmNhOTc5OGZkMiIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkNsYXJpdHkiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQXVyYSwgU3BlbGwsIEFvRSIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjAgKE1heCkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgUmVzZXJ2ZWQiLCJ2YWx1ZXMiOltbIjI3OSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siMS4yMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siSW5zdGFudCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTU1IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNhc3RzIGFuIGF1cmEgdGhhdCBncmFudHMgbWFuYSByZWdlbmVyYXRpb24gdG8geW91IGFuZCB5b3VyIGFsbGllcy4iLCJleHBsaWNpdE1vZHMiOlsiKzE5IHRvIHJhZGl1cyIsIllvdSBhbmQgbmVhcmJ5IEFsbGllcyBSZWdlbmVyYXRlIDMzIE1hbmEgcGVyIHNlY29uZCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MCwiY29sb3VyIjoiSSJ9XX0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjIsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0FybW91cnNcL0hlbG1ldHNcL0hlbG1ldFN0ckludDcucG5nP3NjYWxlPTEmdz0yJmg9MiZ2PWJmMjk2M2U3ODM3OWY2ZWIzNWJiZmVkZTNhZGUwMTY0IiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImQ1N2UxY2Q4NmYyMTgyYTQ1ZjMzMjJmOGMyZTllYmE4YmY4NjNkNjAzZmFiNTBiMzRkMzIwYmQwZTk4MDk2NDciLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkQiLCJzQ29sb3VyIjoiRyJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifSx7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9XSwibmFtZSI6IlNrdWxsIFZpc2FnZSIsInR5cGVMaW5lIjoiR3JlYXQgQ3Jvd24iLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo1NywicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IkFybW91ciIsInZhbHVlcyI6W1siMjQyIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNn0seyJuYW1lIjoiRW5lcmd5IFNoaWVsZCIsInZhbHVlcyI6W1siMzkiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjE4fV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyI1NCIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiZXhwbGljaXRNb2RzIjpbIjIwJSBpbmNyZWFzZWQgQXJtb3VyIGFuZCBFbmVyZ3kgU2hpZWxkIiwiKzg5IHRvIG1heGltdW0gTGlmZSIsIiszMyUgdG8gQ29sZCBSZXNpc3RhbmNlIiwiKzEwJSB0byBMaWdodG5pbmcgUmVzaXN0YW5jZSJdLCJjcmFmdGVkTW9kcyI6WyIrMzAgdG8gQXJtb3VyIl0sImVuY2hhbnRNb2RzIjpbIkJvbmUgT2ZmZXJpbmcgZ3JhbnRzIGFuIGFkZGl0aW9uYWwgKzYlIENoYW5jZSB0byBCbG9jayBBdHRhY2sgRGFtYWdlIl0sImZyYW1lVHlwZSI6MiwieCI6MCwieSI6MCwiaW52ZW50b3J5SWQiOiJIZWxtIiwic29ja2V0ZWRJdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9DdWxsaW5nU3RyaWtlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj03Y2I3NTZlZDAzM2I2MDczOTg2ZmQ4YmFiZmMxMGY0MyIsInN1cHBvcnQiOnRydWUsImlkIjoiMmY1YzhkZjU2MDk0Mzk0ZDFhYTI5Y2UzYjFiNWE5ODA2M2VhYWU3NmE2MDMxMjRlYjUyZmYyMDNhZjVjNzI1MSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkN1bGxpbmcgU3RyaWtlIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMTAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siMzIwNjcyXC8zMjA2NzIiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjoxLCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMzIiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siNTQiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwibmV4dExldmVsUmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjM1IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjU4IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1cHBvcnRzIGFueSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcy4gSWYgZW5lbWllcyBhcmUgbGVmdCBiZWxvdyAxMCUgb2YgbWF4aW11bSBsaWZlIGFmdGVyIGJlaW5nIGhpdCBieSB0aGVzZSBza2lsbHMsIHRoZXkgd2lsbCBiZSBraWxsZWQuIiwiZXhwbGljaXRNb2RzIjpbIktpbGwgRW5lbWllcyB0aGF0IGhhdmUgMTAlIExpZmUgb3IgbG93ZXIgd2hlbiBIaXQgYnkgU3VwcG9ydGVkIFNraWxscyIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCA4JSBpbmNyZWFzZWQgRGFtYWdlIl0sImRlc2NyVGV4dCI6IlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MCwiY29sb3VyIjoiRCJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9CYWxsTGlnaHRuaW5nLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj00ZWU5OGNjMWUwNDQzMGNmZDQ1NjM5YWUxYWQ1NWEzOCIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjAwZGMxMGUxNjdhN2MyN2Y3NTEyYjk1MjFhZjhkNmQzMzUxZGMwOGRiODhiMWRmNmU4YzczYTMyMWI3OGYzNDYiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJCYWxsIExpZ2h0bmluZyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgUHJvamVjdGlsZSwgQW9FLCBMaWdodG5pbmciLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgQ29zdCIsInZhbHVlcyI6W1siMTQiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siMC43NSBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjUuMDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJFZmZlY3RpdmVuZXNzIG9mIEFkZGVkIERhbWFnZSIsInZhbHVlcyI6W1siNDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siMjg1ODE1XC8yODU4MTUiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjoxLCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMzEiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siNzMiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwibmV4dExldmVsUmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjM0IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjc5IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkZpcmVzIGEgc2xvdy1tb3ZpbmcgcHJvamVjdGlsZSB0aGF0IHBlcmlvZGljYWxseSBkYW1hZ2VzIGVuZW1pZXMgaW4gYW4gYXJlYSBhcm91bmQgaXQgd2l0aCBib2x0cyBvZiBsaWdodG5pbmcuIiwiZXhwbGljaXRNb2RzIjpbIkRlYWxzIDMgdG8gNTggTGlnaHRuaW5nIERhbWFnZSJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MSwiY29sb3VyIjoiSSJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9DdXJzZU9uSGl0LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0zYjRkMDBhM2Y1ZTM4ZGM5MTMxMTQ4ZDc2YmE4MWNkOSIsInN1cHBvcnQiOnRydWUsImlkIjoiN2NjZTE3NDllMzUwODVmOGVhYTU1Y2M5NmQ1MjUzYzQ0ZmQ2N2M4MWRkZWZhYjVkOGQ5ZmJiNGYwMTMzYzJkMSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkN1cnNlIE9uIEhpdCBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwiY29ycnVwdGVkIjp0cnVlLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQ3Vyc2UsIFRyaWdnZXIsIFN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiTXVzdCBzdXBwb3J0IGJvdGggYSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcywgYW5kIGEgY3Vyc2Ugc2tpbGwgdG8gd29yay4gVGhlIGN1cnNlIHdpbGwgYmUgYXBwbGllZCB3aGVuIGVuZW1pZXMgYXJlIGhpdCBieSB0aGUgb3RoZXIgc2tpbGwuIENhbm5vdCBzdXBwb3J0IHRvdGVtcywgdHJhcHMsIG9yIG1pbmVzLiBNaW5pb25zIGNhbm5vdCBhcHBseSBjdXJzZXMgdGhpcyB3YXkuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgYXBwbHkgc3VwcG9ydGVkIEN1cnNlcyBvbiBIaXQiLCJZb3UgY2Fubm90IENhc3QgU3VwcG9ydGVkIEN1cnNlcyIsIlN1cHBvcnRlZCBTa2lsbHMgaGF2ZSAxMiUgcmVkdWNlZCBDdXJzZSBEdXJhdGlvbiJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjIsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvRmxhbW1hYmlsaXR5LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj02ZmJmN2NmY2RkY2MyNjdiMWY0NGZiODQzMTRkYmUwNCIsInN1cHBvcnQiOmZhbHNlLCJpZCI6ImM2ZThjNzRhYjlhMTY5NDJmYjVmZDQ4ZmQ0MTM0OTczMTY2YzhmNWM0ZTA2ZDhjMTAzNDFhZmExYjFhMjdjMDciLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJGbGFtbWFiaWxpdHkiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgQW9FLCBEdXJhdGlvbiwgQ3Vyc2UsIEZpcmUiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIENvc3QiLCJ2YWx1ZXMiOltbIjUwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJDYXN0IFRpbWUiLCJ2YWx1ZXMiOltbIjAuNTAgc2VjIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyI5OCIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJDdXJzZXMgYWxsIHRhcmdldHMgaW4gYW4gYXJlYSwgbWFraW5nIHRoZW0gbGVzcyByZXNpc3RhbnQgdG8gZmlyZSBkYW1hZ2UgYW5kIGdpdmluZyB0aGVtIGEgY2hhbmNlIHRvIGJlIGlnbml0ZWQgYnkgZmlyZSBkYW1hZ2UuIiwiZXhwbGljaXRNb2RzIjpbIkJhc2UgZHVyYXRpb24gaXMgMTAuOTAgc2Vjb25kcyIsIisxMCB0byByYWRpdXMiLCJDdXJzZWQgZW5lbWllcyBoYXZlIC00NCUgdG8gRmlyZSBSZXNpc3RhbmNlIiwiQ3Vyc2VkIGVuZW1pZXMgaGF2ZSArMTQlIGNoYW5jZSB0byBiZSBJZ25pdGVkIGJ5IEZpcmUgRGFtYWdlIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjozLCJjb2xvdXIiOiJJIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MywiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQXJtb3Vyc1wvQm9keUFybW91cnNcL1Zpc01vcnRpcy5wbmc/c2NhbGU9MSZ3PTImaD0zJnY9ZDk2NTVjZDI4OTU4OTcxNmJiNDU2MGJiY2M1ZGEwZTMiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiNmY4N2Y1Y2JjMGI2NjNhZWE0MDYyNmM2ZjUyNjE0NTIxMmQ3NTk5MDk3Yjg0YzY5ZjM4ZTM2OTkwYTRjMTEyMSIsInNvY2tldHMiOlt7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifSx7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn0seyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9LHsiZ3JvdXAiOjEsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifV0sIm5hbWUiOiJWaXMgTW9ydGlzIiwidHlwZUxpbmUiOiJOZWNyb21hbmNlciBTaWxrcyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjc1LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRW5lcmd5IFNoaWVsZCIsInZhbHVlcyI6W1siMzUyIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxOH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzIiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMTExIiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjE2MCIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJleHBsaWNpdE1vZHMiOlsiMjAxJSBpbmNyZWFzZWQgRW5lcmd5IFNoaWVsZCIsIisyOSB0byBtYXhpbXVtIE1hbmEiLCJNaW5pb25zIGhhdmUgMjAlIHJlZHVjZWQgbWF4aW11bSBMaWZlIiwiTWluaW9ucyBkZWFsIDE1JSBpbmNyZWFzZWQgRGFtYWdlIiwiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgU3BlY3RyZXMiLCJNaW5pb25zIGdhaW4gVW5ob2x5IE1pZ2h0IGZvciAxMCBzZWNvbmRzIG9uIEtpbGwiXSwiZmxhdm91clRleHQiOlsiUmVhcCB3aGF0IG90aGVycyBoYXZlIHNvd25cciIsIk11c3RlciB0aGVtIGZyb20gdGhlaXIgZ3JhdmVzXHIiLCJQYXJhZGUgdGhlbSBmb3IgeW91ciBwbGVhc3VyZVxyIiwiWmVhbG90cyBpbiBtb3J0aXMgZW5zbGF2ZWQiXSwiZnJhbWVUeXBlIjozLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IkJvZHlBcm1vdXIiLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1JhaXNlU3BlY3RyZS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MjQ3ODlhOWVjYWE1ODdiNDE4OGEwMjcyZDBhYWQ1YWIiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiI5MjkzNGU3MGM2NzM2ZTAyZWFkZWZiOWYxMzNkZTM1NjgxZTFhMzAzNDEyMzg2MWQzMzE4YjExMThjMDIzNDRiIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiUmFpc2UgU3BlY3RyZSIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgTWluaW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIyOCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjg1IHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTU1IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlJhaXNlcyBhIHNwZWN0cmFsIHZlcnNpb24gb2YgYSBkZWZlYXRlZCBmb2UgYXMgYSBtaW5pb24gdG8gZmlnaHQgZm9yIHlvdSBpbiBiYXR0bGUuIiwiZXhwbGljaXRNb2RzIjpbIk1pbmlvbnMgaGF2ZSArMzAlIHRvIGFsbCBFbGVtZW50YWwgUmVzaXN0YW5jZXMiLCJNYXhpbXVtIDIgUmFpc2VkIFNwZWN0cmVzIiwiNTUlIG1vcmUgTWluaW9uIE1vdmVtZW50IFNwZWVkIiwiTWluaW9uIE1vdmVtZW50IFNwZWVkIGlzIENhcHBlZCIsIlJhaXNlZCBTcGVjdHJlcyBhcmUgTGV2ZWwgODAiLCJNaW5pb25zIGhhdmUgMTEwOSBhZGRpdGlvbmFsIEFjY3VyYWN5IFJhdGluZyJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MCwiY29sb3VyIjoiSSJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9NaW5pb25EYW1hZ2UucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTExYTYyOTBlNzU5ODM3MWY4MDc1MGEwMzZjMDdjYzM4Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI3NDA1NTY2MzBlMDUzZTdiOWVhN2FkYmNlMGMwN2Q5NjFmMWIzZTBjMWUzYjE1NGQ3Y2UyYzY2OTYwOTRmZDIwIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiTWluaW9uIERhbWFnZSBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwiY29ycnVwdGVkIjp0cnVlLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCwgTWluaW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMSAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTQiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgc2tpbGxzIHdoaWNoIGNyZWF0ZSBNaW5pb25zLiIsImV4cGxpY2l0TW9kcyI6WyJNaW5pb25zIGZyb20gU3VwcG9ydGVkIFNraWxscyBkZWFsIDUwJSBtb3JlIERhbWFnZSJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjEsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvRWxlbWVudGFsRm9jdXMucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTkwYzA1ZWRhNjdmMTFhNDNiOTVkMjJkN2Q3NzBkMTE1Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI0NzU0NTYxZTYwMGM1OTI1ZGE1MjkwYTYxZjFlNWU2MjhjMTUyYzFkZmIzNzYzMDY2YmI1ZmZkMmNmMjliNjk4IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiRWxlbWVudGFsIEZvY3VzIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTdXBwb3J0IiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJRdWFsaXR5IiwidmFsdWVzIjpbWyIrNiUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIG9yIGJ1cm5zIGVuZW1pZXMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCA0OSUgbW9yZSBFbGVtZW50YWwgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBjYW5ub3QgaW5mbGljdCBFbGVtZW50YWwgQWlsbWVudHMiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMyUgaW5jcmVhc2VkIEVsZW1lbnRhbCBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0dyZWF0ZXJNdWx0aXBsZVByb2plY3RpbGVzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMWI2ZmIyNmU1OTM3OTU3ZTA4Y2U4MGUxMTNmNDFlMiIsInN1cHBvcnQiOnRydWUsImlkIjoiNDcxYWIyNWJlMWM5MzdmN2Y5ZTlhYzI2Nzc4YmYzMmM5ZDkyODI5OThmN2Y5ZWEwY2MwNjBhNmViOWU0YjJmMyIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkdyZWF0ZXIgTXVsdGlwbGUgUHJvamVjdGlsZXMgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQsIFByb2plY3RpbGUiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjE2NSUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBwcm9qZWN0aWxlIHNraWxscy4iLCJleHBsaWNpdE1vZHMiOlsiU3VwcG9ydGVkIFNraWxscyBkZWFsIDI2JSBsZXNzIFByb2plY3RpbGUgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBmaXJlIDQgYWRkaXRpb25hbCBQcm9qZWN0aWxlcyJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjMsImNvbG91ciI6IkQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvRWNoby5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MTcyNjVmYjEyMjYzZmNhMzQxZGRjNDQzYTI0MmQzMDYiLCJzdXBwb3J0Ijp0cnVlLCJpZCI6IjdlMGJlOWYwOWZmMjMzNzdlZGNhMzk3ZWQyMTdmNzA4MjVjMWRhNjI0NzRiYTkzNjBkZDBmY2Y3NDgwNThlYTEiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJTcGVsbCBFY2hvIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJjb3JydXB0ZWQiOnRydWUsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTcGVsbCwgU3VwcG9ydCIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjAgKE1heCkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgTXVsdGlwbGllciIsInZhbHVlcyI6W1siMTQwJSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiUXVhbGl0eSIsInZhbHVlcyI6W1siKzUlIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo2fV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgc3BlbGwgc2tpbGxzLCBtYWtpbmcgdGhlbSByZXBlYXQgd2hlbiBjYXN0LiBDYW5ub3Qgc3VwcG9ydCBWYWFsIHNraWxscywgdG90ZW0gc2tpbGxzLCBjaGFubmVsbGluZyBza2lsbHMsIHRyaWdnZXJlZCBza2lsbHMsIGluc3RhbnQgc2tpbGxzLCBvciBza2lsbHMgd2hpY2ggcmVzZXJ2ZSBtYW5hLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMTAlIGxlc3MgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBSZXBlYXQgYW4gYWRkaXRpb25hbCB0aW1lIiwiU3VwcG9ydGVkIFNraWxscyBoYXZlIDcwJSBtb3JlIENhc3QgU3BlZWQiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMiUgaW5jcmVhc2VkIFNwZWxsIERhbWFnZSJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjQsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvQ29udm9jYXRpb24ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTI4MDIzNzRlNjA2MzQ2MDhmM2QyNjYwNzJmOTdkZjQxIiwic3VwcG9ydCI6ZmFsc2UsImlkIjoiN2NlYjJlMjAxNDVhNDdhYTZmZGRjZDg3MWVjODhjNzMzZTVkNjBkMzIzNzZkY2VhMmE5MWVjYmNmZTZjNDkyYyIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkNvbnZvY2F0aW9uIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6Ik1pbmlvbiwgU3BlbGwsIER1cmF0aW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxMyIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siMy4wMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siSW5zdGFudCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTU1IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlJlY2FsbHMgYWxsIG1pbmlvbnMgdGhhdCBhcmUgZm9sbG93aW5nIHlvdSB0byB5b3VyIGxvY2F0aW9uLCBhbmQgZ3JhbnRzIHRoZW0gYSB0ZW1wb3JhcnkgbGlmZSByZWdlbmVyYXRpb24gZWZmZWN0LiIsImV4cGxpY2l0TW9kcyI6WyJCYXNlIGR1cmF0aW9uIGlzIDIuMDAgc2Vjb25kcyIsIlJlZ2VuZXJhdGUgMS42NSUgb2YgTGlmZSBwZXIgc2Vjb25kIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0Ijo1LCJjb2xvdXIiOiJJIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQmVsdHNcL0Jpc2Nvc0xlYXNoLnBuZz9zY2FsZT0xJnc9MiZoPTEmdj03NTMyOWU1ZjI4NTVkNTViZTQ1OTNhMzBhYWVhZDlmZCIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiJhODA5OGM5MGE5YjMwY2I1MzQ4Mzc2YmIyMjc1ZWJlZGJmYjRjMjMwYzA2ODlmMjg4NzA3ZTMwYTQzN2UzMzNlIiwibmFtZSI6IkJpc2NvJ3MgTGVhc2giLCJ0eXBlTGluZSI6IkhlYXZ5IEJlbHQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo4NCwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjMwIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImltcGxpY2l0TW9kcyI6WyIrMjcgdG8gU3RyZW5ndGgiXSwiZXhwbGljaXRNb2RzIjpbIjUlIGluY3JlYXNlZCBRdWFudGl0eSBvZiBJdGVtcyBmb3VuZCIsIisyOSUgdG8gQ29sZCBSZXNpc3RhbmNlIiwiMSUgaW5jcmVhc2VkIFJhcml0eSBvZiBJdGVtcyBmb3VuZCBwZXIgMTUgUmFtcGFnZSBLaWxscyIsIlJhbXBhZ2UiXSwiZmxhdm91clRleHQiOlsiWW91IHdlcmUgYWx3YXlzIHRoZXJlLCBieSBteSBzaWRlLFxyIiwid2hldGhlciB3ZSBzYXQgb3IgcGxheWVkLlxyIiwiT3VyIGZyaWVuZHNoaXAgd2FzIGEgam95ZnVsIHJpZGUsXHIiLCJJIHdpc2ggeW91IGNvdWxkIGhhdmUgc3RheWVkLiJdLCJmcmFtZVR5cGUiOjMsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiQmVsdCJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoyLCJoIjoyLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9XZWFwb25zXC9PbmVIYW5kV2VhcG9uc1wvQ2xhd3NcL0NsYXdzaGllbGQzLnBuZz9zY2FsZT0xJnc9MiZoPTImdj02YmNiMTA2MzFjNTFjNGFlYWYxMTM0NWJiNjA0MWVkMSIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiI0MDUxZWI2ZTQ2NDEwNWYwMTkyNTEyOGY4N2YxM2NmZWYyMDNmYWRmNTZjODI1M2ZjNjE2OTE1NTE0YTVjZDA4Iiwic29ja2V0cyI6W3siZ3JvdXAiOjAsImF0dHIiOiJTIiwic0NvbG91ciI6IlIifSx7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MSwiYXR0ciI6IkQiLCJzQ29sb3VyIjoiRyJ9XSwibmFtZSI6IkFkdmFuY2luZyBGb3J0cmVzcyIsInR5cGVMaW5lIjoiR3V0IFJpcHBlciIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjU1LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQ2xhdyIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJRdWFsaXR5IiwidmFsdWVzIjpbWyIrMjAlIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo2fSx7Im5hbWUiOiJQaHlzaWNhbCBEYW1hZ2UiLCJ2YWx1ZXMiOltbIjQ0LTExNyIsMV1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6OX0seyJuYW1lIjoiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsInZhbHVlcyI6W1siNi4zMCUiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjEyfSx7Im5hbWUiOiJBdHRhY2tzIHBlciBTZWNvbmQiLCJ2YWx1ZXMiOltbIjEuNTAiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjEzfSx7Im5hbWUiOiJXZWFwb24gUmFuZ2UiLCJ2YWx1ZXMiOltbIjExIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxNH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siOTgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTUxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sImltcGxpY2l0TW9kcyI6WyIrNDQgTGlmZSBnYWluZWQgZm9yIGVhY2ggRW5lbXkgaGl0IGJ5IEF0dGFja3MiXSwiZXhwbGljaXRNb2RzIjpbIlNvY2tldGVkIEdlbXMgYXJlIFN1cHBvcnRlZCBieSBMZXZlbCAxMiBGb3J0aWZ5IiwiMTUlIENoYW5jZSB0byBCbG9jayBBdHRhY2sgRGFtYWdlIiwiMTAxJSBpbmNyZWFzZWQgUGh5c2ljYWwgRGFtYWdlIiwiKzExMCB0byBFdmFzaW9uIFJhdGluZyIsIiszNSB0byBtYXhpbXVtIEVuZXJneSBTaGllbGQiLCIrNDYgdG8gbWF4aW11bSBMaWZlIiwiUmVmbGVjdHMgODIgUGh5c2ljYWwgRGFtYWdlIHRvIE1lbGVlIEF0dGFja2VycyJdLCJmbGF2b3VyVGV4dCI6WyJcIkEgbWFuIGNvd2VycyBiZWhpbmQgaGlzIHdhbGxzLlxyIiwiQSB3b21hbiBjYXJyaWVzIGhlciBmb3J0cmVzcyB3aXRoIGhlci5cciIsIkluIGhlYXJ0LCBpbiBtaW5kLCBpbiBoYW5kLlwiXHIiLCItIFNla2hlbWEgRGVzaHJldCJdLCJmcmFtZVR5cGUiOjMsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiV2VhcG9uIiwic29ja2V0ZWRJdGVtcyI6W3sidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9TdXBwb3J0XC9DYXN0T25EbWdUYWtlbi5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9ZTAzY2ViMWEzMWU0NTdkYmZlMzBlOTY4Yzc2ZmIwZDYiLCJzdXBwb3J0Ijp0cnVlLCJpZCI6Ijg1NjVlZTk0Y2FkNTFjNGE3OTQ2NzZjZGE1ZmJiYmYzNmU5N2U3NTViNDZhMTliN2IwOGNlODAxMWM4ZTg2YzUiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJDYXN0IHdoZW4gRGFtYWdlIFRha2VuIFN1cHBvcnQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3VwcG9ydCwgU3BlbGwsIFRyaWdnZXIiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJDb29sZG93biBUaW1lIiwidmFsdWVzIjpbWyIwLjI1IHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siNDgiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiRWFjaCBzdXBwb3J0ZWQgc3BlbGwgc2tpbGwgd2lsbCB0cmFjayBkYW1hZ2UgeW91IHRha2UsIGFuZCBiZSB0cmlnZ2VyZWQgd2hlbiB0aGUgdG90YWwgZGFtYWdlIHRha2VuIHJlYWNoZXMgYSB0aHJlc2hvbGQuIENhbm5vdCBzdXBwb3J0IHNraWxscyB1c2VkIGJ5IHRvdGVtcywgdHJhcHMsIG9yIG1pbmVzLiBWYWFsIHNraWxscywgY2hhbm5lbGxpbmcgc2tpbGxzLCBhbmQgc2tpbGxzIHRoYXQgcmVzZXJ2ZSBtYW5hIGNhbm5vdCBiZSB0cmlnZ2VyZWQuIiwiZXhwbGljaXRNb2RzIjpbIlRoaXMgR2VtIGNhbiBvbmx5IFN1cHBvcnQgU2tpbGwgR2VtcyByZXF1aXJpbmcgTGV2ZWwgNzAgb3IgbG93ZXIiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgNiUgbW9yZSBEYW1hZ2UiLCJZb3UgY2Fubm90IENhc3QgU3VwcG9ydGVkIFRyaWdnZXJhYmxlIFNwZWxscyBkaXJlY3RseSIsIlRyaWdnZXIgU3VwcG9ydGVkIFNwZWxscyB3aGVuIHlvdSB0YWtlIGEgdG90YWwgb2YgMzI3MiBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjowLCJjb2xvdXIiOiJTIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0JvbmVPZmZlcmluZy5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NTYxZGRjNmYxNjNhMTc1NjIxOWNmZGU3OGM1MjUzYmIiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiIwYWJlYzA3NTZjYTZmODAyNGQ3NDJiYWI3OTg0NDU1NjdhODBlMGNiNWJjYTA4ODY3YTZiODZlZTQ1NWY3ZTE0IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQm9uZSBPZmZlcmluZyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6Ik1pbmlvbiwgU3BlbGwsIER1cmF0aW9uIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIzMiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIxLjAwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJhZGRpdGlvbmFsUHJvcGVydGllcyI6W3sibmFtZSI6IkV4cGVyaWVuY2UiLCJ2YWx1ZXMiOltbIjE1NzAzOTVcLzIxMjA0NjAxNyIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMDA3NDA1OTE2MjI4ODkwNDE5LCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjgiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTUxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNvbnN1bWVzIGEgY29ycHNlLCBncmFudGluZyBhbGwgb2YgeW91ciBtaW5pb25zIHRoZSBwb3dlciB0byBibG9jayBib3RoIGF0dGFja3MgYW5kIHNwZWxscy4gVGhlIHNraWxsIGNvbnN1bWVzIG90aGVyIG5lYXJieSBjb3Jwc2VzLCBpbmNyZWFzaW5nIHRoZSBkdXJhdGlvbiBmb3IgZWFjaCBjb3Jwc2UgY29uc3VtZWQuIiwiZXhwbGljaXRNb2RzIjpbIk1pbmlvbnMgcmVjb3ZlciA1MjggTGlmZSB3aGVuIHRoZXkgQmxvY2siLCJCYXNlIGR1cmF0aW9uIGlzIDUuMDAgc2Vjb25kcyIsIkFkZGl0aW9uYWwgMS4wMCBzZWNvbmRzIEJhc2UgRHVyYXRpb24gcGVyIGV4dHJhIGNvcnBzZSBDb25zdW1lZCIsIkdyYW50cyBNaW5pb25zICszNCUgQ2hhbmNlIHRvIEJsb2NrIEF0dGFjayBEYW1hZ2UiLCJHcmFudHMgTWluaW9ucyArMzQlIENoYW5jZSB0byBCbG9jayBTcGVsbCBEYW1hZ2UiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjEsImNvbG91ciI6IkkifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvRGVzZWNyYXRlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1kZmMyYTc0NjgwOTk0YzlkOWYwMGI3ODcyYjA3Zjc2YiIsInN1cHBvcnQiOmZhbHNlLCJpZCI6ImFiMTg0YzBhNmRiNTcxMDczMzA4MDk4ZDYxMzFmNTAyZWFmNWJkZjQxMmIyOTVlN2UyNWM1NDcwZGE3MWZhY2UiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJEZXNlY3JhdGUiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjowLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiU3BlbGwsIEFvRSwgRHVyYXRpb24sIENoYW9zIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIyNiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjYwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siOTgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siNjgiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiRGVzZWNyYXRlcyB0aGUgZ3JvdW5kLCBzdW1tb25pbmcgY29ycHNlcyBhbmQgZGVhbGluZyBjaGFvcyBkYW1hZ2UgdG8gYWxsIGVuZW1pZXMgaW4gdGhlIGFyZWEuIFRoZSBjb3Jwc2VzIHdpbGwgYmUgY2hvc2VuIGZyb20gdGhlIG1vbnN0ZXJzIGluIHRoZSBjdXJyZW50IGFyZWEgYW5kIGFueSBTcGVjdHJlcyB0aGF0IGhhdmUgZXhpc3RlZCBpbiB0aGlzIGluc3RhbmNlLiIsImV4cGxpY2l0TW9kcyI6WyJEZWFscyAyOTQgQmFzZSBDaGFvcyBEYW1hZ2UgcGVyIHNlY29uZCIsIkJhc2UgZHVyYXRpb24gaXMgNC4wMCBzZWNvbmRzIiwiU3Bhd25zIDUgY29ycHNlcyIsIlNwYXducyBjb3Jwc2VzIHVwIHRvIExldmVsIDEwMCIsIk1heGltdW0gb2YgMTAgY29ycHNlcyBhbGxvd2VkIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJEIn1dfSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MiwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvZ2VuXC9pbWFnZVwvV3prc05DeDdJbVlpT2lKQmNuUmNMekpFU1hSbGJYTmNMMFpzWVhOcmMxd3ZiV0Z1WVdac1lYTnJNVEJ2YkdRaUxDSnpjQ0k2TUM0Mk1EZzFMQ0pzWlhabGJDSTZNWDFkXC8yYTFhNGJmZDU5XC9JdGVtLnBuZyIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiJkNWFkMjQxZGYyYWJjZDU5YjRiODAwYWFlODVhZDQ4YThlMzlkZTkyNmUzMzE2MmMwNjJkOWNlOTMxOTFlZDQ0IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQnViYmxpbmcgRGl2aW5lIE1hbmEgRmxhc2sgb2YgSGVhdCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjYyLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiUmVjb3ZlcnMgJTAgTWFuYSBvdmVyICUxIFNlY29uZHMiLCJ2YWx1ZXMiOltbIjcwMCIsMV0sWyIyLjEwIiwxXV0sImRpc3BsYXlNb2RlIjozfSx7Im5hbWUiOiJDb25zdW1lcyAlMCBvZiAlMSBDaGFyZ2VzIG9uIHVzZSIsInZhbHVlcyI6W1siOCIsMF0sWyI0MiIsMF1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwidmFsdWVzIjpbWyI0MiIsMF1dLCJkaXNwbGF5TW9kZSI6M31dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjAiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiZXhwbGljaXRNb2RzIjpbIjUwJSByZWR1Y2VkIEFtb3VudCBSZWNvdmVyZWQiLCIxMzUlIGluY3JlYXNlZCBSZWNvdmVyeSByYXRlIiwiNTAlIG9mIFJlY292ZXJ5IGFwcGxpZWQgSW5zdGFudGx5IiwiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIl0sImRlc2NyVGV4dCI6IlJpZ2h0IGNsaWNrIHRvIGRyaW5rLiBDYW4gb25seSBob2xkIGNoYXJnZXMgd2hpbGUgaW4gYmVsdC4gUmVmaWxscyBhcyB5b3Uga2lsbCBtb25zdGVycy4iLCJmcmFtZVR5cGUiOjEsIngiOjMsInkiOjAsImludmVudG9yeUlkIjoiRmxhc2sifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MiwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvZ2VuXC9pbWFnZVwvV3prc05DeDdJbVlpT2lKQmNuUmNMekpFU1hSbGJYTmNMMFpzWVhOcmMxd3ZjMkZ3Y0docGNtVWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZFwvMDlkODNjNjJhZFwvSXRlbS5wbmciLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiMTZkNzY1NGVmNWFkMTYxMGZhZTllM2UyYjk5YmZiNDUyZDc2MTZlOTM2NTVjNmVkMjljZWJmYmNhYWMwMWQzYiIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkV4cGVyaW1lbnRlcidzIFNhcHBoaXJlIEZsYXNrIG9mIEhlYXQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3NCwicHJvcGVydGllcyI6W3sibmFtZSI6Ikxhc3RzICUwIFNlY29uZHMiLCJ2YWx1ZXMiOltbIjUuNjAiLDFdXSwiZGlzcGxheU1vZGUiOjN9LHsibmFtZSI6IkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwidmFsdWVzIjpbWyIzMCIsMF0sWyI2MCIsMF1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwidmFsdWVzIjpbWyI2MCIsMF1dLCJkaXNwbGF5TW9kZSI6M31dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMTgiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwidXRpbGl0eU1vZHMiOlsiKzUwJSB0byBDb2xkIFJlc2lzdGFuY2UiLCIyMCUgbGVzcyBDb2xkIERhbWFnZSB0YWtlbiJdLCJleHBsaWNpdE1vZHMiOlsiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIiwiNDAlIGluY3JlYXNlZCBEdXJhdGlvbiJdLCJkZXNjclRleHQiOiJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwiZnJhbWVUeXBlIjoxLCJ4IjoxLCJ5IjowLCJpbnZlbnRvcnlJZCI6IkZsYXNrIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjMsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL1dlYXBvbnNcL09uZUhhbmRXZWFwb25zXC9EYWdnZXJzXC9EYWdnZXI3LnBuZz9zY2FsZT0xJnc9MSZoPTMmdj0yNTI0ZjUzNThkOWM5ZjQ2ZDQwYzZkZmU2MWMzZjE3ZSIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiJhMmZiNDY3Yzg1NzUyZWI1MWNjMWFjNzUyYjJjODQ2ZTVmNjc3OGU0MzBmYzhmMDE1ZmI5NjdjNDYyNWM3MTc1Iiwic29ja2V0cyI6W3siZ3JvdXAiOjAsImF0dHIiOiJEIiwic0NvbG91ciI6IkcifSx7Imdyb3VwIjowLCJhdHRyIjoiRCIsInNDb2xvdXIiOiJHIn0seyJncm91cCI6MSwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9XSwibmFtZSI6IiIsInR5cGVMaW5lIjoiUm95YWwgU2tlYW4iLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo1MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlJ1bmUgRGFnZ2VyIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlBoeXNpY2FsIERhbWFnZSIsInZhbHVlcyI6W1siMTYtNjQiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjl9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjYuMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxMn0seyJuYW1lIjoiQXR0YWNrcyBwZXIgU2Vjb25kIiwidmFsdWVzIjpbWyIxLjQ1IiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxM30seyJuYW1lIjoiV2VhcG9uIFJhbmdlIiwidmFsdWVzIjpbWyIxMCIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTR9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjY4IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjk1IiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjE1MSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJpbXBsaWNpdE1vZHMiOlsiMzAlIGluY3JlYXNlZCBHbG9iYWwgQ3JpdGljYWwgU3RyaWtlIENoYW5jZSJdLCJmcmFtZVR5cGUiOjAsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiV2VhcG9uMiIsInNvY2tldGVkSXRlbXMiOlt7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvQm9uZUxhbmNlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj01YTA2NTY3NzI2MjNlNDJlNjM1ZjI4MjExZTM4NTAxNyIsInN1cHBvcnQiOmZhbHNlLCJpZCI6ImRhNDNkN2RmZTBjNjUxNjI1Y2I2NjY1YTRkM2FjN2EyZWZkYzgzN2MzYmNiMDRiMWYwMzY5ZTI1MTM4NjMxYzciLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJVbmVhcnRoIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlNwZWxsLCBQcm9qZWN0aWxlLCBBb0UsIFBoeXNpY2FsIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjYwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsInZhbHVlcyI6W1siNi4wMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkVmZmVjdGl2ZW5lc3Mgb2YgQWRkZWQgRGFtYWdlIiwidmFsdWVzIjpbWyIxMjUlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siNzk1ODQ5NzVcLzIxMjA1MTU5OSIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMzc1MzA5NDY3MzE1NjczODMsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyI5NSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyI2NiIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJGaXJlcyBhIHByb2plY3RpbGUgdGhhdCB3aWxsIHBpZXJjZSB0aHJvdWdoIGVuZW1pZXMgdG8gaW1wYWN0IHRoZSBncm91bmQgYXQgdGhlIHRhcmdldGVkIGxvY2F0aW9uLCBjcmVhdGluZyBhIEJvbmUgQXJjaGVyIGNvcnBzZSB3aGVyZSBpdCBsYW5kcy4iLCJleHBsaWNpdE1vZHMiOlsiRGVhbHMgNjI0IHRvIDkzNiBQaHlzaWNhbCBEYW1hZ2UiLCJQcm9qZWN0aWxlcyBQaWVyY2UgYWxsIFRhcmdldHMiLCJTcGF3bnMgY29ycHNlcyB3aXRoIExldmVsIDc3IiwiTWF4aW11bSBvZiAxMCBjb3Jwc2VzIGFsbG93ZWQiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjAsImNvbG91ciI6IkQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvQm9uZUxhbmNlLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj01YTA2NTY3NzI2MjNlNDJlNjM1ZjI4MjExZTM4NTAxNyIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjFhMjUwMWE2MGQ2ZWYwYzNhZTAzZjMyMTFhMDFlNTc5ZWQzMWUzMTY0N2FmMjNhMTUxM2JjMjdlODdlZmU0YmIiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJVbmVhcnRoIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlNwZWxsLCBQcm9qZWN0aWxlLCBBb0UsIFBoeXNpY2FsIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ2FzdCBUaW1lIiwidmFsdWVzIjpbWyIwLjYwIHNlYyIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ3JpdGljYWwgU3RyaWtlIENoYW5jZSIsInZhbHVlcyI6W1siNi4wMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkVmZmVjdGl2ZW5lc3Mgb2YgQWRkZWQgRGFtYWdlIiwidmFsdWVzIjpbWyIxMjUlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siNzk1NzQwOTVcLzIxMjA1MTU5OSIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMzc1MjU4MTc3NTE4ODQ0NiwidHlwZSI6MjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjY4IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJEZXgiLCJ2YWx1ZXMiOltbIjk1IiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjY2IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkZpcmVzIGEgcHJvamVjdGlsZSB0aGF0IHdpbGwgcGllcmNlIHRocm91Z2ggZW5lbWllcyB0byBpbXBhY3QgdGhlIGdyb3VuZCBhdCB0aGUgdGFyZ2V0ZWQgbG9jYXRpb24sIGNyZWF0aW5nIGEgQm9uZSBBcmNoZXIgY29ycHNlIHdoZXJlIGl0IGxhbmRzLiIsImV4cGxpY2l0TW9kcyI6WyJEZWFscyA2MjQgdG8gOTM2IFBoeXNpY2FsIERhbWFnZSIsIlByb2plY3RpbGVzIFBpZXJjZSBhbGwgVGFyZ2V0cyIsIlNwYXducyBjb3Jwc2VzIHdpdGggTGV2ZWwgNzciLCJNYXhpbXVtIG9mIDEwIGNvcnBzZXMgYWxsb3dlZCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MSwiY29sb3VyIjoiRCJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9HZW1zXC9MaWdodG5pbmdSZXNpc3RBdXJhLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1jYTBmZDg5NjExNWI2NGM1ZDIxYjEwNGI1YTg0NjMwYiIsInN1cHBvcnQiOmZhbHNlLCJpZCI6IjMxMWY4NWMxNzJlZmI3MGRjMjQ5Nzk1MTVlY2VmZWY0ZGUzYzY4OWFhNzM0NGQwM2VkYTNjZmNjYjgwMjk1ZDAiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJQdXJpdHkgb2YgTGlnaHRuaW5nIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IkF1cmEsIFNwZWxsLCBBb0UsIExpZ2h0bmluZyIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMTkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgUmVzZXJ2ZWQiLCJ2YWx1ZXMiOltbIjM1JSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siMS4yMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siSW5zdGFudCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJhZGRpdGlvbmFsUHJvcGVydGllcyI6W3sibmFtZSI6IkV4cGVyaWVuY2UiLCJ2YWx1ZXMiOltbIjc5OTc0Njk3XC8yMTE4Nzc2ODMiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjowLjM3NzQ1NjkwMzQ1NzY0MTYsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2OCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxNTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiQ2FzdHMgYW4gYXVyYSB0aGF0IGdyYW50cyBsaWdodG5pbmcgcmVzaXN0YW5jZSB0byB5b3UgYW5kIHlvdXIgYWxsaWVzLiIsImV4cGxpY2l0TW9kcyI6WyIrMTggdG8gcmFkaXVzIiwiWW91IGFuZCBuZWFyYnkgYWxsaWVzIGdhaW4gNDAlIGFkZGl0aW9uYWwgTGlnaHRuaW5nIFJlc2lzdGFuY2UiLCJZb3UgYW5kIG5lYXJieSBhbGxpZXMgZ2FpbiAzJSBhZGRpdGlvbmFsIG1heGltdW0gTGlnaHRuaW5nIFJlc2lzdGFuY2UiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBvZiB0aGUgcmlnaHQgY29sb3VyIHRvIGdhaW4gdGhpcyBza2lsbC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjIsImNvbG91ciI6IkkifV19LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjozLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9XZWFwb25zXC9PbmVIYW5kV2VhcG9uc1wvT25lSGFuZE1hY2VzXC9PbmVIYW5kTWFjZTMucG5nP3NjYWxlPTEmdz0xJmg9MyZ2PTBkYTIxMTUxM2I1M2RjOGFjYzFhMDQwZWUxOGI2MzQ3IiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6IjgxNjZlNWExN2IzYmM1MmY5NzlmNGZmNDI3ZDE2ZGUzNWI1ZTM2NjFkYzExMGMwMzcwMDlmYTg4NWMyZWFhN2MiLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkkiLCJzQ29sb3VyIjoiQiJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJTIiwic0NvbG91ciI6IlIifSx7Imdyb3VwIjoxLCJhdHRyIjoiUyIsInNDb2xvdXIiOiJSIn1dLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJCYXJiZWQgQ2x1YiIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjUwLCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiT25lIEhhbmRlZCBNYWNlIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlBoeXNpY2FsIERhbWFnZSIsInZhbHVlcyI6W1siMzMtNDIiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjl9LHsibmFtZSI6IkNyaXRpY2FsIFN0cmlrZSBDaGFuY2UiLCJ2YWx1ZXMiOltbIjUuMDAlIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxMn0seyJuYW1lIjoiQXR0YWNrcyBwZXIgU2Vjb25kIiwidmFsdWVzIjpbWyIxLjQwIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxM30seyJuYW1lIjoiV2VhcG9uIFJhbmdlIiwidmFsdWVzIjpbWyIxMSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6MTR9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjY5IiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjEyNSIsMF1dLCJkaXNwbGF5TW9kZSI6MX0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxNTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwiaW1wbGljaXRNb2RzIjpbIjEwJSByZWR1Y2VkIEVuZW15IFN0dW4gVGhyZXNob2xkIl0sImZyYW1lVHlwZSI6MCwieCI6MCwieSI6MCwiaW52ZW50b3J5SWQiOiJPZmZoYW5kMiIsInNvY2tldGVkSXRlbXMiOlt7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvTGlnaHRuaW5nUmVzaXN0QXVyYS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9Y2EwZmQ4OTYxMTViNjRjNWQyMWIxMDRiNWE4NDYzMGIiLCJzdXBwb3J0IjpmYWxzZSwiaWQiOiI1MDgxNTViNGE1MWQ0MGYwNjA3NmM5OTc1MDkwYjY3MDA0M2NiZWZmNDk5ZTBlNjczNzc0MTIxYTAzOGU1MTFiIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiUHVyaXR5IG9mIExpZ2h0bmluZyIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJBdXJhLCBTcGVsbCwgQW9FLCBMaWdodG5pbmciLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjE5IiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIFJlc2VydmVkIiwidmFsdWVzIjpbWyIzNSUiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNvb2xkb3duIFRpbWUiLCJ2YWx1ZXMiOltbIjEuMjAgc2VjIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJDYXN0IFRpbWUiLCJ2YWx1ZXMiOltbIkluc3RhbnQiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiYWRkaXRpb25hbFByb3BlcnRpZXMiOlt7Im5hbWUiOiJFeHBlcmllbmNlIiwidmFsdWVzIjpbWyI3OTk3NDY5N1wvMjExODc3NjgzIiwwXV0sImRpc3BsYXlNb2RlIjoyLCJwcm9ncmVzcyI6MC4zNzc0NTY5MDM0NTc2NDE2LCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjgiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkludCIsInZhbHVlcyI6W1siMTUxIiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IkNhc3RzIGFuIGF1cmEgdGhhdCBncmFudHMgbGlnaHRuaW5nIHJlc2lzdGFuY2UgdG8geW91IGFuZCB5b3VyIGFsbGllcy4iLCJleHBsaWNpdE1vZHMiOlsiKzE4IHRvIHJhZGl1cyIsIllvdSBhbmQgbmVhcmJ5IGFsbGllcyBnYWluIDQwJSBhZGRpdGlvbmFsIExpZ2h0bmluZyBSZXNpc3RhbmNlIiwiWW91IGFuZCBuZWFyYnkgYWxsaWVzIGdhaW4gMyUgYWRkaXRpb25hbCBtYXhpbXVtIExpZ2h0bmluZyBSZXNpc3RhbmNlIl0sImRlc2NyVGV4dCI6IlBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgb2YgdGhlIHJpZ2h0IGNvbG91ciB0byBnYWluIHRoaXMgc2tpbGwuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjowLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0JydXRhbGl0eS5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9ZTM5YzNhYmVjMjk5MjMzOGFjYmJlYWMzN2UzMWZhZTciLCJzdXBwb3J0Ijp0cnVlLCJpZCI6IjliOWFjNDFkNzUwZDIzMDU2MWY4NGI5YzMzMDJlMDdiNDI4ODdkNjc2MDhhMmY1ODJkZmEyYmNmOWExN2ZjZTMiLCJuYW1lIjoiIiwidHlwZUxpbmUiOiJCcnV0YWxpdHkgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJQaHlzaWNhbCwgU3VwcG9ydCIsInZhbHVlcyI6W10sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMTkiLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjV9LHsibmFtZSI6Ik1hbmEgTXVsdGlwbGllciIsInZhbHVlcyI6W1siMTMwJSIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJhZGRpdGlvbmFsUHJvcGVydGllcyI6W3sibmFtZSI6IkV4cGVyaWVuY2UiLCJ2YWx1ZXMiOltbIjMwMzM0MjQ1XC8xNTc0MDUwNjMiLDBdXSwiZGlzcGxheU1vZGUiOjIsInByb2dyZXNzIjowLjE5MjcxNDU0MjE1MDQ5NzQ0LCJ0eXBlIjoyMH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNjkiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siMTA5IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1cHBvcnRzIGFueSBza2lsbCB0aGF0IGhpdHMgZW5lbWllcy4iLCJleHBsaWNpdE1vZHMiOlsiU3VwcG9ydGVkIFNraWxscyBkZWFsIDU4JSBtb3JlIFBoeXNpY2FsIERhbWFnZSIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCBubyBDaGFvcyBEYW1hZ2UiLCJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgbm8gRWxlbWVudGFsIERhbWFnZSJdLCJkZXNjclRleHQiOiJUaGlzIGlzIGEgU3VwcG9ydCBHZW0uIEl0IGRvZXMgbm90IGdyYW50IGEgYm9udXMgdG8geW91ciBjaGFyYWN0ZXIsIGJ1dCB0byBza2lsbHMgaW4gc29ja2V0cyBjb25uZWN0ZWQgdG8gaXQuIFBsYWNlIGludG8gYW4gaXRlbSBzb2NrZXQgY29ubmVjdGVkIHRvIGEgc29ja2V0IGNvbnRhaW5pbmcgdGhlIEFjdGl2ZSBTa2lsbCBHZW0geW91IHdpc2ggdG8gYXVnbWVudC4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gYSBzb2NrZXQuIiwiZnJhbWVUeXBlIjo0LCJzb2NrZXQiOjEsImNvbG91ciI6IlMifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvR2Vtc1wvU3VwcG9ydFwvQnJ1dGFsaXR5LnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMzljM2FiZWMyOTkyMzM4YWNiYmVhYzM3ZTMxZmFlNyIsInN1cHBvcnQiOnRydWUsImlkIjoiODcyOTVjMmYxOTgzOWE4MzNjMzBmNjVmODY4NTA4MjkxMTRjMWFmYjA2YjZlMjliNTFlMWVkYjk0YzYzODY0MCIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkJydXRhbGl0eSBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlBoeXNpY2FsLCBTdXBwb3J0IiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIxOSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxMzAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sImFkZGl0aW9uYWxQcm9wZXJ0aWVzIjpbeyJuYW1lIjoiRXhwZXJpZW5jZSIsInZhbHVlcyI6W1siMzAxOTYxNjBcLzE1NzQwNTA2MyIsMF1dLCJkaXNwbGF5TW9kZSI6MiwicHJvZ3Jlc3MiOjAuMTkxODM3Mjk1ODg5ODU0NDMsInR5cGUiOjIwfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2OSIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiU3RyIiwidmFsdWVzIjpbWyIxMDkiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgYW55IHNraWxsIHRoYXQgaGl0cyBlbmVtaWVzLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgNTglIG1vcmUgUGh5c2ljYWwgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBkZWFsIG5vIENoYW9zIERhbWFnZSIsIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCBubyBFbGVtZW50YWwgRGFtYWdlIl0sImRlc2NyVGV4dCI6IlRoaXMgaXMgYSBTdXBwb3J0IEdlbS4gSXQgZG9lcyBub3QgZ3JhbnQgYSBib251cyB0byB5b3VyIGNoYXJhY3RlciwgYnV0IHRvIHNraWxscyBpbiBzb2NrZXRzIGNvbm5lY3RlZCB0byBpdC4gUGxhY2UgaW50byBhbiBpdGVtIHNvY2tldCBjb25uZWN0ZWQgdG8gYSBzb2NrZXQgY29udGFpbmluZyB0aGUgQWN0aXZlIFNraWxsIEdlbSB5b3Ugd2lzaCB0byBhdWdtZW50LiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MiwiY29sb3VyIjoiUyJ9XX0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL1JpbmdzXC9Ub3BhelJ1YnkucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTBiZDNjNzE5N2I5NTI5ODFlYjIxY2IwZGMyNzhjMDRkIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6IjgxOWZiN2NlN2UxY2IwYzM5OTkxMWQwYTczMWVkZTFiNmIwNmZjYThmN2IyYTNiOWZiZDkyMzA2N2NiYTU3YzYiLCJuYW1lIjoiRm9lIEtudWNrbGUiLCJ0eXBlTGluZSI6IlR3by1TdG9uZSBSaW5nIiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6NzMsInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI0OCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJpbXBsaWNpdE1vZHMiOlsiKzE2JSB0byBGaXJlIGFuZCBMaWdodG5pbmcgUmVzaXN0YW5jZXMiXSwiZXhwbGljaXRNb2RzIjpbIisxMiB0byBTdHJlbmd0aCIsIkFkZHMgMSB0byAyIFBoeXNpY2FsIERhbWFnZSB0byBBdHRhY2tzIiwiKzM5JSB0byBGaXJlIFJlc2lzdGFuY2UiLCIrMzYlIHRvIENvbGQgUmVzaXN0YW5jZSIsIjAuMjMlIG9mIFBoeXNpY2FsIEF0dGFjayBEYW1hZ2UgTGVlY2hlZCBhcyBMaWZlIl0sImNyYWZ0ZWRNb2RzIjpbIisyNCB0byBtYXhpbXVtIExpZmUiXSwiZnJhbWVUeXBlIjoyLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IlJpbmcyIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2dlblwvaW1hZ2VcL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YkdsbVpXWnNZWE5yTVRCdmJHUWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZFwvZTVlN2ZkMDE1YVwvSXRlbS5wbmciLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiNDU3MWVhYzQ2ODU5MTRiMDdlZGYwNTJkNGQ1ZDNiNzIzODExYjE4YjRmN2JjYzUyNDUxMzdjZjhlOTU5Zjc4MSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IlNlZXRoaW5nIERpdmluZSBMaWZlIEZsYXNrIG9mIERvdXNpbmciLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2MywicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6IlJlY292ZXJzICUwIExpZmUgb3ZlciAlMSBTZWNvbmRzIiwidmFsdWVzIjpbWyI5NzkiLDFdLFsiNy4wMCIsMF1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ29uc3VtZXMgJTAgb2YgJTEgQ2hhcmdlcyBvbiB1c2UiLCJ2YWx1ZXMiOltbIjE1IiwwXSxbIjQ1IiwwXV0sImRpc3BsYXlNb2RlIjozfSx7Im5hbWUiOiJDdXJyZW50bHkgaGFzICUwIENoYXJnZXMiLCJ2YWx1ZXMiOltbIjQ1IiwwXV0sImRpc3BsYXlNb2RlIjozfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI2MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJleHBsaWNpdE1vZHMiOlsiNjYlIHJlZHVjZWQgQW1vdW50IFJlY292ZXJlZCIsIkluc3RhbnQgUmVjb3ZlcnkiLCJJbW11bml0eSB0byBJZ25pdGUgZHVyaW5nIEZsYXNrIGVmZmVjdFxuUmVtb3ZlcyBCdXJuaW5nIG9uIHVzZSJdLCJkZXNjclRleHQiOiJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwiZnJhbWVUeXBlIjoxLCJ4IjowLCJ5IjowLCJpbnZlbnRvcnlJZCI6IkZsYXNrIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2dlblwvaW1hZ2VcL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YzNSdmJtVWlMQ0p6Y0NJNk1DNDJNRGcxTENKc1pYWmxiQ0k2TVgxZFwvYTQ4ZWJkY2RlZFwvSXRlbS5wbmciLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiODliZGJhODk0ZDVkZThkMDcxYWNiMTM0NTE2ZTM0OTMzNmYyOWIwMTMwZmZiMWVmMzhiNDIzM2UzZTJmNDc0OCIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkV4cGVyaW1lbnRlcidzIEJhc2FsdCBGbGFzayBvZiBIZWF0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6NjgsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJRdWFsaXR5IiwidmFsdWVzIjpbWyIrMTIlIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo2fSx7Im5hbWUiOiJMYXN0cyAlMCBTZWNvbmRzIiwidmFsdWVzIjpbWyI2LjYwIiwxXV0sImRpc3BsYXlNb2RlIjozfSx7Im5hbWUiOiJDb25zdW1lcyAlMCBvZiAlMSBDaGFyZ2VzIG9uIHVzZSIsInZhbHVlcyI6W1siNDAiLDBdLFsiNjAiLDBdXSwiZGlzcGxheU1vZGUiOjN9LHsibmFtZSI6IkN1cnJlbnRseSBoYXMgJTAgQ2hhcmdlcyIsInZhbHVlcyI6W1siNjAiLDBdXSwiZGlzcGxheU1vZGUiOjN9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjQwIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInV0aWxpdHlNb2RzIjpbIjE1JSBhZGRpdGlvbmFsIFBoeXNpY2FsIERhbWFnZSBSZWR1Y3Rpb24iLCIyMCUgb2YgTWVsZWUgUGh5c2ljYWwgRGFtYWdlIHRha2VuIHJlZmxlY3RlZCB0byBBdHRhY2tlciJdLCJleHBsaWNpdE1vZHMiOlsiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIiwiMzQlIGluY3JlYXNlZCBEdXJhdGlvbiJdLCJkZXNjclRleHQiOiJSaWdodCBjbGljayB0byBkcmluay4gQ2FuIG9ubHkgaG9sZCBjaGFyZ2VzIHdoaWxlIGluIGJlbHQuIFJlZmlsbHMgYXMgeW91IGtpbGwgbW9uc3RlcnMuIiwiZnJhbWVUeXBlIjoxLCJ4Ijo0LCJ5IjowLCJpbnZlbnRvcnlJZCI6IkZsYXNrIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjIsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2dlblwvaW1hZ2VcL1d6a3NOQ3g3SW1ZaU9pSkJjblJjTHpKRVNYUmxiWE5jTDBac1lYTnJjMXd2YzJsc2RtVnlJaXdpYzNBaU9qQXVOakE0TlN3aWJHVjJaV3dpT2pGOVhRXC9iNGVkY2JhODBmXC9JdGVtLnBuZyIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiIzZGQwMGQ0YzVjNGYyM2MyY2UyZWM0YTI1ZjcyMjhiZTM4MmJkNWJhM2VmMDVmODc1NjI5MWUwNjk2MDc1MzIwIiwibmFtZSI6IiIsInR5cGVMaW5lIjoiQW1wbGUgU2lsdmVyIEZsYXNrIG9mIEhlYXQiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo2NSwicHJvcGVydGllcyI6W3sibmFtZSI6IlF1YWxpdHkiLCJ2YWx1ZXMiOltbIisyMCUiLDFdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjZ9LHsibmFtZSI6Ikxhc3RzICUwIFNlY29uZHMiLCJ2YWx1ZXMiOltbIjYuMDAiLDFdXSwiZGlzcGxheU1vZGUiOjN9LHsibmFtZSI6IkNvbnN1bWVzICUwIG9mICUxIENoYXJnZXMgb24gdXNlIiwidmFsdWVzIjpbWyI0MCIsMF0sWyI4MCIsMV1dLCJkaXNwbGF5TW9kZSI6M30seyJuYW1lIjoiQ3VycmVudGx5IGhhcyAlMCBDaGFyZ2VzIiwidmFsdWVzIjpbWyI4MCIsMF1dLCJkaXNwbGF5TW9kZSI6M31dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjIiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwidXRpbGl0eU1vZHMiOlsiT25zbGF1Z2h0Il0sImV4cGxpY2l0TW9kcyI6WyIrMjAgdG8gTWF4aW11bSBDaGFyZ2VzIiwiSW1tdW5pdHkgdG8gRnJlZXplIGFuZCBDaGlsbCBkdXJpbmcgRmxhc2sgZWZmZWN0XG5SZW1vdmVzIEZyZWV6ZSBhbmQgQ2hpbGwgb24gdXNlIl0sImRlc2NyVGV4dCI6IlJpZ2h0IGNsaWNrIHRvIGRyaW5rLiBDYW4gb25seSBob2xkIGNoYXJnZXMgd2hpbGUgaW4gYmVsdC4gUmVmaWxscyBhcyB5b3Uga2lsbCBtb25zdGVycy4iLCJmcmFtZVR5cGUiOjEsIngiOjIsInkiOjAsImludmVudG9yeUlkIjoiRmxhc2sifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQW11bGV0c1wvQW11bGV0NC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9Y2MzZWMxNjdjYTllYTEwZGZhMDViMmQ1NWI1ZGNmNjIiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiN2NhYjI2ODBkNjU1ZDZkZjBlYWM3MDhkZDZlM2IzMTgwNjZjMTcwNTI5M2IxYzQ0ODEwYTU4NDA2ZDNjNzU0MCIsIm5hbWUiOiJEYW1uYXRpb24gTWVkYWxsaW9uIiwidHlwZUxpbmUiOiJKYWRlIEFtdWxldCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjcwLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMzkiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiaW1wbGljaXRNb2RzIjpbIisyNSB0byBEZXh0ZXJpdHkiXSwiZXhwbGljaXRNb2RzIjpbIkFkZHMgMSB0byAyOCBMaWdodG5pbmcgRGFtYWdlIHRvIEF0dGFja3MiLCIzJSBpbmNyZWFzZWQgbWF4aW11bSBFbmVyZ3kgU2hpZWxkIiwiKzYlIHRvIGFsbCBFbGVtZW50YWwgUmVzaXN0YW5jZXMiLCIrMzUlIHRvIExpZ2h0bmluZyBSZXNpc3RhbmNlIiwiKzMgTWFuYSBnYWluZWQgb24gS2lsbCJdLCJjcmFmdGVkTW9kcyI6WyIrMzAgdG8gbWF4aW11bSBMaWZlIl0sImZyYW1lVHlwZSI6MiwieCI6MCwieSI6MCwiaW52ZW50b3J5SWQiOiJBbXVsZXQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MiwiaCI6MiwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvQXJtb3Vyc1wvQm9vdHNcL2Jvb3R1bmlxdWUucG5nP3NjYWxlPTEmdz0yJmg9MiZ2PTViNTgxYzJjYjdkNzdkMDYzYjM2ZTA4ZjU5YWRlYTdlIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImExZDY4MWQ0YTMxZTIzNDBlZjg5ZDU2ZGE5ODk1M2QxNzQwYzFmYWUyZDQ1ZmNmMjUwM2E5MDNlYTIxNjVjNzMiLCJzb2NrZXRzIjpbeyJncm91cCI6MCwiYXR0ciI6IkQiLCJzQ29sb3VyIjoiRyJ9LHsiZ3JvdXAiOjAsImF0dHIiOiJJIiwic0NvbG91ciI6IkIifSx7Imdyb3VwIjowLCJhdHRyIjoiSSIsInNDb2xvdXIiOiJCIn0seyJncm91cCI6MCwiYXR0ciI6IlMiLCJzQ29sb3VyIjoiUiJ9XSwibmFtZSI6IkJvbmVzIG9mIFVsbHIiLCJ0eXBlTGluZSI6IlNpbGsgU2xpcHBlcnMiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjozMywicHJvcGVydGllcyI6W3sibmFtZSI6IkVuZXJneSBTaGllbGQiLCJ2YWx1ZXMiOltbIjIyIiwxXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjoxOH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siNzAiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IlN0ciIsInZhbHVlcyI6W1siOTgiLDBdXSwiZGlzcGxheU1vZGUiOjF9LHsibmFtZSI6IkRleCIsInZhbHVlcyI6W1siMTExIiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJleHBsaWNpdE1vZHMiOlsiNDklIGluY3JlYXNlZCBFbmVyZ3kgU2hpZWxkIiwiKzIwIHRvIG1heGltdW0gTGlmZSIsIisyMCB0byBtYXhpbXVtIE1hbmEiLCIrMSB0byBMZXZlbCBvZiBhbGwgUmFpc2UgWm9tYmllIEdlbXMiLCIrMSB0byBMZXZlbCBvZiBhbGwgUmFpc2UgU3BlY3RyZSBHZW1zIiwiNSUgaW5jcmVhc2VkIE1vdmVtZW50IFNwZWVkIl0sImVuY2hhbnRNb2RzIjpbIkFkZHMgMTYgdG8gMjQgQ29sZCBEYW1hZ2UgaWYgeW91J3ZlIGJlZW4gSGl0IFJlY2VudGx5Il0sImZsYXZvdXJUZXh0IjpbIlRoZSBkZWFkIG1hbiB3YWxrcyB3aGVyZVxyIiwidGhlIGxpdmluZyBmZWFyIHRvIHRyZWFkLiJdLCJmcmFtZVR5cGUiOjMsIngiOjAsInkiOjAsImludmVudG9yeUlkIjoiQm9vdHMiLCJzb2NrZXRlZEl0ZW1zIjpbeyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0dyZWF0ZXJNdWx0aXBsZVByb2plY3RpbGVzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj1lMWI2ZmIyNmU1OTM3OTU3ZTA4Y2U4MGUxMTNmNDFlMiIsInN1cHBvcnQiOnRydWUsImlkIjoiMmY1NjU1YTJjMjczN2M4ZDY3OTMwZDM2YmY4OWI0OTRmOTk0ZTk3NTViN2NiOTI0NmY0ZWE0MzcxY2E5OTYzMSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkdyZWF0ZXIgTXVsdGlwbGUgUHJvamVjdGlsZXMgU3VwcG9ydCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsInByb3BlcnRpZXMiOlt7Im5hbWUiOiJTdXBwb3J0LCBQcm9qZWN0aWxlIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxNjUlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiRGV4IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgcHJvamVjdGlsZSBza2lsbHMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCAyNiUgbGVzcyBQcm9qZWN0aWxlIERhbWFnZSIsIlN1cHBvcnRlZCBTa2lsbHMgZmlyZSA0IGFkZGl0aW9uYWwgUHJvamVjdGlsZXMiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjowLCJjb2xvdXIiOiJEIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0VsZW1lbnRhbEZvY3VzLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj05MGMwNWVkYTY3ZjExYTQzYjk1ZDIyZDdkNzcwZDExNSIsInN1cHBvcnQiOnRydWUsImlkIjoiZjE5ZmQ4NTBhZjkxMDA5M2ZmZmUyYTcxMzE0YWJkNmMyMjBkZjJiMGQwNDdiZWIzZGRjYTY0ZmE5MTZjMTU2OSIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IkVsZW1lbnRhbCBGb2N1cyBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlN1cHBvcnQiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjIwIChNYXgpIiwwXV0sImRpc3BsYXlNb2RlIjowLCJ0eXBlIjo1fSx7Im5hbWUiOiJNYW5hIE11bHRpcGxpZXIiLCJ2YWx1ZXMiOltbIjEzMCUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjExMSIsMF1dLCJkaXNwbGF5TW9kZSI6MX1dLCJzZWNEZXNjclRleHQiOiJTdXBwb3J0cyBhbnkgc2tpbGwgdGhhdCBoaXRzIG9yIGJ1cm5zIGVuZW1pZXMuIiwiZXhwbGljaXRNb2RzIjpbIlN1cHBvcnRlZCBTa2lsbHMgZGVhbCA0OSUgbW9yZSBFbGVtZW50YWwgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBjYW5ub3QgaW5mbGljdCBFbGVtZW50YWwgQWlsbWVudHMiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoxLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL1N1cHBvcnRcL0VjaG8ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTE3MjY1ZmIxMjI2M2ZjYTM0MWRkYzQ0M2EyNDJkMzA2Iiwic3VwcG9ydCI6dHJ1ZSwiaWQiOiI2NTRlNTFkMDliNjYwY2QyYmI5OWZiZTQxZWFiZjI5NmRmMTc3MTJlOWJmMmI2ODRkNjc0NDZlOTFhMzExNzM0IiwibmFtZSI6IiIsInR5cGVMaW5lIjoiU3BlbGwgRWNobyBTdXBwb3J0IiwiaWRlbnRpZmllZCI6dHJ1ZSwiaWx2bCI6MCwicHJvcGVydGllcyI6W3sibmFtZSI6IlNwZWxsLCBTdXBwb3J0IiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBNdWx0aXBsaWVyIiwidmFsdWVzIjpbWyIxNDAlIiwwXV0sImRpc3BsYXlNb2RlIjowfV0sInJlcXVpcmVtZW50cyI6W3sibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyI3MCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiSW50IiwidmFsdWVzIjpbWyIxMTEiLDBdXSwiZGlzcGxheU1vZGUiOjF9XSwic2VjRGVzY3JUZXh0IjoiU3VwcG9ydHMgc3BlbGwgc2tpbGxzLCBtYWtpbmcgdGhlbSByZXBlYXQgd2hlbiBjYXN0LiBDYW5ub3Qgc3VwcG9ydCBWYWFsIHNraWxscywgdG90ZW0gc2tpbGxzLCBjaGFubmVsbGluZyBza2lsbHMsIHRyaWdnZXJlZCBza2lsbHMsIGluc3RhbnQgc2tpbGxzLCBvciBza2lsbHMgd2hpY2ggcmVzZXJ2ZSBtYW5hLiIsImV4cGxpY2l0TW9kcyI6WyJTdXBwb3J0ZWQgU2tpbGxzIGRlYWwgMTAlIGxlc3MgRGFtYWdlIiwiU3VwcG9ydGVkIFNraWxscyBSZXBlYXQgYW4gYWRkaXRpb25hbCB0aW1lIiwiU3VwcG9ydGVkIFNraWxscyBoYXZlIDcwJSBtb3JlIENhc3QgU3BlZWQiXSwiZGVzY3JUZXh0IjoiVGhpcyBpcyBhIFN1cHBvcnQgR2VtLiBJdCBkb2VzIG5vdCBncmFudCBhIGJvbnVzIHRvIHlvdXIgY2hhcmFjdGVyLCBidXQgdG8gc2tpbGxzIGluIHNvY2tldHMgY29ubmVjdGVkIHRvIGl0LiBQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IGNvbm5lY3RlZCB0byBhIHNvY2tldCBjb250YWluaW5nIHRoZSBBY3RpdmUgU2tpbGwgR2VtIHlvdSB3aXNoIHRvIGF1Z21lbnQuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIGEgc29ja2V0LiIsImZyYW1lVHlwZSI6NCwic29ja2V0IjoyLCJjb2xvdXIiOiJJIn0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0dlbXNcL0ZpcmVFbGVtZW50YWxTdW1tb24ucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTVhOTU3ODRjYzRlYjYwNjdjMTVlMWE4YmNhNjAyZjg3Iiwic3VwcG9ydCI6ZmFsc2UsImlkIjoiOTVjNTBmM2VhYTQ1YmZkMjNkZDQyNDk5Y2MxZmY2NTdmNzg3NTM3MGVjYjkwYWU1MTA2NzZlZDc2NzYwODE5ZiIsIm5hbWUiOiIiLCJ0eXBlTGluZSI6IlN1bW1vbiBGbGFtZSBHb2xlbSIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjAsImNvcnJ1cHRlZCI6dHJ1ZSwicHJvcGVydGllcyI6W3sibmFtZSI6IkZpcmUsIE1pbmlvbiwgU3BlbGwsIEdvbGVtIiwidmFsdWVzIjpbXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkxldmVsIiwidmFsdWVzIjpbWyIyMCAoTWF4KSIsMF1dLCJkaXNwbGF5TW9kZSI6MCwidHlwZSI6NX0seyJuYW1lIjoiTWFuYSBDb3N0IiwidmFsdWVzIjpbWyI1NCIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiQ29vbGRvd24gVGltZSIsInZhbHVlcyI6W1siNi4wMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9LHsibmFtZSI6IkNhc3QgVGltZSIsInZhbHVlcyI6W1siMS4wMCBzZWMiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwicmVxdWlyZW1lbnRzIjpbeyJuYW1lIjoiTGV2ZWwiLCJ2YWx1ZXMiOltbIjcwIiwwXV0sImRpc3BsYXlNb2RlIjowfSx7Im5hbWUiOiJTdHIiLCJ2YWx1ZXMiOltbIjk4IiwwXV0sImRpc3BsYXlNb2RlIjoxfSx7Im5hbWUiOiJJbnQiLCJ2YWx1ZXMiOltbIjY4IiwwXV0sImRpc3BsYXlNb2RlIjoxfV0sInNlY0Rlc2NyVGV4dCI6IlN1bW1vbnMgYSBGbGFtZSBHb2xlbSB0aGF0IGdyYW50cyB5b3UgaW5jcmVhc2VkIERhbWFnZS4gVGhlIEZsYW1lIEdvbGVtIGNhbiB1c2UgYSBmaXJlIHNwcmF5LCBhIHdhdmUgb2YgZmlyZSBkYW1hZ2UsIGFuZCBhbiBleHBsb3NpdmUgYXJjaW5nIHByb2plY3RpbGUuIiwiZXhwbGljaXRNb2RzIjpbIk1heGltdW0gMSBTdW1tb25lZCBHb2xlbSIsIjY4JSBpbmNyZWFzZWQgTWluaW9uIE1heGltdW0gTGlmZSIsIkdvbGVtcyBHcmFudCAyMCUgaW5jcmVhc2VkIERhbWFnZSJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGl0ZW0gc29ja2V0IG9mIHRoZSByaWdodCBjb2xvdXIgdG8gZ2FpbiB0aGlzIHNraWxsLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSBhIHNvY2tldC4iLCJmcmFtZVR5cGUiOjQsInNvY2tldCI6MywiY29sb3VyIjoiUyJ9XX1dLCJjaGFyYWN0ZXIiOnsibmFtZSI6IlNsZWVwZXJTcGVjdHJlQm9pIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJjbGFzc0lkIjozLCJhc2NlbmRhbmN5Q2xhc3MiOjMsImNsYXNzIjoiTmVjcm9tYW5jZXIiLCJsZXZlbCI6OTEsImV4cGVyaWVuY2UiOjIxMjcyOTgxNDN9fQ==\"")
+	packr.PackJSONBytes(".", "golden/sleeper-spectre/get-passive-skills.json", "\"eyJoYXNoZXMiOlsyMzgsMTAzMSwxMjAzLDIyOTIsNDM5Nyw1MTUyLDU0MTUsNjIwNCw2MjMwLDY3MTIsNzM4OCw4OTMwLDEwMDMxLDEwNDkwLDEwODI5LDExNDIwLDExNDk3LDEzMDA5LDEzNzE0LDE0OTM2LDE1MDczLDE1NDA1LDE1ODY4LDE2MTY3LDE2Nzc1LDE3NTQ2LDE3NzM1LDE3ODIxLDE4NDAyLDE5MTQ0LDE5NjM1LDE5OTM5LDIwNTUxLDIxMzMwLDIxNjM0LDIxOTU4LDIyNjI3LDIzMDI3LDI0MzYyLDI0MzgzLDI2NTIzLDI2ODY2LDI3MjAzLDI3NjExLDI3OTI5LDI4MzMwLDI5MzUzLDMxNjgzLDMxNzU4LDMxODc1LDMyMjQ1LDMzNDc5LDMzNjMxLDMzNzQwLDMzNzU1LDM0MDk4LDM0NDgzLDM1NTAzLDM2MDE3LDM2MDQ3LDM2NTQyLDM2NjM0LDM2ODgxLDM2OTQ5LDM3NTY5LDM5MDg1LDM5NjQ4LDM5ODE4LDQxNDcyLDQxOTY3LDQyMDQxLDQyNTgzLDQyNzYwLDQyODM3LDQ0MTg0LDQ0MjAyLDQ0NjA2LDQ2NTc4LDQ2OTEwLDQ3MjUxLDQ4MzYyLDQ4NDIzLDQ4NzE5LDQ5MjU0LDUwNDIyLDUwODYyLDUzMjc5LDU0MTU5LDU1NDg1LDU1NjQ2LDU3MjY0LDU4NDQ5LDYwMzg4LDYwMzk4LDYwNTQ3LDYwNTU0LDYxMTk4LDYxNDE5LDYxNDcxLDYyMDIxLDYzMDQ4LDYzNDQ3LDYzOTc2LDY1MDM0LDY1MTY3XSwiaXRlbXMiOlt7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvSmV3ZWxzXC9HaGFzdGx5RXllLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj0xMTg4MDNmNGFhODcwYjBkNTU2OTU4ZDZhMDYzNGNlZSIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiIzNDUyMThmYjRhMTAwMTViNzUyNTQwZjlkNTRjODc3MzgwNTQzNGMwMWQ4MDYzOGNjNDE4OWJmMzc2NTlmM2Y2IiwiYWJ5c3NKZXdlbCI6dHJ1ZSwibmFtZSI6IkZvdWwgT2N1bHVzIiwidHlwZUxpbmUiOiJHaGFzdGx5IEV5ZSBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjY5LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiQWJ5c3MiLCJ2YWx1ZXMiOltdLCJkaXNwbGF5TW9kZSI6MH1dLCJyZXF1aXJlbWVudHMiOlt7Im5hbWUiOiJMZXZlbCIsInZhbHVlcyI6W1siMjUiLDBdXSwiZGlzcGxheU1vZGUiOjB9XSwiZXhwbGljaXRNb2RzIjpbIisyNyB0byBtYXhpbXVtIExpZmUiLCIrMjUgdG8gbWF4aW11bSBNYW5hIiwiMiUgYWRkaXRpb25hbCBQaHlzaWNhbCBEYW1hZ2UgUmVkdWN0aW9uIGlmIHlvdSB3ZXJlbid0IERhbWFnZWQgYnkgYSBIaXQgUmVjZW50bHkiLCJNaW5pb25zIGhhdmUgNCUgY2hhbmNlIHRvIEJsaW5kIG9uIEhpdCB3aXRoIEF0dGFja3MiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBBYnlzc2FsIFNvY2tldCBvbiBhbiBJdGVtIG9yIGludG8gYW4gYWxsb2NhdGVkIEpld2VsIFNvY2tldCBvbiB0aGUgUGFzc2l2ZSBTa2lsbCBUcmVlLiBSaWdodCBjbGljayB0byByZW1vdmUgZnJvbSB0aGUgU29ja2V0LiIsImZyYW1lVHlwZSI6MiwieCI6MTAsInkiOjAsImludmVudG9yeUlkIjoiUGFzc2l2ZUpld2VscyIsInR5cGUiOiJKZXdlbEFieXNzU3VtbW9uZXIifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvSmV3ZWxzXC9Hb2xlbUluZmVybmFsLnBuZz9zY2FsZT0xJnc9MSZoPTEmdj04OThkM2RiZTY2YjNhZDc5MTVjYmE3MjlkZjkwYTQxMCIsImxlYWd1ZSI6IlN0YW5kYXJkIiwiaWQiOiI1OTI2NmIwOGQ1OTI1MWY1MmRhMmVmNmQ4MWJlMTBmMzFiMTRlNzZlYTE1ODZjOTJkNWZkMjU2MjFjNmQyYTYxIiwibmFtZSI6IlByaW1vcmRpYWwgTWlnaHQiLCJ0eXBlTGluZSI6IkNyaW1zb24gSmV3ZWwiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3MywiZXhwbGljaXRNb2RzIjpbIjMwJSBpbmNyZWFzZWQgRGFtYWdlIGlmIHlvdSBTdW1tb25lZCBhIEdvbGVtIGluIHRoZSBwYXN0IDggc2Vjb25kcyIsIkdvbGVtcyBTdW1tb25lZCBpbiB0aGUgcGFzdCA4IHNlY29uZHMgZGVhbCAzOSUgaW5jcmVhc2VkIERhbWFnZSIsIkdvbGVtcyBoYXZlIDIwJSBpbmNyZWFzZWQgTWF4aW11bSBMaWZlIiwiUHJpbW9yZGlhbCIsIlN1bW1vbmVkIEdvbGVtcyBhcmUgQWdncmVzc2l2ZSJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLCJmbGF2b3VyVGV4dCI6WyJcIkRvIG5vdCBjYXVzZSB0aGUgbGFuZCB0byBzdGlyLFxyIiwiSXQgaG9sZHMgc2VjcmV0cyBiZXR0ZXIgdW5rbm93bi5cIlxyIiwiLSBBem1lcmlhbiBwcm92ZXJiIl0sImZyYW1lVHlwZSI6MywieCI6MTQsInkiOjAsImludmVudG9yeUlkIjoiUGFzc2l2ZUpld2VscyIsInR5cGUiOiJKZXdlbFN0ciJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9KZXdlbHNcL1NwZWN0cmVKZXdlbC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9MjZiOWVhN2NmYTk0ZmQ2MTQwMDdiNTEyNGVjNDQyM2QiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiYmIyYjA1ZDliYmI0MjdiYjA1Y2RlZWVlNWRjZjg5MjFkMGNlNDliZDU5MTk3NDFkNzhhYTQyMWFlYzY3ZjBjNSIsIm5hbWUiOiJVbmVuZGluZyBIdW5nZXIiLCJ0eXBlTGluZSI6IkNvYmFsdCBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjY5LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiTGltaXRlZCB0byIsInZhbHVlcyI6W1siMiIsMF1dLCJkaXNwbGF5TW9kZSI6MH0seyJuYW1lIjoiUmFkaXVzIiwidmFsdWVzIjpbWyJNZWRpdW0iLDBdXSwiZGlzcGxheU1vZGUiOjAsInR5cGUiOjI1fV0sImV4cGxpY2l0TW9kcyI6WyJNaW5pb25zIGhhdmUgNyUgaW5jcmVhc2VkIEFyZWEgb2YgRWZmZWN0IiwiV2l0aCBhdCBsZWFzdCA0MCBJbnRlbGxpZ2VuY2UgaW4gUmFkaXVzLCBSYWlzZWQgU3BlY3RyZXMgaGF2ZSBhIDUwJSBjaGFuY2UgdG8gZ2FpbiBTb3VsIEVhdGVyIGZvciAyMCBzZWNvbmRzIG9uIEtpbGwiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBhbGxvY2F0ZWQgSmV3ZWwgU29ja2V0IG9uIHRoZSBQYXNzaXZlIFNraWxsIFRyZWUuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIHRoZSBTb2NrZXQuIiwiZmxhdm91clRleHQiOlsiVGhleSByb2FtZWQsIHRoZXkgZmVkLCB0aGV5IGdyZXcsXHIiLCJLaWxsaW5nIGFzIHRoZXkgd2VudCxcciIsIkNvbnF1ZXIgdGhvdWdoIHRoZXkgbWF5LFxyIiwiVGhlaXIgaGVhcnRzIHdpbGwgbmV2ZXIgYmUgY29udGVudC4iXSwiZnJhbWVUeXBlIjozLCJ4IjoxLCJ5IjowLCJpbnZlbnRvcnlJZCI6IlBhc3NpdmVKZXdlbHMiLCJ0eXBlIjoiSmV3ZWxJbnQifSx7InZlcmlmaWVkIjpmYWxzZSwidyI6MSwiaCI6MSwiaWNvbiI6Imh0dHBzOlwvXC93ZWIucG9lY2RuLmNvbVwvaW1hZ2VcL0FydFwvMkRJdGVtc1wvSmV3ZWxzXC9Hb2xlbVRlbXBlc3QucG5nP3NjYWxlPTEmdz0xJmg9MSZ2PTQyZTI0NjcxODBkNjNlMDQ3OWY1NjI1NDNiYWE0N2UyIiwibGVhZ3VlIjoiU3RhbmRhcmQiLCJpZCI6ImE5ZWUwNWQ5NjQxNGIwYmIxYWVjNGI0YzlkMWYzZjM1OTNlYzJlNWUwNjZhYzdhOThkMGRlOTM3ZDk5YTZjYjUiLCJuYW1lIjoiUHJpbW9yZGlhbCBIYXJtb255IiwidHlwZUxpbmUiOiJDb2JhbHQgSmV3ZWwiLCJpZGVudGlmaWVkIjp0cnVlLCJpbHZsIjo3NCwiZXhwbGljaXRNb2RzIjpbIkdvbGVtIFNraWxscyBoYXZlIDI5JSBpbmNyZWFzZWQgQ29vbGRvd24gUmVjb3ZlcnkgU3BlZWQiLCJHb2xlbXMgaGF2ZSA0MSUgaW5jcmVhc2VkIENvb2xkb3duIFJlY292ZXJ5IFNwZWVkIiwiMTklIGluY3JlYXNlZCBHb2xlbSBEYW1hZ2UgZm9yIGVhY2ggVHlwZSBvZiBHb2xlbSB5b3UgaGF2ZSBTdW1tb25lZCIsIlN1bW1vbmVkIEdvbGVtcyBSZWdlbmVyYXRlIDIlIG9mIHRoZWlyIExpZmUgcGVyIHNlY29uZCIsIlByaW1vcmRpYWwiXSwiZGVzY3JUZXh0IjoiUGxhY2UgaW50byBhbiBhbGxvY2F0ZWQgSmV3ZWwgU29ja2V0IG9uIHRoZSBQYXNzaXZlIFNraWxsIFRyZWUuIFJpZ2h0IGNsaWNrIHRvIHJlbW92ZSBmcm9tIHRoZSBTb2NrZXQuIiwiZmxhdm91clRleHQiOlsiXCJBIHN0b25lIGlzIG5vdCBhIHN0b25lLlxyIiwiSXQgaXMgYnV0IG9uZSBwYXJ0IG9mIGEgZm9ydHJlc3MuXCJcciIsIi0gRXpvbXl0ZSBwcm92ZXJiIl0sImZyYW1lVHlwZSI6MywieCI6OCwieSI6MCwiaW52ZW50b3J5SWQiOiJQYXNzaXZlSmV3ZWxzIiwidHlwZSI6Ikpld2VsSW50In0seyJ2ZXJpZmllZCI6ZmFsc2UsInciOjEsImgiOjEsImljb24iOiJodHRwczpcL1wvd2ViLnBvZWNkbi5jb21cL2ltYWdlXC9BcnRcLzJESXRlbXNcL0pld2Vsc1wvR29sZW1DaGFvcy5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9OTE1NmFiY2MxYWRkYjg3YWM1ZTQ0YjZkMDdlMDA5NjkiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiN2ExNmRiZWFmOWQ0NTc4OGM5YmUzNjFkM2RmMTk4OTU0Y2VkMWJkYmY3ZDI0MDBhNGY3Zjk4NjlhYWFkYmZmZSIsIm5hbWUiOiJUaGUgQW5pbWEgU3RvbmUiLCJ0eXBlTGluZSI6IlByaXNtYXRpYyBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjY0LCJwcm9wZXJ0aWVzIjpbeyJuYW1lIjoiTGltaXRlZCB0byIsInZhbHVlcyI6W1siMSIsMF1dLCJkaXNwbGF5TW9kZSI6MH1dLCJleHBsaWNpdE1vZHMiOlsiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgR29sZW1zIiwiKzEgdG8gbWF4aW11bSBudW1iZXIgb2YgU3VtbW9uZWQgR29sZW1zIGlmIHlvdSBoYXZlIDMgUHJpbW9yZGlhbCBJdGVtcyBTb2NrZXRlZCBvciBFcXVpcHBlZCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLCJmbGF2b3VyVGV4dCI6WyJBbGwgcmV2ZXJlIHRoZSBlYXJ0aCB0aGV5IHdhbGssXHIiLCJGb3IgaXQgd2llbGRzIHN0cmVuZ3RoIGJleW9uZCBjb21wYXJlLFxyIiwiSG9sZHMga25vd2xlZGdlIG1vcmUgdGhhbiB0aG91Z2h0LFxyIiwiU3RhbmRzIGxvbmcgYWZ0ZXIgYWxsIGVsc2UgZmFsbHMuIl0sImZyYW1lVHlwZSI6MywieCI6MTksInkiOjAsImludmVudG9yeUlkIjoiUGFzc2l2ZUpld2VscyIsInR5cGUiOiJKZXdlbFByaXNtYXRpYyJ9LHsidmVyaWZpZWQiOmZhbHNlLCJ3IjoxLCJoIjoxLCJpY29uIjoiaHR0cHM6XC9cL3dlYi5wb2VjZG4uY29tXC9pbWFnZVwvQXJ0XC8yREl0ZW1zXC9KZXdlbHNcL0dvbGVtVGVtcGVzdC5wbmc/c2NhbGU9MSZ3PTEmaD0xJnY9NDJlMjQ2NzE4MGQ2M2UwNDc5ZjU2MjU0M2JhYTQ3ZTIiLCJsZWFndWUiOiJTdGFuZGFyZCIsImlkIjoiZTVhMWIyNWNjZGViZWJiNGEyMDQ2YWZkMjliZmI4MDlhYzgxZTQ3ZmM5Y2I3ZmM3YTNmYmRlMDVkMDk1OGQwMSIsIm5hbWUiOiJQcmltb3JkaWFsIEhhcm1vbnkiLCJ0eXBlTGluZSI6IkNvYmFsdCBKZXdlbCIsImlkZW50aWZpZWQiOnRydWUsImlsdmwiOjc1LCJleHBsaWNpdE1vZHMiOlsiR29sZW0gU2tpbGxzIGhhdmUgMjUlIGluY3JlYXNlZCBDb29sZG93biBSZWNvdmVyeSBTcGVlZCIsIkdvbGVtcyBoYXZlIDM5JSBpbmNyZWFzZWQgQ29vbGRvd24gUmVjb3ZlcnkgU3BlZWQiLCIyMCUgaW5jcmVhc2VkIEdvbGVtIERhbWFnZSBmb3IgZWFjaCBUeXBlIG9mIEdvbGVtIHlvdSBoYXZlIFN1bW1vbmVkIiwiU3VtbW9uZWQgR29sZW1zIFJlZ2VuZXJhdGUgMiUgb2YgdGhlaXIgTGlmZSBwZXIgc2Vjb25kIiwiUHJpbW9yZGlhbCJdLCJkZXNjclRleHQiOiJQbGFjZSBpbnRvIGFuIGFsbG9jYXRlZCBKZXdlbCBTb2NrZXQgb24gdGhlIFBhc3NpdmUgU2tpbGwgVHJlZS4gUmlnaHQgY2xpY2sgdG8gcmVtb3ZlIGZyb20gdGhlIFNvY2tldC4iLCJmbGF2b3VyVGV4dCI6WyJcIkEgc3RvbmUgaXMgbm90IGEgc3RvbmUuXHIiLCJJdCBpcyBidXQgb25lIHBhcnQgb2YgYSBmb3J0cmVzcy5cIlxyIiwiLSBFem9teXRlIHByb3ZlcmIiXSwiZnJhbWVUeXBlIjozLCJ4Ijo2LCJ5IjowLCJpbnZlbnRvcnlJZCI6IlBhc3NpdmVKZXdlbHMiLCJ0eXBlIjoiSmV3ZWxJbnQifV0sImpld2VsX3Nsb3RzIjpbMjY3MjUsMzY2MzQsMzM5ODksNDEyNjMsNjA3MzUsNjE4MzQsMzE2ODMsMjg0NzUsNjIzMCw0ODc2OCwzNDQ4Myw3OTYwLDQ2ODgyLDU1MTkwLDYxNDE5LDI0OTEsNTQxMjcsMzI3NjMsMjYxOTYsMzM2MzEsMjE5ODRdLCJ2aXN1YWxfb3ZlcnJpZGVzIjpbXX0=\"")
+	packr.PackJSONBytes(".", "golden/starter-gear/entry.json", "\"ewogICJyYW5rIjogMSwKICAiZGVhZCI6IGZhbHNlLAogICJvbmxpbmUiOiBmYWxzZSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiU3RhcnRlclRlbXBsYXIiLAogICAgImxldmVsIjogMywKICAgICJjbGFzcyI6ICJUZW1wbGFyIgogIH0sCiAgImFjY291bnQiOiB7CiAgICAibmFtZSI6ICJnb2xkZW4tYWNjb3VudCIKICB9Cn0K\"")
+	packr.PackJSONBytes(".", "golden/starter-gear/expected/gucci-hobo.json", "\"WwogIHsKICAgICJyZWFzb24iOiAiTm9uVW5pcXVlSXRlbVByZXNlbnQiLAogICAgInJ1bGVJZCI6ICJyYXJpdHkiLAogICAgInNldmVyaXR5IjogInZpb2xhdGlvbiIsCiAgICAiaXRlbU5hbWUiOiAiRHJpZnR3b29kIFNjZXB0cmUiLAogICAgIml0ZW1MZXZlbCI6IDEsCiAgICAiaXRlbVNsb3QiOiAiV2VhcG9uIiwKICAgICJzb2NrZXRJbmRleCI6IDAsCiAgICAiY2hhcmFjdGVyTmFtZSI6ICJTdGFydGVyVGVtcGxhciIsCiAgICAiY2hhcmFjdGVyTGV2ZWwiOiAzLAogICAgImFjY291bnROYW1lIjogImdvbGRlbi1hY2NvdW50IiwKICAgICJ3aGVuIjogIjIwMjAtMDEtMTVUMDQ6NDM6MjdaIiwKICAgICJldmlkZW5jZSI6IHsKICAgICAgImlkIjogInN0YXJ0ZXItd2VhcG9uIiwKICAgICAgInJhcml0eSI6ICJub3JtYWwiLAogICAgICAidHlwZUxpbmUiOiAiRHJpZnR3b29kIFNjZXB0cmUiCiAgICB9CiAgfQpdCg==\"")
+	packr.PackJSONBytes(".", "golden/starter-gear/get-items.json", "\"ewogICJpdGVtcyI6IFsKICAgIHsKICAgICAgImlkIjogInN0YXJ0ZXItd2VhcG9uIiwKICAgICAgImlsdmwiOiAxLAogICAgICAibmFtZSI6ICIiLAogICAgICAidHlwZUxpbmUiOiAiRHJpZnR3b29kIFNjZXB0cmUiLAogICAgICAiZnJhbWVUeXBlIjogMCwKICAgICAgImludmVudG9yeUlkIjogIldlYXBvbiIsCiAgICAgICJleHBsaWNpdE1vZHMiOiBudWxsCiAgICB9CiAgXSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiU3RhcnRlclRlbXBsYXIiLAogICAgImxlYWd1ZSI6ICJTdGFuZGFyZCIsCiAgICAiY2xhc3MiOiAiVGVtcGxhciIsCiAgICAibGV2ZWwiOiAzCiAgfQp9Cg==\"")
+	packr.PackJSONBytes(".", "golden/twilight-strand/entry.json", "\"ewogICJyYW5rIjogMSwKICAiZGVhZCI6IGZhbHNlLAogICJvbmxpbmUiOiBmYWxzZSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiU3RyYW5kVGVtcGxhciIsCiAgICAibGV2ZWwiOiAyLAogICAgImNsYXNzIjogIlRlbXBsYXIiCiAgfSwKICAiYWNjb3VudCI6IHsKICAgICJuYW1lIjogImdvbGRlbi1hY2NvdW50IgogIH0KfQo=\"")
+	packr.PackJSONBytes(".", "golden/twilight-strand/expected/gucci-hobo.json", "\"W10K\"")
+	packr.PackJSONBytes(".", "golden/twilight-strand/get-items.json", "\"ewogICJpdGVtcyI6IFsKICAgIHsKICAgICAgImlkIjogInN0YXJ0ZXItd2VhcG9uIiwKICAgICAgImlsdmwiOiAxLAogICAgICAibmFtZSI6ICIiLAogICAgICAidHlwZUxpbmUiOiAiRHJpZnR3b29kIFNjZXB0cmUiLAogICAgICAiZnJhbWVUeXBlIjogMCwKICAgICAgImludmVudG9yeUlkIjogIldlYXBvbiIsCiAgICAgICJleHBsaWNpdE1vZHMiOiBudWxsCiAgICB9CiAgXSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiU3RyYW5kVGVtcGxhciIsCiAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICJjbGFzcyI6ICJUZW1wbGFyIiwKICAgICJsZXZlbCI6IDIKICB9Cn0K\"")
+	packr.PackJSONBytes(".", "golden/unique-gear/entry.json", "\"ewogICJyYW5rIjogMSwKICAiZGVhZCI6IGZhbHNlLAogICJvbmxpbmUiOiBmYWxzZSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiVW5pcXVlRHVlbGlzdCIsCiAgICAibGV2ZWwiOiA4MCwKICAgICJjbGFzcyI6ICJTbGF5ZXIiCiAgfSwKICAiYWNjb3VudCI6IHsKICAgICJuYW1lIjogImdvbGRlbi1hY2NvdW50IgogIH0KfQo=\"")
+	packr.PackJSONBytes(".", "golden/unique-gear/expected/gucci-hobo.json", "\"W10K\"")
+	packr.PackJSONBytes(".", "golden/unique-gear/get-items.json", "\"ewogICJpdGVtcyI6IFsKICAgIHsKICAgICAgImlkIjogInVuaXF1ZS1oZWxtIiwKICAgICAgImlsdmwiOiA3NSwKICAgICAgIm5hbWUiOiAiR29sZHJpbSIsCiAgICAgICJ0eXBlTGluZSI6ICJMZWF0aGVyIENhcCIsCiAgICAgICJmcmFtZVR5cGUiOiAzLAogICAgICAiaW52ZW50b3J5SWQiOiAiSGVsbSIsCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIiszNCB0byBFdmFzaW9uIFJhdGluZyIKICAgICAgXSwKICAgICAgInNvY2tldGVkSXRlbXMiOiBbCiAgICAgICAgewogICAgICAgICAgImlkIjogImdlbS0xIiwKICAgICAgICAgICJpbHZsIjogMCwKICAgICAgICAgICJuYW1lIjogIiIsCiAgICAgICAgICAidHlwZUxpbmUiOiAiQ2xlYXZlIiwKICAgICAgICAgICJmcmFtZVR5cGUiOiA0LAogICAgICAgICAgImludmVudG9yeUlkIjogIiIsCiAgICAgICAgICAic29ja2V0IjogMCwKICAgICAgICAgICJleHBsaWNpdE1vZHMiOiBudWxsLAogICAgICAgICAgInByb3BlcnRpZXMiOiBbCiAgICAgICAgICAgIHsKICAgICAgICAgICAgICAibmFtZSI6ICJMZXZlbCIsCiAgICAgICAgICAgICAgInZhbHVlcyI6IFsKICAgICAgICAgICAgICAgIFsKICAgICAgICAgICAgICAgICAgIjIwIiwKICAgICAgICAgICAgICAgICAgMAogICAgICAgICAgICAgICAgXQogICAgICAgICAgICAgIF0sCiAgICAgICAgICAgICAgImRpc3BsYXlNb2RlIjogMCwKICAgICAgICAgICAgICAidHlwZSI6IDUKICAgICAgICAgICAgfQogICAgICAgICAgXQogICAgICAgIH0KICAgICAgXQogICAgfSwKICAgIHsKICAgICAgImlkIjogInJlbGljLWJvb3RzIiwKICAgICAgImlsdmwiOiA3MCwKICAgICAgIm5hbWUiOiAiV2FuZGVybHVzdCIsCiAgICAgICJ0eXBlTGluZSI6ICJXb29sIFNob2VzIiwKICAgICAgImZyYW1lVHlwZSI6IDksCiAgICAgICJpbnZlbnRvcnlJZCI6ICJCb290cyIsCiAgICAgICJleHBsaWNpdE1vZHMiOiBbCiAgICAgICAgIjI1JSBpbmNyZWFzZWQgTW92ZW1lbnQgU3BlZWQiCiAgICAgIF0KICAgIH0sCiAgICB7CiAgICAgICJpZCI6ICJtYWdpYy1mbGFzayIsCiAgICAgICJpbHZsIjogNjAsCiAgICAgICJuYW1lIjogIiIsCiAgICAgICJ0eXBlTGluZSI6ICJTZWV0aGluZyBEaXZpbmUgTGlmZSBGbGFzayBvZiBTdGF1bmNoaW5nIiwKICAgICAgImZyYW1lVHlwZSI6IDEsCiAgICAgICJpbnZlbnRvcnlJZCI6ICJGbGFzayIsCiAgICAgICJ4IjogMCwKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiSW1tdW5lIHRvIEJsZWVkaW5nIgogICAgICBdCiAgICB9CiAgXSwKICAiY2hhcmFjdGVyIjogewogICAgIm5hbWUiOiAiVW5pcXVlRHVlbGlzdCIsCiAgICAibGVhZ3VlIjogIlN0YW5kYXJkIiwKICAgICJjbGFzcyI6ICJTbGF5ZXIiLAogICAgImxldmVsIjogODAKICB9Cn0K\"")
+	packr.PackJSONBytes(".", "golden/unique-gear/get-passive-skills.json", "\"ewogICJoYXNoZXMiOiBbXSwKICAiaXRlbXMiOiBbCiAgICB7CiAgICAgICJpZCI6ICJ1bmlxdWUtamV3ZWwiLAogICAgICAiaWx2bCI6IDcwLAogICAgICAibmFtZSI6ICJXYXRjaGVyJ3MgRXllIiwKICAgICAgInR5cGVMaW5lIjogIlByaXNtYXRpYyBKZXdlbCIsCiAgICAgICJmcmFtZVR5cGUiOiAzLAogICAgICAiaW52ZW50b3J5SWQiOiAiUGFzc2l2ZUpld2VscyIsCiAgICAgICJ4IjogMywKICAgICAgImV4cGxpY2l0TW9kcyI6IFsKICAgICAgICAiNSUgaW5jcmVhc2VkIG1heGltdW0gTGlmZSIKICAgICAgXQogICAgfQogIF0KfQo=\"")
 }
@@ -1,6 +1,9 @@
 package fixtures
 
 import (
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/gobuffalo/packr"
@@ -9,10 +12,16 @@ import (
 
 const GetItemsFixture = "get-items.json"
 const GetLadderFixture = "get-ladder.json"
-const EmptyItemsFixture = "lifecycle/get-items.empty.json"
-const FullItemsFixture = "lifecycle/get-items.full.json"
+const GetCharactersFixture = "get-characters.json"
+const EmptyItemsFixture = "golden/fresh-character/get-items.json"
+const FullItemsFixture = "golden/sleeper-spectre/get-items.json"
 const GetPassivesFixture34 = "get-passive-skills.json"
 
+// GoldenDir holds the golden policy corpus, one directory per case.
+//
+// See golden/README.md for the layout of a case.
+const GoldenDir = "golden"
+
 var box = packr.NewBox(".")
 
 // FixtureBytes returns the bytes from a file relative to the fixtures directory
@@ -27,3 +36,33 @@ func FixtureBytes(t testing.TB, fixture string) []byte {
 
 	return blob
 }
+
+// HasFixture returns true when the file exists relative to
+// the fixtures directory
+func HasFixture(fixture string) bool {
+	return box.Has(fixture)
+}
+
+// FixtureDirs returns the names of every directory directly within
+// a directory relative to the fixtures directory, sorted.
+func FixtureDirs(t testing.TB, dir string) []string {
+	found := make(map[string]struct{})
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+	err := box.WalkPrefix(prefix, func(name string, _ packr.File) error {
+		rest := strings.TrimPrefix(filepath.ToSlash(name), prefix)
+		if i := strings.Index(rest, "/"); i > 0 {
+			found[rest[:i]] = struct{}{}
+		}
+		return nil
+	})
+	if t != nil {
+		require.NoError(t, err, "walking fixture directory")
+	}
+
+	dirs := make([]string, 0, len(found))
+	for d := range found {
+		dirs = append(dirs, d)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
@@ -1,6 +1,7 @@
 package items
 
 import (
+	"fmt"
 	"io"
 	"strconv"
 	"strings"
@@ -21,10 +22,71 @@ const (
 	FrameTypeQuestItem  = 7
 	FrameTypeProphecy   = 8
 	FrameTypeRelic      = 9
-
-	inventoryIDFlask = "Flask"
 )
 
+var frameTypeNames = map[int]string{
+	FrameTypeNormal:     "normal",
+	FrameTypeMagic:      "magic",
+	FrameTypeRare:       "rare",
+	FrameTypeUnique:     "unique",
+	FrameTypeGem:        "gem",
+	FrameTypeCurrency:   "currency",
+	FrameTypeDivination: "divination",
+	FrameTypeQuestItem:  "quest",
+	FrameTypeProphecy:   "prophecy",
+	FrameTypeRelic:      "relic",
+}
+
+// FrameTypeName returns the human-readable name of a FrameType, ie unique
+//
+// Unknown FrameTypes are returned as their numeric value.
+func FrameTypeName(frameType int) string {
+	if name, ok := frameTypeNames[frameType]; ok {
+		return name
+	}
+	return strconv.Itoa(frameType)
+}
+
+// ParseFrameType returns the FrameType with the provided name,
+// as returned from FrameTypeName.
+func ParseFrameType(name string) (int, error) {
+	for frameType, n := range frameTypeNames {
+		if n == name {
+			return frameType, nil
+		}
+	}
+	return 0, errors.Errorf("unknown frame type %q", name)
+}
+
+// InventoryIDFlask is the InventoryID of every equipped flask.
+const InventoryIDFlask = "Flask"
+
+// InventoryIDPassiveJewels is the InventoryID of every jewel
+// socketed into the passive tree.
+const InventoryIDPassiveJewels = "PassiveJewels"
+
+// InventoryIDPassiveTree is used as the slot of allocated passive
+// tree nodes; no item has it.
+const InventoryIDPassiveTree = "PassiveTree"
+
+// InventoryIDs are all InventoryIDs an item relevant to policy can have.
+var InventoryIDs = []string{
+	"Weapon",
+	"Weapon2",
+	"Offhand",
+	"Offhand2",
+	"Helm",
+	"BodyArmour",
+	"Gloves",
+	"Boots",
+	"Belt",
+	"Amulet",
+	"Ring",
+	"Ring2",
+	InventoryIDFlask,
+	InventoryIDPassiveJewels,
+}
+
 const (
 	// PolicyFailureReasonItem is set as the reason for a PolicyFailure
 	// when the issue is a non-unique item.
@@ -32,72 +94,240 @@ const (
 	// PolicyFailureReasonPrivateProfile is set as the reason for a PolicyFailure
 	// when the issue is a private profile.
 	PolicyFailureReasonPrivateProfile = "PrivateProfile"
+	// PolicyFailureReasonBannedUnique is set as the reason for a PolicyFailure
+	// when the issue is a unique on a deny list.
+	PolicyFailureReasonBannedUnique = "BannedUnique"
+	// PolicyFailureReasonUniqueNotAllowed is set as the reason for a PolicyFailure
+	// when the issue is a unique missing from an allow list.
+	PolicyFailureReasonUniqueNotAllowed = "UniqueNotAllowed"
+	// PolicyFailureReasonBannedBase is set as the reason for a PolicyFailure
+	// when the issue is a base type on a deny list.
+	PolicyFailureReasonBannedBase = "BannedBase"
+	// PolicyFailureReasonFlask is set as the reason for a PolicyFailure
+	// when the issue is a flask with a disallowed FrameType.
+	PolicyFailureReasonFlask = "FlaskNotAllowed"
+	// PolicyFailureReasonTooManyFlasks is set as the reason for a PolicyFailure
+	// when the issue is more flasks of a FrameType than are allowed.
+	PolicyFailureReasonTooManyFlasks = "TooManyFlasks"
+	// PolicyFailureReasonCraftedFlask is set as the reason for a PolicyFailure
+	// when the issue is a flask with crafted mods.
+	PolicyFailureReasonCraftedFlask = "CraftedFlask"
+	// PolicyFailureReasonGemLevel is set as the reason for a PolicyFailure
+	// when the issue is a gem above the maximum level.
+	PolicyFailureReasonGemLevel = "GemLevelTooHigh"
+	// PolicyFailureReasonGemQuality is set as the reason for a PolicyFailure
+	// when the issue is a gem above the maximum quality.
+	PolicyFailureReasonGemQuality = "GemQualityTooHigh"
+	// PolicyFailureReasonAwakenedGem is set as the reason for a PolicyFailure
+	// when the issue is a disallowed awakened gem.
+	PolicyFailureReasonAwakenedGem = "AwakenedGem"
+	// PolicyFailureReasonVaalGem is set as the reason for a PolicyFailure
+	// when the issue is a disallowed vaal gem.
+	PolicyFailureReasonVaalGem = "VaalGem"
+	// PolicyFailureReasonAlternateQualityGem is set as the reason for a
+	// PolicyFailure when the issue is a disallowed alternate quality gem.
+	PolicyFailureReasonAlternateQualityGem = "AlternateQualityGem"
+	// PolicyFailureReasonBannedGem is set as the reason for a PolicyFailure
+	// when the issue is a gem on a deny list.
+	PolicyFailureReasonBannedGem = "BannedGem"
+	// PolicyFailureReasonClass is set as the reason for a PolicyFailure
+	// when the issue is the class or ascendancy of a Character.
+	PolicyFailureReasonClass = "ClassNotAllowed"
+	// PolicyFailureReasonBannedPassive is set as the reason for a PolicyFailure
+	// when the issue is an allocated passive tree node.
+	PolicyFailureReasonBannedPassive = "BannedPassive"
+	// PolicyFailureReasonPassivePoints is set as the reason for a PolicyFailure
+	// when the issue is too many passive points spent.
+	PolicyFailureReasonPassivePoints = "TooManyPassivePoints"
+	// PolicyFailureReasonAscendancyPoints is set as the reason for a
+	// PolicyFailure when the issue is too many ascendancy points spent.
+	PolicyFailureReasonAscendancyPoints = "TooManyAscendancyPoints"
+	// PolicyFailureReasonUnknownPassives is set as the reason for a
+	// PolicyFailure when allocated passives are not in the passive tree
+	// being checked against, usually as it is the wrong version.
+	PolicyFailureReasonUnknownPassives = "UnknownPassives"
 )
 
+// Severity is how serious a PolicyFailure is.
+type Severity string
+
+const (
+	// SeverityInfo is for failures which are recorded but never acted on.
+	SeverityInfo Severity = "info"
+	// SeverityWarning is for borderline failures, ie low level characters
+	// which have not yet replaced their starter gear.
+	SeverityWarning Severity = "warning"
+	// SeverityViolation is for failures which break the rules of a league.
+	SeverityViolation Severity = "violation"
+)
+
+// Severities are all valid Severities, from least to most serious.
+var Severities = []Severity{SeverityInfo, SeverityWarning, SeverityViolation}
+
+// ParseSeverity returns the Severity with the provided name.
+func ParseSeverity(name string) (Severity, error) {
+	for _, s := range Severities {
+		if string(s) == name {
+			return s, nil
+		}
+	}
+	return "", errors.Errorf("unknown severity %q, expected one of %v",
+		name, Severities)
+}
+
+// RuleIDPrivateProfile is the RuleID of PolicyFailures
+// for private profiles.
+const RuleIDPrivateProfile = "private-profile"
+
 // PolicyFailure are the details we surface when
 // disallowed items are present
 type PolicyFailure struct {
-	Reason string
+	Reason string `json:"reason"`
+	// RuleID identifies the rule which produced the PolicyFailure,
+	// ie rarity
+	RuleID   string   `json:"ruleId"`
+	Severity Severity `json:"severity"`
+
+	ItemName  string `json:"itemName"`
+	ItemLevel int    `json:"itemLevel"`
+	// ItemSlot is the slot of the ItemResp, as returned by ItemResp.Slot,
+	// ie Flask 3
+	ItemSlot string `json:"itemSlot"`
+
+	// ParentItemName and ParentItemSlot describe the item this item
+	// is socketed into. These are empty when the item is not socketed.
+	ParentItemName string `json:"parentItemName,omitempty"`
+	ParentItemSlot string `json:"parentItemSlot,omitempty"`
+	// SocketIndex is the socket of the parent item this item is within.
+	//
+	// This is only meaningful when ParentItemSlot is set.
+	SocketIndex int `json:"socketIndex"`
 
-	ItemName  string
-	ItemLevel int
-	// ItemSlot is the InventoryID in ItemResp, ie Flask
-	ItemSlot string
+	CharacterName  string `json:"characterName"`
+	CharacterLevel int    `json:"characterLevel"`
 
-	CharacterName  string
-	CharacterLevel int
+	AccountName string `json:"accountName"`
 
-	AccountName string
+	When time.Time `json:"when"`
 
-	When time.Time
+	// Evidence is the raw details of the item, if any, this
+	// PolicyFailure is about.
+	Evidence *Evidence `json:"evidence,omitempty"`
 
 	// PoB is a Path of Building code that contains a subset of
 	// the information about the Character.
 	//
 	// This is NOT recorded in the items package. If desired,
 	// This MUST be captured external to this package.
-	PoB string
+	PoB string `json:"pob,omitempty"`
 }
 
 // ToCSVRecord formats the PolicyFailure to be fine
 // for use in a CSV.
-func (f *PolicyFailure) ToCSVRecord() []string {
+func (f *PolicyFailure) ToCSVRecord() ([]string, error) {
+	evidence, err := f.evidenceCSV()
+	if err != nil {
+		return nil, err
+	}
 	return []string{
 		f.Reason,
+		f.RuleID,
+		string(f.Severity),
 		f.ItemName,
 		strconv.Itoa(f.ItemLevel),
 		f.ItemSlot,
+		f.ParentItemName,
+		f.ParentItemSlot,
+		f.socketIndexCSV(),
 		f.CharacterName,
 		strconv.Itoa(f.CharacterLevel),
 		f.AccountName,
 		f.When.Format(time.RFC3339),
+		evidence,
 		f.PoB,
+	}, nil
+}
+
+// evidenceCSV encodes the Evidence as JSON, leaving it empty
+// when there is no Evidence.
+func (f *PolicyFailure) evidenceCSV() (string, error) {
+	if f.Evidence == nil {
+		return "", nil
+	}
+	blob, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(f.Evidence)
+	if err != nil {
+		return "", errors.Wrap(err, "encoding evidence")
 	}
+	return string(blob), nil
 }
 
+// socketIndexCSV leaves the socket index empty for items
+// which are not socketed.
+func (f *PolicyFailure) socketIndexCSV() string {
+	if len(f.ParentItemSlot) == 0 {
+		return ""
+	}
+	return strconv.Itoa(f.SocketIndex)
+}
+
+// ParsePolicyFailureCSV parses a record output by PolicyFailure.ToCSVRecord
 func ParsePolicyFailureCSV(line []string) (PolicyFailure, error) {
-	itemLevel, err := strconv.Atoi(line[2])
+	if len(line) != len(PolicyFailureCSVHeader()) {
+		return PolicyFailure{}, errors.Errorf("expected %d fields, found %d",
+			len(PolicyFailureCSVHeader()), len(line))
+	}
+	var severity Severity
+	var err error
+	if len(line[2]) > 0 {
+		severity, err = ParseSeverity(line[2])
+		if err != nil {
+			return PolicyFailure{}, errors.Wrap(err, "parsing severity")
+		}
+	}
+	itemLevel, err := strconv.Atoi(line[4])
 	if err != nil {
 		return PolicyFailure{}, errors.Wrap(err, "parsing itemLevel")
 	}
-	characterLevel, err := strconv.Atoi(line[5])
+	var socketIndex int
+	if len(line[8]) > 0 {
+		socketIndex, err = strconv.Atoi(line[8])
+		if err != nil {
+			return PolicyFailure{}, errors.Wrap(err, "parsing socketIndex")
+		}
+	}
+	characterLevel, err := strconv.Atoi(line[10])
 	if err != nil {
 		return PolicyFailure{}, errors.Wrap(err, "parsing characterLevel")
 	}
-	when, err := time.Parse(time.RFC3339, line[7])
+	when, err := time.Parse(time.RFC3339, line[12])
 	if err != nil {
 		return PolicyFailure{}, errors.Wrap(err, "parsing when")
 	}
+	var evidence *Evidence
+	if len(line[13]) > 0 {
+		evidence = &Evidence{}
+		err = jsoniter.ConfigCompatibleWithStandardLibrary.UnmarshalFromString(
+			line[13], evidence)
+		if err != nil {
+			return PolicyFailure{}, errors.Wrap(err, "parsing evidence")
+		}
+	}
 	return PolicyFailure{
 		Reason:         line[0],
-		ItemName:       line[1],
-		ItemLevel:      itemLevel, // 2
-		ItemSlot:       line[3],
-		CharacterName:  line[4],
-		CharacterLevel: characterLevel, // 5
-		AccountName:    line[6],
-		When:           when,
-		PoB:            line[8],
+		RuleID:         line[1],
+		Severity:       severity, // 2
+		ItemName:       line[3],
+		ItemLevel:      itemLevel, // 4
+		ItemSlot:       line[5],
+		ParentItemName: line[6],
+		ParentItemSlot: line[7],
+		SocketIndex:    socketIndex, // 8
+		CharacterName:  line[9],
+		CharacterLevel: characterLevel, // 10
+		AccountName:    line[11],
+		When:           when,     // 12
+		Evidence:       evidence, // 13
+		PoB:            line[14],
 	}, nil
 }
 
@@ -108,19 +338,59 @@ func PolicyFailureCSVHeader() []string {
 	// sync with PolicyFailure.ToCSVRecord
 	return []string{
 		"reason",
+		"ruleId",
+		"severity",
 		"itemName",
 		"itemLevel",
 		"itemSlot",
+		"parentItemName",
+		"parentItemSlot",
+		"socketIndex",
 		"characterName",
 		"characterLevel",
 		"accountName",
 		"when",
+		"evidence",
 		"pob",
 	}
 }
 
+// Evidence is the raw details of an item, recorded so disputes can
+// be settled without needing the character or Path of Building.
+type Evidence struct {
+	ItemID string `json:"id,omitempty"`
+	// Rarity is the name of the FrameType, as in FrameTypeName
+	Rarity   string `json:"rarity"`
+	Name     string `json:"name,omitempty"`
+	TypeLine string `json:"typeLine"`
+
+	ImplicitMods []string `json:"implicitMods,omitempty"`
+	EnchantMods  []string `json:"enchantMods,omitempty"`
+	UtilityMods  []string `json:"utilityMods,omitempty"`
+	ExplicitMods []string `json:"explicitMods,omitempty"`
+	CraftedMods  []string `json:"craftedMods,omitempty"`
+}
+
+// Evidence returns the raw details of the item, not including
+// anything socketed into it.
+func (i ItemResp) Evidence() *Evidence {
+	return &Evidence{
+		ItemID:       i.ID,
+		Rarity:       FrameTypeName(i.FrameType),
+		Name:         i.Name,
+		TypeLine:     i.TypeLine,
+		ImplicitMods: i.ImplicitMods,
+		EnchantMods:  i.EnchantMods,
+		UtilityMods:  i.UtilityMods,
+		ExplicitMods: i.ExplicitMods,
+		CraftedMods:  i.CraftedMods,
+	}
+}
+
 // ItemResp is the raw response received from the JSON get-item api
 type ItemResp struct {
+	// ID is unique to the item and stable across trades
+	ID       string `json:"id,omitempty"`
 	Ilvl     int    `json:"ilvl"`
 	Name     string `json:"name"`
 	TypeLine string `json:"typeLine"`
@@ -130,8 +400,14 @@ type ItemResp struct {
 	//
 	// We care about restricting non-flasks
 	InventoryID string `json:"inventoryId"`
+	// AbyssJewel is set for abyss jewels, which may be socketed into
+	// either equipment or the passive tree.
+	AbyssJewel bool `json:"abyssJewel,omitempty"`
 
 	SocketedItems []ItemResp `json:"socketedItems,omitempty"`
+	// Socket is the index of the socket this item is within
+	// when it is one of SocketedItems.
+	Socket int `json:"socket,omitempty"`
 
 	ImplicitMods []string `json:"implicitMods,omitempty"`
 	EnchantMods  []string `json:"enchantMods,omitempty"`
@@ -141,6 +417,113 @@ type ItemResp struct {
 
 	// X is used in pob code output to assign to a flask slot
 	X int32 `json:"x,omitempty"`
+
+	// Properties are the item's display properties, ie gem Level
+	Properties []ItemProperty `json:"properties,omitempty"`
+	// Support is set for support gems
+	Support bool `json:"support,omitempty"`
+}
+
+// ItemProperty is a single display property of an item, ie Quality
+type ItemProperty struct {
+	Name string `json:"name"`
+	// Values are pairs of a display string and how it is displayed,
+	// ie ["+20%", 1]
+	Values      [][]interface{} `json:"values"`
+	DisplayMode int             `json:"displayMode"`
+	Type        int             `json:"type,omitempty"`
+}
+
+// Value returns the first display string of the property,
+// if present.
+func (p *ItemProperty) Value() (string, bool) {
+	if len(p.Values) == 0 || len(p.Values[0]) == 0 {
+		return "", false
+	}
+	v, ok := p.Values[0][0].(string)
+	return v, ok
+}
+
+const (
+	// ItemPropertyLevel is the name of the ItemProperty holding gem level
+	ItemPropertyLevel = "Level"
+	// ItemPropertyQuality is the name of the ItemProperty holding quality
+	ItemPropertyQuality = "Quality"
+)
+
+// Property returns the value of the first ItemProperty with
+// the provided name.
+func (i *ItemResp) Property(name string) (string, bool) {
+	for _, p := range i.Properties {
+		if p.Name != name {
+			continue
+		}
+		return p.Value()
+	}
+	return "", false
+}
+
+// leadingInt returns the first integer in a property value,
+// ie 20 for "20 (Max)" or "+20%"
+func leadingInt(value string) (int, bool) {
+	start := strings.IndexAny(value, "0123456789")
+	if start == -1 {
+		return 0, false
+	}
+	end := start
+	for end < len(value) && value[end] >= '0' && value[end] <= '9' {
+		end++
+	}
+	v, err := strconv.Atoi(value[start:end])
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// GemLevel returns the level of a gem; zero is returned if
+// the level is not present.
+func (i *ItemResp) GemLevel() int {
+	value, ok := i.Property(ItemPropertyLevel)
+	if !ok {
+		return 0
+	}
+	level, _ := leadingInt(value)
+	return level
+}
+
+// Quality returns the quality of an item; zero is returned if
+// the quality is not present.
+func (i *ItemResp) Quality() int {
+	value, ok := i.Property(ItemPropertyQuality)
+	if !ok {
+		return 0
+	}
+	quality, _ := leadingInt(value)
+	return quality
+}
+
+// Prefixes of gem TypeLines which denote special variants of gems
+const (
+	GemPrefixAwakened = "Awakened "
+	GemPrefixVaal     = "Vaal "
+)
+
+// GemPrefixesAlternateQuality are the TypeLine prefixes of gems
+// with alternate quality, ie Anomalous Fireball
+var GemPrefixesAlternateQuality = []string{
+	"Anomalous ",
+	"Divergent ",
+	"Phantasmal ",
+}
+
+// Slot returns the InventoryID of the item; flasks additionally include
+// their 1-indexed position, ie Flask 3.
+func (i *ItemResp) Slot() string {
+	if i.InventoryID == InventoryIDFlask {
+		return fmt.Sprintf("%s %d", i.InventoryID, i.X+1)
+	}
+	return i.InventoryID
 }
 
 // FullName returns the name derived from name and typeline of an item.
@@ -160,49 +543,6 @@ func (i *ItemResp) FullName() string {
 	return builder.String()
 }
 
-// EnforceGucciHobo ensures all facets of the ItemResp are compliant
-// under the 'Gucci Hobo' policy.
-// TODO: consider having something outside this decorate on the
-// non-item context; or, taking a struct that contains that info
-// rather than bare arguments.
-func (i *ItemResp) EnforceGucciHobo(now time.Time,
-	characterName string, characterLevel int,
-	accountName string) (PolicyFailure, bool) {
-	// Ignore flasks
-	if i.InventoryID == inventoryIDFlask {
-		return PolicyFailure{}, false
-	}
-
-	// Check socketed items first; control flow is a bit easier
-	for _, s := range i.SocketedItems {
-		fail, failed := s.EnforceGucciHobo(now,
-			characterName, characterLevel, accountName)
-		if !failed {
-			continue
-		}
-		return fail, true
-	}
-
-	// Allow uniques or relics, which are fancy uniques
-	// Also allow gems since those are always okay.
-	if i.FrameType == FrameTypeUnique ||
-		i.FrameType == FrameTypeRelic ||
-		i.FrameType == FrameTypeGem {
-		return PolicyFailure{}, false
-	}
-
-	return PolicyFailure{
-		Reason:         PolicyFailureReasonItem,
-		AccountName:    accountName,
-		CharacterName:  characterName,
-		CharacterLevel: characterLevel,
-		ItemName:       i.FullName(),
-		ItemLevel:      i.Ilvl,
-		ItemSlot:       i.InventoryID,
-		When:           now,
-	}, true
-}
-
 // ItemRespSet is a slice of ItemResp received from the get-item API
 type ItemRespSet []ItemResp
 
@@ -223,33 +563,6 @@ type GetItemResp struct {
 	Character CharacterResp `json:"character"`
 }
 
-// EnforceGucciHobo ensures all facets of the GetItemResp is valid
-// under the constraints of the 'Gucci Hobo' policy.
-func (r *GetItemResp) EnforceGucciHobo(now time.Time,
-	accountName string) []PolicyFailure {
-
-	var failures []PolicyFailure
-
-	// We have an exlusion for characters of level 2 and lower
-	// since the game requires you to use a piece of equipment to
-	// get past the twilight strand.
-	if r.Character.Level <= 2 {
-		return failures
-	}
-
-	// Filter for policy exceptions
-	for _, i := range r.Items {
-		fail, failed := i.EnforceGucciHobo(now,
-			r.Character.Name, r.Character.Level, accountName)
-		if !failed {
-			continue
-		}
-		failures = append(failures, fail)
-	}
-
-	return failures
-}
-
 // ReadGetItemResp parses the provided reader as a GetItemResp
 func ReadGetItemResp(r io.Reader) (*GetItemResp, error) {
 	var resp GetItemResp
@@ -16,196 +16,174 @@ func TestReadGetItemResp(t *testing.T) {
 
 	require.NotZero(t, resp.Character)
 	require.NotEmpty(t, resp.Items)
-}
 
-func TestEnforceGucciHobo(t *testing.T) {
-	const charName = "some-character"
-	const accountName = "some-account"
+	// Gloves are first in the fixture and have gems socketed
+	gloves := resp.Items[0]
+	require.Equal(t, "Gloves", gloves.InventoryID)
+	require.Equal(t, 20, gloves.Quality())
+	require.NotEmpty(t, gloves.ID)
+
+	evidence := gloves.Evidence()
+	require.Equal(t, gloves.ID, evidence.ItemID)
+	require.Equal(t, FrameTypeName(gloves.FrameType), evidence.Rarity)
+	require.Equal(t, gloves.ExplicitMods, evidence.ExplicitMods)
+
+	support := gloves.SocketedItems[0]
+	require.Equal(t, "Faster Attacks Support", support.TypeLine)
+	require.True(t, support.Support)
+	require.Equal(t, 20, support.GemLevel())
+	require.Equal(t, 9, support.Quality())
+
+	active := gloves.SocketedItems[3]
+	require.Equal(t, "Whirling Blades", active.TypeLine)
+	require.False(t, active.Support)
+	require.Equal(t, 4, active.GemLevel())
+	require.Equal(t, 0, active.Quality())
+}
 
-	now, err := time.Parse("Mon Jan 2 15:04:05 -0700 MST 2006", "Mon Jan 2 15:04:05 -0700 MST 2006")
-	require.NoError(t, err, "sample time must parse")
+func TestPolicyFailureCSV(t *testing.T) {
+	// This ensures any change to the failure MUST be explicit
+	t.Run("correctly decodes", func(t *testing.T) {
+		// Grab an arbitrary time to use as a reference point
+		now, err := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		require.NoError(t, err, "parsing fixture time")
 
-	run := func(charLevel int, items ...ItemResp) []PolicyFailure {
-		resp := GetItemResp{
-			Character: CharacterResp{
-				Name:  charName,
-				Level: charLevel,
-			},
-			Items: items,
+		failure := PolicyFailure{
+			Reason:         "some-reason",
+			RuleID:         "some-rule",
+			Severity:       SeverityWarning,
+			ItemName:       "some-item",
+			ItemLevel:      84,
+			ItemSlot:       "Boots",
+			CharacterName:  "Tim",
+			CharacterLevel: 91,
+			AccountName:    "some-account",
+			When:           now,
+			PoB:            "some-long-code",
 		}
 
-		return resp.EnforceGucciHobo(now, accountName)
-	}
+		line, err := failure.ToCSVRecord()
+		require.NoError(t, err)
 
-	t.Run("happy path unique item", func(t *testing.T) {
-		failures := run(99, ItemResp{
-			FrameType: FrameTypeUnique,
-		})
-		require.Empty(t, failures)
+		found, err := ParsePolicyFailureCSV(line)
+		require.NoError(t, err)
+		require.Equal(t, failure, found)
 	})
 
-	t.Run("level 2 character allowed with magic item", func(t *testing.T) {
-		failures := run(2, ItemResp{
-			FrameType: FrameTypeMagic,
-		})
-		require.Empty(t, failures)
-	})
+	t.Run("correctly decodes socketed", func(t *testing.T) {
+		now, err := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		require.NoError(t, err, "parsing fixture time")
 
-	t.Run("failure reports details", func(t *testing.T) {
-		badName := "some-item-name"
-		badSlot := "Weapon"
-		failures := run(99,
-			ItemResp{
-				Name:        badName,
-				FrameType:   FrameTypeNormal,
-				InventoryID: badSlot,
-				Ilvl:        84,
-			},
-		)
-		require.NotEmpty(t, failures)
+		failure := PolicyFailure{
+			Reason:         "some-reason",
+			ItemName:       "some-jewel",
+			ItemLevel:      84,
+			ParentItemName: "some-item",
+			ParentItemSlot: "BodyArmour",
+			SocketIndex:    0,
+			CharacterName:  "Tim",
+			CharacterLevel: 91,
+			AccountName:    "some-account",
+			When:           now,
+		}
 
-		// We ensure these are exactly equivalent as it enforces
-		// that the test is updated if the code is updated.
-		exactFailure := PolicyFailure{
-			Reason:      PolicyFailureReasonItem,
-			AccountName: accountName,
+		line, err := failure.ToCSVRecord()
+		require.NoError(t, err)
+		require.Equal(t, "0", line[8])
 
-			CharacterLevel: 99,
-			CharacterName:  charName,
+		found, err := ParsePolicyFailureCSV(line)
+		require.NoError(t, err)
+		require.Equal(t, failure, found)
+	})
 
-			ItemName:  badName,
-			ItemLevel: 84,
-			ItemSlot:  badSlot,
+	t.Run("correctly decodes evidence", func(t *testing.T) {
+		now, err := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		require.NoError(t, err, "parsing fixture time")
 
-			When: now,
+		failure := PolicyFailure{
+			Reason:   "some-reason",
+			ItemName: "Doom Grip Vaal Gauntlets",
+			When:     now,
+			Evidence: &Evidence{
+				ItemID:       "some-item-id",
+				Rarity:       "rare",
+				Name:         "Doom Grip",
+				TypeLine:     "Vaal Gauntlets",
+				ExplicitMods: []string{"+10 to Strength", `"quoted", with commas`},
+				CraftedMods:  []string{"+25% to Fire Resistance"},
+			},
 		}
-		require.Equal(t, exactFailure, failures[0])
-	})
 
-	t.Run("non-unique non-flask invalid", func(t *testing.T) {
-		failures := run(99, ItemResp{
-			FrameType: FrameTypeMagic,
-		})
-		require.NotEmpty(t, failures)
-	})
+		line, err := failure.ToCSVRecord()
+		require.NoError(t, err)
+		found, err := ParsePolicyFailureCSV(line)
+		require.NoError(t, err)
+		require.Equal(t, failure, found)
 
-	t.Run("non-unique flask valid", func(t *testing.T) {
-		failures := run(99, ItemResp{
-			FrameType:   FrameTypeMagic,
-			InventoryID: inventoryIDFlask,
-		})
-		require.Empty(t, failures)
+		line[13] = "{not json"
+		_, err = ParsePolicyFailureCSV(line)
+		require.Error(t, err)
 	})
 
-	t.Run("multiple items succeed when all in policy", func(t *testing.T) {
-		failures := run(99,
-			ItemResp{
-				FrameType:   FrameTypeUnique,
-				InventoryID: "Offhand",
-			},
-			ItemResp{
-				FrameType:   FrameTypeUnique,
-				InventoryID: "Gloves",
-			},
-			ItemResp{
-				FrameType:   FrameTypeUnique,
-				InventoryID: "Ring",
-			},
-			ItemResp{
-				FrameType:   FrameTypeNormal,
-				InventoryID: "Flask",
-			},
-		)
-		require.Empty(t, failures)
+	t.Run("header matches record", func(t *testing.T) {
+		var failure PolicyFailure
+		line, err := failure.ToCSVRecord()
+		require.NoError(t, err)
+		require.Len(t, line, len(PolicyFailureCSVHeader()))
 	})
 
-	t.Run("multiple items fail when one not in policy", func(t *testing.T) {
-		badSlot := "Weapon"
-		failures := run(99,
-			ItemResp{
-				FrameType:   FrameTypeUnique,
-				InventoryID: "Offhand",
-			},
-			ItemResp{
-				FrameType:   FrameTypeUnique,
-				InventoryID: "Gloves",
-			},
-			ItemResp{
-				FrameType:   FrameTypeUnique,
-				InventoryID: "Ring",
-			},
-			ItemResp{
-				FrameType:   FrameTypeNormal,
-				InventoryID: "Flask",
-			},
-			ItemResp{
-				FrameType:   FrameTypeNormal,
-				InventoryID: badSlot,
-			},
-		)
-		require.NotEmpty(t, failures)
+	t.Run("rejects wrong field count", func(t *testing.T) {
+		_, err := ParsePolicyFailureCSV([]string{"some-reason"})
+		require.Error(t, err)
 	})
 
-	t.Run("socketed gem valid", func(t *testing.T) {
-		failures := run(99, ItemResp{
-			FrameType: FrameTypeUnique,
-			SocketedItems: []ItemResp{
-				ItemResp{
-					FrameType: FrameTypeGem,
-				},
-			},
-		})
-		require.Empty(t, failures)
+	t.Run("rejects unknown severity", func(t *testing.T) {
+		failure := PolicyFailure{Severity: "catastrophic"}
+		line, err := failure.ToCSVRecord()
+		require.NoError(t, err)
+		_, err = ParsePolicyFailureCSV(line)
+		require.Error(t, err)
 	})
+}
 
-	t.Run("unique socketed jewel valid", func(t *testing.T) {
-		failures := run(99, ItemResp{
-			FrameType: FrameTypeUnique,
-			SocketedItems: []ItemResp{
-				ItemResp{
-					FrameType: FrameTypeUnique,
-				},
-			},
-		})
-		require.Empty(t, failures)
+func TestFrameTypeName(t *testing.T) {
+	t.Run("round trips", func(t *testing.T) {
+		for frameType := FrameTypeNormal; frameType <= FrameTypeRelic; frameType++ {
+			found, err := ParseFrameType(FrameTypeName(frameType))
+			require.NoError(t, err)
+			require.Equal(t, frameType, found)
+		}
 	})
 
-	t.Run("non-unique socketed jewel invalid", func(t *testing.T) {
-		failures := run(99, ItemResp{
-			FrameType: FrameTypeUnique,
-			SocketedItems: []ItemResp{
-				ItemResp{
-					FrameType: FrameTypeRare,
-				},
-			},
-		})
-		require.NotEmpty(t, failures)
+	t.Run("unknown name", func(t *testing.T) {
+		_, err := ParseFrameType("legendary")
+		require.Error(t, err)
 	})
 }
 
-func TestPolicyFailureCSV(t *testing.T) {
-	// This ensures any change to the failure MUST be explicit
-	t.Run("correctly decodes", func(t *testing.T) {
-		// Grab an arbitrary time to use as a reference point
-		now, err := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
-		require.NoError(t, err, "parsing fixture time")
+func TestSlot(t *testing.T) {
+	t.Run("flasks are numbered", func(t *testing.T) {
+		i := ItemResp{InventoryID: InventoryIDFlask, X: 2}
+		require.Equal(t, "Flask 3", i.Slot())
+	})
 
-		failure := PolicyFailure{
-			Reason:         "some-reason",
-			ItemName:       "some-item",
-			ItemLevel:      84,
-			ItemSlot:       "Boots",
-			CharacterName:  "Tim",
-			CharacterLevel: 91,
-			AccountName:    "some-account",
-			When:           now,
-			PoB:            "some-long-code",
-		}
+	t.Run("other slots are unchanged", func(t *testing.T) {
+		i := ItemResp{InventoryID: "Helm", X: 2}
+		require.Equal(t, "Helm", i.Slot())
+	})
+}
 
-		line := failure.ToCSVRecord()
+func TestLeadingInt(t *testing.T) {
+	for value, expected := range map[string]int{
+		"20 (Max)": 20,
+		"+9%":      9,
+		"4":        4,
+	} {
+		found, ok := leadingInt(value)
+		require.True(t, ok, value)
+		require.Equal(t, expected, found, value)
+	}
 
-		found, err := ParsePolicyFailureCSV(line)
-		require.NoError(t, err)
-		require.Equal(t, failure, found)
-	})
+	_, ok := leadingInt("Max")
+	require.False(t, ok)
 }
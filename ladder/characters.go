@@ -0,0 +1,65 @@
+package ladder
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// AccountCharacter is a single character of an account, as returned
+// from get-characters.
+//
+// Unlike the ladder, this includes characters of every league.
+type AccountCharacter struct {
+	Name            string `json:"name"`
+	League          string `json:"league"`
+	ClassID         int    `json:"classId"`
+	AscendancyClass int    `json:"ascendancyClass"`
+	Class           string `json:"class"`
+	Level           int    `json:"level"`
+	Experience      int64  `json:"experience"`
+	// LastActive is set for the character the account last played.
+	LastActive bool `json:"lastActive,omitempty"`
+}
+
+// AccountCharacters are every character of an account.
+type AccountCharacters []AccountCharacter
+
+// ReadAccountCharacters returns AccountCharacters decoded from the
+// provided Reader, in the format of get-characters.
+func ReadAccountCharacters(r io.Reader) (AccountCharacters, error) {
+	var c AccountCharacters
+	if err := json.NewDecoder(r).Decode(&c); err != nil {
+		return nil, errors.Wrap(err, "decoding characters json")
+	}
+	return c, nil
+}
+
+// InLeague returns the AccountCharacters playing in the provided league,
+// ie Slippery Hobo League (PL5357)
+func (c AccountCharacters) InLeague(league string) AccountCharacters {
+	var result AccountCharacters
+	for _, character := range c {
+		if character.League == league {
+			result = append(result, character)
+		}
+	}
+	return result
+}
+
+// Entry returns the ladder Entry the character would have, without
+// a rank, so it can be checked as though it were on the ladder.
+func (c AccountCharacter) Entry(accountName string) Entry {
+	return Entry{
+		Character: Character{
+			Name:       c.Name,
+			Level:      c.Level,
+			Class:      c.Class,
+			Experience: c.Experience,
+		},
+		Account: Account{
+			Name: accountName,
+		},
+	}
+}
@@ -70,6 +70,35 @@ func ReadLadder(r io.Reader) (Ladder, error) {
 	return l, nil
 }
 
+// ascendancies maps each base class to its ascendancy classes.
+var ascendancies = map[string][]string{
+	"Scion":    {"Ascendant"},
+	"Marauder": {"Juggernaut", "Berserker", "Chieftain"},
+	"Ranger":   {"Raider", "Deadeye", "Pathfinder"},
+	"Witch":    {"Occultist", "Elementalist", "Necromancer"},
+	"Duelist":  {"Slayer", "Gladiator", "Champion"},
+	"Templar":  {"Inquisitor", "Hierophant", "Guardian"},
+	"Shadow":   {"Assassin", "Trickster", "Saboteur"},
+}
+
+// BaseClass returns the base class of a Character's class, ie
+// Witch for Necromancer. Base classes are returned unchanged.
+//
+// The empty string is returned for unknown classes.
+func BaseClass(class string) string {
+	for base, ascendancyClasses := range ascendancies {
+		if class == base {
+			return base
+		}
+		for _, a := range ascendancyClasses {
+			if class == a {
+				return base
+			}
+		}
+	}
+	return ""
+}
+
 // PageCursor lets us keep track of where we are in a ladder
 // traversal.
 type PageCursor struct {
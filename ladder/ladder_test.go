@@ -52,3 +52,60 @@ func TestActiveCharacters(t *testing.T) {
 		require.NotEmpty(t, l.ActiveCharacters())
 	})
 }
+
+func TestBaseClass(t *testing.T) {
+	t.Run("ascendancy", func(t *testing.T) {
+		require.Equal(t, "Witch", BaseClass("Necromancer"))
+		require.Equal(t, "Scion", BaseClass("Ascendant"))
+	})
+
+	t.Run("base class", func(t *testing.T) {
+		require.Equal(t, "Shadow", BaseClass("Shadow"))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		require.Empty(t, BaseClass("Bard"))
+	})
+
+	t.Run("fixture classes are known", func(t *testing.T) {
+		blob := fixtures.FixtureBytes(t, fixtures.GetLadderFixture)
+		l, err := ReadLadder(bytes.NewReader(blob))
+		require.NoError(t, err)
+		for _, e := range l.Entries {
+			require.NotEmpty(t, BaseClass(e.Character.Class),
+				"unknown class %s", e.Character.Class)
+		}
+	})
+}
+
+func TestReadAccountCharacters(t *testing.T) {
+	blob := fixtures.FixtureBytes(t, fixtures.GetCharactersFixture)
+	c, err := ReadAccountCharacters(bytes.NewReader(blob))
+	require.NoError(t, err)
+	require.Len(t, c, 4)
+	for _, character := range c {
+		require.NotEmpty(t, character.Name,
+			"character did not have name: %v", character)
+		require.NotEmpty(t, character.League,
+			"character did not have league: %v", character)
+	}
+
+	t.Run("in league", func(t *testing.T) {
+		league := c.InLeague("Slippery Hobo League (PL5357)")
+		require.Len(t, league, 2)
+		require.Equal(t, "HoboDuelistOffLadder", league[0].Name)
+		require.Equal(t, "HoboTemplar", league[1].Name)
+
+		require.Empty(t, c.InLeague("Some League"))
+	})
+
+	t.Run("entry", func(t *testing.T) {
+		e := c[1].Entry("Everlag")
+		require.Equal(t, "Everlag", e.Account.Name)
+		require.Equal(t, "HoboDuelistOffLadder", e.Character.Name)
+		require.Equal(t, "Slayer", e.Character.Class)
+		require.Equal(t, 71, e.Character.Level)
+		require.Zero(t, e.Rank)
+		require.False(t, e.Dead)
+	})
+}
@@ -0,0 +1,209 @@
+package passives
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/packr"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+)
+
+// trees holds the bundled passive tree data, one file per version,
+// ie trees/3.10.0.json
+//
+// These are GGG's skilltree-export data.json and are fetched with
+// `make passive-tree TREE_VERSION=3.10.0`
+var trees = packr.NewBox("./trees")
+
+// NodeType is the kind of a passive tree Node.
+type NodeType int
+
+const (
+	NodeTypeNormal NodeType = iota
+	NodeTypeNotable
+	NodeTypeKeystone
+	NodeTypeMastery
+	NodeTypeJewelSocket
+)
+
+var nodeTypeNames = map[NodeType]string{
+	NodeTypeNormal:      "normal",
+	NodeTypeNotable:     "notable",
+	NodeTypeKeystone:    "keystone",
+	NodeTypeMastery:     "mastery",
+	NodeTypeJewelSocket: "jewel",
+}
+
+func (t NodeType) String() string {
+	if name, ok := nodeTypeNames[t]; ok {
+		return name
+	}
+	return strconv.Itoa(int(t))
+}
+
+// Node is a single passive tree node.
+type Node struct {
+	Hash int
+	Name string
+	Type NodeType
+	// Ascendancy is the name of the ascendancy the Node belongs to,
+	// this is empty for nodes on the main tree.
+	Ascendancy string
+	// AscendancyStart is set for the free node every ascendancy
+	// starts from; it costs no points.
+	AscendancyStart bool
+}
+
+// IsAscendancy returns true when the Node is part of an ascendancy
+// rather than the main tree.
+func (n Node) IsAscendancy() bool {
+	return len(n.Ascendancy) > 0
+}
+
+// Tree resolves passive hashes, as returned from get-passive-skills,
+// to Nodes.
+type Tree struct {
+	Version string
+	Nodes   map[int]Node
+}
+
+// treeExport is the subset of GGG's skilltree-export data.json
+// we care about.
+type treeExport struct {
+	Nodes map[string]struct {
+		Skill             int    `json:"skill"`
+		Name              string `json:"name"`
+		IsKeystone        bool   `json:"isKeystone"`
+		IsNotable         bool   `json:"isNotable"`
+		IsMastery         bool   `json:"isMastery"`
+		IsJewelSocket     bool   `json:"isJewelSocket"`
+		AscendancyName    string `json:"ascendancyName"`
+		IsAscendancyStart bool   `json:"isAscendancyStart"`
+	} `json:"nodes"`
+}
+
+// ReadTree attempts to convert the provided blob, in the format of
+// GGG's skilltree-export data.json, to a Tree of the provided version.
+func ReadTree(r io.Reader, version string) (*Tree, error) {
+	var export treeExport
+	err := jsoniter.ConfigCompatibleWithStandardLibrary.NewDecoder(r).Decode(&export)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse blob into tree")
+	}
+
+	tree := Tree{
+		Version: version,
+		Nodes:   make(map[int]Node, len(export.Nodes)),
+	}
+	for _, n := range export.Nodes {
+		// The root node, which every class starts from, has no skill
+		if n.Skill == 0 {
+			continue
+		}
+		node := Node{
+			Hash:            n.Skill,
+			Name:            n.Name,
+			Ascendancy:      n.AscendancyName,
+			AscendancyStart: n.IsAscendancyStart,
+		}
+		switch {
+		case n.IsKeystone:
+			node.Type = NodeTypeKeystone
+		case n.IsNotable:
+			node.Type = NodeTypeNotable
+		case n.IsMastery:
+			node.Type = NodeTypeMastery
+		case n.IsJewelSocket:
+			node.Type = NodeTypeJewelSocket
+		}
+		tree.Nodes[node.Hash] = node
+	}
+	if len(tree.Nodes) == 0 {
+		return nil, errors.New("tree has no nodes")
+	}
+
+	return &tree, nil
+}
+
+// LoadTree returns the Tree described by the provided spec, which is
+// either a bundled version, ie 3.10.0, or the path to a data.json file.
+func LoadTree(spec string) (*Tree, error) {
+	if strings.EqualFold(filepath.Ext(spec), ".json") {
+		f, err := os.Open(spec)
+		if err != nil {
+			return nil, errors.Wrap(err, "opening tree file")
+		}
+		defer f.Close()
+		return ReadTree(f, strings.TrimSuffix(filepath.Base(spec),
+			filepath.Ext(spec)))
+	}
+
+	name := spec + ".json"
+	if !trees.Has(name) {
+		return nil, errors.Errorf(
+			"tree version %s is not bundled, have %v; fetch it with `make passive-tree TREE_VERSION=%s`",
+			spec, TreeVersions(), spec)
+	}
+	blob, err := trees.Find(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading bundled tree")
+	}
+	return ReadTree(bytes.NewReader(blob), spec)
+}
+
+// TreeVersions returns every bundled tree version.
+func TreeVersions() []string {
+	var versions []string
+	for _, name := range trees.List() {
+		if filepath.Ext(name) != ".json" {
+			continue
+		}
+		versions = append(versions, strings.TrimSuffix(name, ".json"))
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// Allocation is the resolved form of a set of allocated passive hashes.
+type Allocation struct {
+	// Nodes are every allocated Node, in hash order.
+	Nodes []Node
+	// Unknown are hashes not present in the Tree; this usually means
+	// the Tree is for the wrong version.
+	Unknown []int
+
+	// Points is how many passive points are spent on the main tree.
+	Points int
+	// AscendancyPoints is how many ascendancy points are spent.
+	AscendancyPoints int
+}
+
+// Allocate resolves the provided hashes against the Tree.
+func (t *Tree) Allocate(hashes []int) Allocation {
+	sorted := append([]int(nil), hashes...)
+	sort.Ints(sorted)
+
+	var a Allocation
+	for _, hash := range sorted {
+		node, ok := t.Nodes[hash]
+		if !ok {
+			a.Unknown = append(a.Unknown, hash)
+			continue
+		}
+		a.Nodes = append(a.Nodes, node)
+		switch {
+		case node.AscendancyStart:
+		case node.IsAscendancy():
+			a.AscendancyPoints++
+		default:
+			a.Points++
+		}
+	}
+	return a
+}
@@ -0,0 +1,84 @@
+package passives
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Everlag/slippery-policy/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+// testTree is a tiny tree in the format of skilltree-export data.json
+//
+// Hashes 238, 1031 and 1203 are allocated in GetPassivesFixture34.
+const testTree = `{
+	"tree": "Default",
+	"nodes": {
+		"root": {"group": 0, "out": [238]},
+		"238": {"skill": 238, "name": "Chaos Inoculation", "isKeystone": true},
+		"1031": {"skill": 1031, "name": "Heart of Thunder", "isNotable": true},
+		"1203": {"skill": 1203, "name": "Intelligence"},
+		"5000": {"skill": 5000, "name": "Ascendant", "ascendancyName": "Ascendant", "isAscendancyStart": true},
+		"5001": {"skill": 5001, "name": "Path of the Witch", "ascendancyName": "Ascendant"},
+		"6000": {"skill": 6000, "name": "Jewel Socket", "isJewelSocket": true}
+	}
+}`
+
+func TestReadTree(t *testing.T) {
+	tree, err := ReadTree(strings.NewReader(testTree), "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", tree.Version)
+	require.Len(t, tree.Nodes, 6, "root is not a node")
+
+	require.Equal(t, NodeTypeKeystone, tree.Nodes[238].Type)
+	require.Equal(t, NodeTypeNotable, tree.Nodes[1031].Type)
+	require.Equal(t, NodeTypeNormal, tree.Nodes[1203].Type)
+	require.Equal(t, NodeTypeJewelSocket, tree.Nodes[6000].Type)
+	require.True(t, tree.Nodes[5001].IsAscendancy())
+	require.False(t, tree.Nodes[1203].IsAscendancy())
+
+	_, err = ReadTree(strings.NewReader(`{"nodes": {}}`), "test")
+	require.Error(t, err, "empty tree")
+}
+
+func TestAllocate(t *testing.T) {
+	tree, err := ReadTree(strings.NewReader(testTree), "test")
+	require.NoError(t, err)
+
+	blob := fixtures.FixtureBytes(t, fixtures.GetPassivesFixture34)
+	resp, err := ReadPassives(bytes.NewReader(blob))
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Hashes)
+
+	a := tree.Allocate(resp.Hashes)
+	require.Len(t, a.Nodes, 3)
+	require.Equal(t, "Chaos Inoculation", a.Nodes[0].Name)
+	require.Equal(t, 3, a.Points)
+	require.Equal(t, 0, a.AscendancyPoints)
+	require.Len(t, a.Unknown, len(resp.Hashes)-3)
+
+	a = tree.Allocate([]int{5001, 5000, 1203})
+	require.Equal(t, 1, a.Points)
+	require.Equal(t, 1, a.AscendancyPoints, "ascendancy start is free")
+	require.Empty(t, a.Unknown)
+}
+
+func TestLoadTree(t *testing.T) {
+	_, err := LoadTree("0.0.0")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "make passive-tree")
+
+	dir, err := ioutil.TempDir("", "tree")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "3.10.0.json")
+	require.NoError(t, ioutil.WriteFile(filename, []byte(testTree), 0644))
+
+	tree, err := LoadTree(filename)
+	require.NoError(t, err)
+	require.Equal(t, "3.10.0", tree.Version)
+}
@@ -0,0 +1,58 @@
+package policy
+
+import (
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+)
+
+// LadderRule is implemented by Rules which only inspect the ladder Entry
+// of a CharacterSnapshot.
+//
+// These can be checked before the Character is fetched, which saves
+// fetching Characters that have already failed.
+type LadderRule interface {
+	Rule
+	// LadderOnly is a marker for Rules which only require the ladder Entry.
+	LadderOnly()
+}
+
+// Classes restricts the class of a Character.
+//
+// Entries may be either base classes, ie Scion, or ascendancies,
+// ie Necromancer. Base classes also match their ascendancies.
+type Classes struct {
+	// Allowed, when non-empty, are the only classes permitted.
+	Allowed []string
+	// Banned are classes which are never permitted.
+	Banned []string
+}
+
+// Check implements Rule
+func (r Classes) Check(s CharacterSnapshot) []items.PolicyFailure {
+	class := s.Entry.Character.Class
+	if matchClass(r.Banned, class) ||
+		(len(r.Allowed) > 0 && !matchClass(r.Allowed, class)) {
+		return []items.PolicyFailure{
+			s.Failure(items.PolicyFailureReasonClass),
+		}
+	}
+	return nil
+}
+
+// LadderOnly implements LadderRule
+func (r Classes) LadderOnly() {}
+
+// ID implements IdentifiedRule
+func (r Classes) ID() string {
+	return RuleIDClasses
+}
+
+var _ LadderRule = Classes{}
+var _ IdentifiedRule = Classes{}
+
+// matchClass returns true when the class, or its base class,
+// is present in the provided list.
+func matchClass(list []string, class string) bool {
+	return containsString(list, class) ||
+		containsString(list, ladder.BaseClass(class))
+}
@@ -0,0 +1,619 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/passives"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// File is the declarative form of a Policy, as written by league
+// organizers. Both JSON and YAML are accepted.
+//
+// A File representing the GucciHobo Policy looks like
+//
+//	name: gucci-hobo
+//	graceLevel: 2
+//	allowed: [unique]
+//	exempt:
+//	  flasks: true
+//	  gems: true
+//	  relics: true
+type File struct {
+	// Name is the name of the resulting Policy; this defaults
+	// to the name of the file.
+	Name string `yaml:"name"`
+	// GraceLevel is the character level at or below which
+	// items are not checked.
+	GraceLevel int `yaml:"graceLevel"`
+	// WarnLevel is the character level at or below which violations
+	// are reported as warnings.
+	WarnLevel int `yaml:"warnLevel"`
+	// Severities overrides the severity of failures by rule id,
+	// ie gems: warning
+	Severities map[string]string `yaml:"severities"`
+
+	// FileFrameTypes default to only unique.
+	FileFrameTypes `yaml:",inline"`
+	// AbyssJewels, when present, replaces the FileFrameTypes for
+	// abyss jewels. Its allowed defaults to the top-level allowed.
+	AbyssJewels *FileFrameTypes `yaml:"abyssJewels"`
+
+	Exempt FileExempt `yaml:"exempt"`
+	// Flasks, when present, restricts flasks regardless of Exempt.
+	Flasks *FileFlasks `yaml:"flasks"`
+	// Gems, when present, restricts socketed gems regardless of Exempt.
+	Gems *FileGems `yaml:"gems"`
+
+	Uniques FileList `yaml:"uniques"`
+	Bases   FileList `yaml:"bases"`
+
+	// Classes are either base classes, ie Scion, or ascendancies,
+	// ie Necromancer.
+	Classes FileList `yaml:"classes"`
+
+	// Passives, when present, restricts the passive tree.
+	Passives *FilePassives `yaml:"passives"`
+}
+
+// FileFrameTypes are the names of FrameTypes permitted, ie unique.
+type FileFrameTypes struct {
+	// Allowed are permitted in every slot.
+	Allowed []string `yaml:"allowed"`
+	// Slots overrides Allowed per InventoryID, ie Weapon.
+	//
+	// Socketed items use the InventoryID of the item they are socketed
+	// in while jewels in the passive tree use PassiveJewels.
+	Slots map[string][]string `yaml:"slots"`
+}
+
+// FileExempt toggles item classes which are always permitted.
+type FileExempt struct {
+	Flasks bool `yaml:"flasks"`
+	Gems   bool `yaml:"gems"`
+	Relics bool `yaml:"relics"`
+}
+
+// frameTypes returns the FrameTypes exempted, which are
+// permitted everywhere.
+func (e FileExempt) frameTypes() []int {
+	var result []int
+	if e.Gems {
+		result = append(result, items.FrameTypeGem)
+	}
+	if e.Relics {
+		result = append(result, items.FrameTypeRelic)
+	}
+	return result
+}
+
+// FileFlasks restricts flasks.
+type FileFlasks struct {
+	// Allowed, when non-empty, are the only FrameTypes flasks may have.
+	Allowed []string `yaml:"allowed"`
+	// Max limits how many flasks of a FrameType may be equipped,
+	// ie magic: 2
+	Max map[string]int `yaml:"max"`
+	// NoCraftedMods disallows flasks with crafted mods.
+	NoCraftedMods bool `yaml:"noCraftedMods"`
+}
+
+// flasks converts to a Flasks Rule.
+//
+// Problems are described by their location in the File.
+func (ff FileFlasks) flasks(scope Scope) (Flasks, []string) {
+	var problems []string
+	result := Flasks{
+		Max:           make(map[int]int, len(ff.Max)),
+		NoCraftedMods: ff.NoCraftedMods,
+		Scope:         scope,
+	}
+
+	for i, name := range ff.Allowed {
+		frameType, err := items.ParseFrameType(name)
+		if err != nil {
+			problems = append(problems,
+				fmt.Sprintf("flasks.allowed[%d]: %s", i, err))
+			continue
+		}
+		result.Allowed = append(result.Allowed, frameType)
+	}
+
+	// Sort so problems are reported in a stable order
+	names := make([]string, 0, len(ff.Max))
+	for name := range ff.Max {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		location := fmt.Sprintf("flasks.max.%s", name)
+		frameType, err := items.ParseFrameType(name)
+		if err != nil {
+			problems = append(problems,
+				fmt.Sprintf("%s: %s", location, err))
+			continue
+		}
+		max := ff.Max[name]
+		if max < 0 || max > flaskSlots {
+			problems = append(problems,
+				fmt.Sprintf("%s: %d is not between 0 and %d",
+					location, max, flaskSlots))
+			continue
+		}
+		result.Max[frameType] = max
+	}
+
+	return result, problems
+}
+
+// flaskSlots is how many flasks a character can equip.
+const flaskSlots = 5
+
+// FileGems restricts socketed gems.
+type FileGems struct {
+	MaxLevel           *int `yaml:"maxLevel"`
+	MaxQuality         *int `yaml:"maxQuality"`
+	NoAwakened         bool `yaml:"noAwakened"`
+	NoVaal             bool `yaml:"noVaal"`
+	NoAlternateQuality bool `yaml:"noAlternateQuality"`
+	// Banned are gem names or patterns, ie "Enlighten*"
+	Banned []string `yaml:"banned"`
+}
+
+// gems converts to a Gems Rule.
+//
+// Problems are described by their location in the File.
+func (fg FileGems) gems(scope Scope) (Gems, []string) {
+	var problems []string
+	if fg.MaxLevel != nil && *fg.MaxLevel < 0 {
+		problems = append(problems,
+			fmt.Sprintf("gems.maxLevel: %d is negative", *fg.MaxLevel))
+	}
+	if fg.MaxQuality != nil && *fg.MaxQuality < 0 {
+		problems = append(problems,
+			fmt.Sprintf("gems.maxQuality: %d is negative", *fg.MaxQuality))
+	}
+	for i, v := range fg.Banned {
+		if problem, ok := nameProblem(
+			fmt.Sprintf("gems.banned[%d]", i), v); ok {
+			problems = append(problems, problem)
+		}
+	}
+
+	return Gems{
+		MaxLevel:           fg.MaxLevel,
+		MaxQuality:         fg.MaxQuality,
+		NoAwakened:         fg.NoAwakened,
+		NoVaal:             fg.NoVaal,
+		NoAlternateQuality: fg.NoAlternateQuality,
+		Banned:             fg.Banned,
+		Scope:              scope,
+	}, problems
+}
+
+// FilePassives restricts the allocated passive tree.
+type FilePassives struct {
+	// Tree is either a bundled tree version, ie 3.10.0, or the path
+	// to a skilltree-export data.json relative to the policy file.
+	//
+	// This is required; the tree changes between versions.
+	Tree string `yaml:"tree"`
+	// BannedNodes are node names or patterns, ie "Chaos Inoculation"
+	BannedNodes         []string `yaml:"bannedNodes"`
+	MaxPoints           *int     `yaml:"maxPoints"`
+	MaxAscendancyPoints *int     `yaml:"maxAscendancyPoints"`
+}
+
+// passiveTree converts to a PassiveTree Rule, loading the Tree.
+//
+// Problems are described by their location in the File.
+func (fp FilePassives) passiveTree(graceLevel int) (PassiveTree, []string) {
+	var problems []string
+	if fp.MaxPoints != nil && *fp.MaxPoints < 0 {
+		problems = append(problems,
+			fmt.Sprintf("passives.maxPoints: %d is negative", *fp.MaxPoints))
+	}
+	if fp.MaxAscendancyPoints != nil && *fp.MaxAscendancyPoints < 0 {
+		problems = append(problems,
+			fmt.Sprintf("passives.maxAscendancyPoints: %d is negative",
+				*fp.MaxAscendancyPoints))
+	}
+
+	var tree *passives.Tree
+	if len(fp.Tree) == 0 {
+		problems = append(problems, fmt.Sprintf(
+			"passives.tree: missing, expected a bundled tree version, one of %v, or the path to a data.json",
+			passives.TreeVersions()))
+	} else {
+		var err error
+		tree, err = passives.LoadTree(fp.Tree)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("passives.tree: %s", err))
+		}
+	}
+
+	for i, v := range fp.BannedNodes {
+		location := fmt.Sprintf("passives.bannedNodes[%d]", i)
+		if problem, ok := nameProblem(location, v); ok {
+			problems = append(problems, problem)
+			continue
+		}
+		// Catch typos, which would otherwise never match
+		if tree != nil && !strings.ContainsAny(v, namePatternChars) &&
+			!hasNode(tree, v) {
+			problems = append(problems,
+				fmt.Sprintf("%s: no node named %q in tree %s",
+					location, v, tree.Version))
+		}
+	}
+
+	return PassiveTree{
+		Tree:                tree,
+		BannedNodes:         fp.BannedNodes,
+		MaxPoints:           fp.MaxPoints,
+		MaxAscendancyPoints: fp.MaxAscendancyPoints,
+		GraceLevel:          graceLevel,
+	}, problems
+}
+
+// hasNode returns true when the Tree has a Node with the provided name.
+func hasNode(tree *passives.Tree, name string) bool {
+	for _, n := range tree.Nodes {
+		if n.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FileList is a pair of allow and deny lists.
+//
+// Entries are either exact names or patterns, ie "* Flask of Heat",
+// as described by MatchName.
+type FileList struct {
+	Allow []string `yaml:"allow"`
+	Deny  []string `yaml:"deny"`
+}
+
+// FileError describes every problem found with a policy file.
+type FileError struct {
+	Path     string
+	Problems []string
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("invalid policy file %s:\n\t%s",
+		e.Path, strings.Join(e.Problems, "\n\t"))
+}
+
+// LoadFile reads the policy file at the provided path and
+// returns the Policy it describes.
+//
+// Any problems with the file are returned as a *FileError.
+func LoadFile(filename string) (Policy, error) {
+	blob, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return Policy{}, errors.Wrap(err, "reading policy file")
+	}
+	return ParseFile(filename, blob)
+}
+
+// ParseFile returns the Policy described by the provided policy
+// file contents. The filename is used for naming and error reporting.
+//
+// Any problems with the file are returned as a *FileError.
+func ParseFile(filename string, blob []byte) (Policy, error) {
+	// yaml is a superset of json but reports syntax errors on the
+	// wrong line; so, we check json syntax ourselves.
+	if strings.EqualFold(filepath.Ext(filename), ".json") {
+		if problem, ok := jsonSyntaxProblem(blob); ok {
+			return Policy{}, &FileError{
+				Path:     filename,
+				Problems: []string{problem},
+			}
+		}
+	}
+
+	var f File
+	if err := yaml.UnmarshalStrict(blob, &f); err != nil {
+		return Policy{}, &FileError{
+			Path:     filename,
+			Problems: yamlProblems(err),
+		}
+	}
+
+	if len(f.Name) == 0 {
+		f.Name = strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	}
+	// Tree files are relative to the policy file
+	if f.Passives != nil &&
+		strings.EqualFold(filepath.Ext(f.Passives.Tree), ".json") &&
+		!filepath.IsAbs(f.Passives.Tree) {
+		f.Passives.Tree = filepath.Join(filepath.Dir(filename), f.Passives.Tree)
+	}
+
+	p, problems := f.Policy()
+	if len(problems) > 0 {
+		return Policy{}, &FileError{
+			Path:     filename,
+			Problems: problems,
+		}
+	}
+	return p, nil
+}
+
+// jsonSyntaxProblem returns a problem, with the line and column it
+// occurred at, if the provided blob is not valid json.
+func jsonSyntaxProblem(blob []byte) (string, bool) {
+	var v interface{}
+	err := json.Unmarshal(blob, &v)
+	if err == nil {
+		return "", false
+	}
+	syntaxErr, ok := err.(*json.SyntaxError)
+	if !ok {
+		return err.Error(), true
+	}
+
+	// Offset is just past the problem character
+	offset := int(syntaxErr.Offset) - 1
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > len(blob) {
+		offset = len(blob)
+	}
+	line, column := 1, 1
+	for _, c := range blob[:offset] {
+		column++
+		if c == '\n' {
+			line++
+			column = 1
+		}
+	}
+	return fmt.Sprintf("line %d, column %d: %s", line, column, syntaxErr), true
+}
+
+// yamlProblems splits a yaml error into individual problems,
+// each of which is prefixed by the line it occurred on.
+func yamlProblems(err error) []string {
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		return typeErr.Errors
+	}
+	return []string{strings.TrimPrefix(err.Error(), "yaml: ")}
+}
+
+// Policy validates the File and converts it to a Policy.
+//
+// Problems are described by their location in the File,
+// ie slots.Weapon[1].
+func (f File) Policy() (Policy, []string) {
+	var problems []string
+	if f.GraceLevel < 0 || f.GraceLevel > 100 {
+		problems = append(problems,
+			fmt.Sprintf("graceLevel: %d is not a character level", f.GraceLevel))
+	}
+	if f.WarnLevel < 0 || f.WarnLevel > 100 {
+		problems = append(problems,
+			fmt.Sprintf("warnLevel: %d is not a character level", f.WarnLevel))
+	}
+	severities, severityProblems := f.severities()
+	problems = append(problems, severityProblems...)
+
+	defaults := append([]int{items.FrameTypeUnique}, f.Exempt.frameTypes()...)
+	frameTypes, frameTypeProblems := f.FileFrameTypes.frameTypes("",
+		defaults, f.Exempt)
+	problems = append(problems, frameTypeProblems...)
+	rarity := Rarity{
+		FrameTypes:   frameTypes,
+		AllowedBases: f.Bases.Allow,
+	}
+
+	if f.AbyssJewels != nil {
+		abyss, abyssProblems := f.AbyssJewels.frameTypes("abyssJewels.",
+			frameTypes.Allowed, f.Exempt)
+		problems = append(problems, abyssProblems...)
+		rarity.AbyssJewels = &abyss
+	}
+
+	problems = append(problems, classProblems(f.Classes)...)
+	problems = append(problems, listProblems("uniques", f.Uniques)...)
+	problems = append(problems, listProblems("bases", f.Bases)...)
+
+	scope := Scope{
+		GraceLevel: f.GraceLevel,
+	}
+	// exempt.flasks only exempts flasks from rarity; they are still
+	// subject to every other rule, ie uniques.deny
+	rarity.Scope = scope
+	if f.Exempt.Flasks {
+		rarity.Scope.ExemptSlots = []string{items.InventoryIDFlask}
+	}
+
+	var rules []Rule
+	if len(f.Classes.Allow) > 0 || len(f.Classes.Deny) > 0 {
+		rules = append(rules, Classes{
+			Allowed: f.Classes.Allow,
+			Banned:  f.Classes.Deny,
+		})
+	}
+	rules = append(rules, rarity)
+	if f.Flasks != nil {
+		flasks, flaskProblems := f.Flasks.flasks(scope)
+		problems = append(problems, flaskProblems...)
+		rules = append(rules, flasks)
+	}
+	if f.Gems != nil {
+		gems, gemProblems := f.Gems.gems(scope)
+		problems = append(problems, gemProblems...)
+		rules = append(rules, gems)
+	}
+	if len(f.Uniques.Allow) > 0 || len(f.Uniques.Deny) > 0 ||
+		len(f.Bases.Deny) > 0 {
+		rules = append(rules, ItemLists{
+			AllowedUniques: f.Uniques.Allow,
+			BannedUniques:  f.Uniques.Deny,
+			BannedBases:    f.Bases.Deny,
+			Scope:          scope,
+		})
+	}
+	if f.Passives != nil {
+		tree, treeProblems := f.Passives.passiveTree(f.GraceLevel)
+		problems = append(problems, treeProblems...)
+		rules = append(rules, tree)
+	}
+
+	return Policy{
+		Name:       f.Name,
+		Rules:      rules,
+		Severities: severities,
+		WarnLevel:  f.WarnLevel,
+	}, problems
+}
+
+// severities converts Severities, keyed by RuleID.
+//
+// Problems are described by their location in the File.
+func (f File) severities() (map[string]items.Severity, []string) {
+	var problems []string
+	result := make(map[string]items.Severity, len(f.Severities))
+
+	// Sort so problems are reported in a stable order
+	ids := make([]string, 0, len(f.Severities))
+	for id := range f.Severities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	// Private profiles are graded by the Policy without a Rule
+	known := append(append([]string{}, RuleIDs...), items.RuleIDPrivateProfile)
+	for _, id := range ids {
+		location := fmt.Sprintf("severities.%s", id)
+		if !containsString(known, id) {
+			problems = append(problems,
+				fmt.Sprintf("%s: unknown rule, expected one of %v",
+					location, known))
+			continue
+		}
+		severity, err := items.ParseSeverity(f.Severities[id])
+		if err != nil {
+			problems = append(problems,
+				fmt.Sprintf("%s: %s", location, err))
+			continue
+		}
+		result[id] = severity
+	}
+	return result, problems
+}
+
+// frameTypes converts to FrameTypes, falling back to the provided
+// defaults when Allowed is empty.
+//
+// Problems are prefixed by the provided location.
+func (ft FileFrameTypes) frameTypes(location string, defaults []int,
+	exempt FileExempt) (FrameTypes, []string) {
+
+	var problems []string
+	parse := func(location string, names []string) []int {
+		result := make([]int, 0, len(names)+2)
+		for i, name := range names {
+			frameType, err := items.ParseFrameType(name)
+			if err != nil {
+				problems = append(problems,
+					fmt.Sprintf("%s[%d]: %s", location, i, err))
+				continue
+			}
+			result = append(result, frameType)
+		}
+		return append(result, exempt.frameTypes()...)
+	}
+
+	result := FrameTypes{
+		Allowed: defaults,
+		Slots:   make(map[string][]int, len(ft.Slots)),
+	}
+	if len(ft.Allowed) > 0 {
+		result.Allowed = parse(location+"allowed", ft.Allowed)
+	}
+
+	// Sort the slots so problems are reported in a stable order
+	slots := make([]string, 0, len(ft.Slots))
+	for slot := range ft.Slots {
+		slots = append(slots, slot)
+	}
+	sort.Strings(slots)
+	for _, slot := range slots {
+		slotLocation := fmt.Sprintf("%sslots.%s", location, slot)
+		if !containsString(items.InventoryIDs, slot) {
+			problems = append(problems,
+				fmt.Sprintf("%s: unknown slot, expected one of %v",
+					slotLocation, items.InventoryIDs))
+			continue
+		}
+		result.Slots[slot] = parse(slotLocation, ft.Slots[slot])
+	}
+
+	return result, problems
+}
+
+// listProblems returns problems with a FileList, ie
+// empty entries, malformed patterns or entries both allowed and denied.
+func listProblems(location string, l FileList) []string {
+	var problems []string
+	for i, v := range l.Allow {
+		if problem, ok := nameProblem(
+			fmt.Sprintf("%s.allow[%d]", location, i), v); ok {
+			problems = append(problems, problem)
+		}
+	}
+	for i, v := range l.Deny {
+		entryLocation := fmt.Sprintf("%s.deny[%d]", location, i)
+		if problem, ok := nameProblem(entryLocation, v); ok {
+			problems = append(problems, problem)
+			continue
+		}
+		if containsString(l.Allow, v) {
+			problems = append(problems,
+				fmt.Sprintf("%s: %q is also allowed", entryLocation, v))
+		}
+	}
+	return problems
+}
+
+// classProblems returns problems with the classes FileList,
+// ie unknown classes.
+func classProblems(l FileList) []string {
+	var problems []string
+	check := func(location string, classes []string) {
+		for i, class := range classes {
+			if len(ladder.BaseClass(class)) > 0 {
+				continue
+			}
+			problems = append(problems,
+				fmt.Sprintf("%s[%d]: unknown class %q", location, i, class))
+		}
+	}
+	check("classes.allow", l.Allow)
+	check("classes.deny", l.Deny)
+	return problems
+}
+
+// nameProblem returns a problem with a name or pattern
+// as matched by MatchName, ie an empty entry.
+func nameProblem(location string, v string) (string, bool) {
+	if len(strings.TrimSpace(v)) == 0 {
+		return fmt.Sprintf("%s: empty entry", location), true
+	}
+	if _, err := path.Match(v, ""); err != nil {
+		return fmt.Sprintf("%s: malformed pattern %q", location, v), true
+	}
+	return "", false
+}
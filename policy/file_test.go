@@ -0,0 +1,504 @@
+package policy
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/passives"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFile(t *testing.T) {
+	now := fixtureTime(t)
+
+	run := func(p Policy, charLevel int, equipped ...items.ItemResp) []items.PolicyFailure {
+		return p.Check(CharacterSnapshot{
+			Entry: fixtureEntry(charLevel),
+			Items: &items.GetItemResp{
+				Character: items.CharacterResp{
+					Name:  charName,
+					Level: charLevel,
+				},
+				Items: equipped,
+			},
+			When: now,
+		})
+	}
+
+	t.Run("json equivalent to gucci hobo", func(t *testing.T) {
+		p, err := ParseFile("league.json", []byte(`{
+	"graceLevel": 2,
+	"allowed": ["unique"],
+	"exempt": {"flasks": true, "gems": true, "relics": true}
+}`))
+		require.NoError(t, err)
+		require.Equal(t, "league", p.Name)
+
+		equipped := []items.ItemResp{
+			{FrameType: items.FrameTypeUnique, InventoryID: "Helm",
+				SocketedItems: []items.ItemResp{{FrameType: items.FrameTypeGem}}},
+			{FrameType: items.FrameTypeRelic, InventoryID: "Boots"},
+			{FrameType: items.FrameTypeMagic, InventoryID: items.InventoryIDFlask},
+			{FrameType: items.FrameTypeRare, InventoryID: "Weapon"},
+		}
+		for _, level := range []int{1, 2, 3, 99} {
+			require.Equal(t,
+				run(GucciHobo(), level, equipped...),
+				run(p, level, equipped...),
+				"level %d", level)
+		}
+	})
+
+	t.Run("yaml slots override allowed", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+name: magic-weapons
+allowed: [unique]
+slots:
+  Weapon: [unique, magic]
+`))
+		require.NoError(t, err)
+		require.Equal(t, "magic-weapons", p.Name)
+
+		require.Empty(t, run(p, 90,
+			items.ItemResp{FrameType: items.FrameTypeMagic, InventoryID: "Weapon"}))
+		require.NotEmpty(t, run(p, 90,
+			items.ItemResp{FrameType: items.FrameTypeMagic, InventoryID: "Helm"}))
+	})
+
+	t.Run("abyss jewels per socket context", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+allowed: [unique]
+abyssJewels:
+  allowed: [unique, magic]
+  slots:
+    PassiveJewels: [unique]
+`))
+		require.NoError(t, err)
+
+		magicAbyss := items.ItemResp{FrameType: items.FrameTypeMagic, AbyssJewel: true}
+		inGear := run(p, 90, items.ItemResp{
+			FrameType:     items.FrameTypeUnique,
+			InventoryID:   "Belt",
+			SocketedItems: []items.ItemResp{magicAbyss},
+		})
+		require.Empty(t, inGear)
+
+		magicAbyss.InventoryID = items.InventoryIDPassiveJewels
+		onTree := p.Check(CharacterSnapshot{
+			Entry: fixtureEntry(90),
+			Passives: &passives.GetPassivesResp{
+				Items: []items.ItemResp{magicAbyss},
+			},
+		})
+		require.Len(t, onTree, 1)
+
+		// Regular jewels are not affected
+		require.NotEmpty(t, run(p, 90, items.ItemResp{
+			FrameType:   items.FrameTypeUnique,
+			InventoryID: "Belt",
+			SocketedItems: []items.ItemResp{
+				{FrameType: items.FrameTypeMagic},
+			},
+		}))
+	})
+
+	t.Run("flasks", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+exempt:
+  flasks: true
+flasks:
+  allowed: [unique, magic]
+  max:
+    magic: 1
+  noCraftedMods: true
+`))
+		require.NoError(t, err)
+
+		failures := run(p, 90,
+			items.ItemResp{FrameType: items.FrameTypeMagic,
+				InventoryID: items.InventoryIDFlask, X: 3},
+			items.ItemResp{FrameType: items.FrameTypeMagic,
+				InventoryID: items.InventoryIDFlask, X: 1},
+			items.ItemResp{FrameType: items.FrameTypeUnique,
+				InventoryID: items.InventoryIDFlask, X: 0,
+				CraftedMods: []string{"some-crafted-mod"}},
+			items.ItemResp{FrameType: items.FrameTypeNormal,
+				InventoryID: items.InventoryIDFlask, X: 4},
+		)
+		require.Len(t, failures, 3)
+		require.Equal(t, items.PolicyFailureReasonCraftedFlask, failures[0].Reason)
+		require.Equal(t, "Flask 1", failures[0].ItemSlot)
+		require.Equal(t, items.PolicyFailureReasonTooManyFlasks, failures[1].Reason)
+		require.Equal(t, "Flask 4", failures[1].ItemSlot)
+		require.Equal(t, items.PolicyFailureReasonFlask, failures[2].Reason)
+		require.Equal(t, "Flask 5", failures[2].ItemSlot)
+
+		// Flasks share the grace level of every other item rule
+		p, err = ParseFile("league.yaml", []byte(`
+graceLevel: 10
+exempt:
+  flasks: true
+flasks:
+  allowed: [unique]
+`))
+		require.NoError(t, err)
+		flask := items.ItemResp{FrameType: items.FrameTypeMagic,
+			InventoryID: items.InventoryIDFlask}
+		require.Empty(t, run(p, 10, flask))
+		require.Len(t, run(p, 11, flask), 1)
+	})
+
+	t.Run("exempt flasks keep item lists", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+allowed: [unique]
+exempt:
+  flasks: true
+uniques:
+  deny: [Dying Sun]
+bases:
+  deny: ["* Flask of Heat"]
+`))
+		require.NoError(t, err)
+
+		// Exempt from rarity
+		require.Empty(t, run(p, 90, items.ItemResp{FrameType: items.FrameTypeMagic,
+			InventoryID: items.InventoryIDFlask, TypeLine: "Ruby Flask"}))
+
+		// But not from the lists
+		failures := run(p, 90, items.ItemResp{FrameType: items.FrameTypeUnique,
+			InventoryID: items.InventoryIDFlask,
+			Name:        "Dying Sun", TypeLine: "Ruby Flask"})
+		require.Len(t, failures, 1)
+		require.Equal(t, RuleIDItemLists, failures[0].RuleID)
+		require.Equal(t, items.PolicyFailureReasonBannedUnique, failures[0].Reason)
+
+		failures = run(p, 90, items.ItemResp{FrameType: items.FrameTypeMagic,
+			InventoryID: items.InventoryIDFlask,
+			TypeLine:    "Ruby Flask of Heat"})
+		require.Len(t, failures, 1)
+		require.Equal(t, items.PolicyFailureReasonBannedBase, failures[0].Reason)
+	})
+
+	t.Run("invalid flasks", func(t *testing.T) {
+		_, err := ParseFile("league.yaml", []byte(`
+flasks:
+  allowed: [shiny]
+  max:
+    magic: 6
+    rare: -1
+    shiny: 1
+`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 4)
+		require.Contains(t, fileErr.Problems[0], "flasks.allowed[0]")
+		require.Contains(t, fileErr.Problems[1], "flasks.max.magic")
+		require.Contains(t, fileErr.Problems[2], "flasks.max.rare")
+		require.Contains(t, fileErr.Problems[3], "flasks.max.shiny")
+	})
+
+	t.Run("gems", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+exempt:
+  gems: true
+gems:
+  maxLevel: 20
+  maxQuality: 0
+  noAwakened: true
+  noVaal: true
+  noAlternateQuality: true
+  banned: ["Enlighten*"]
+`))
+		require.NoError(t, err)
+
+		gem := func(typeLine string, level, quality string, socket int) items.ItemResp {
+			return items.ItemResp{
+				FrameType: items.FrameTypeGem,
+				TypeLine:  typeLine,
+				Socket:    socket,
+				Properties: []items.ItemProperty{
+					{Name: items.ItemPropertyLevel,
+						Values: [][]interface{}{{level, 0}}},
+					{Name: items.ItemPropertyQuality,
+						Values: [][]interface{}{{quality, 1}}},
+				},
+			}
+		}
+
+		failures := run(p, 90, items.ItemResp{
+			Name:        "host",
+			FrameType:   items.FrameTypeUnique,
+			InventoryID: "Helm",
+			SocketedItems: []items.ItemResp{
+				gem("Fireball", "20 (Max)", "+0%", 0),
+				gem("Awakened Added Fire Damage Support", "1", "+0%", 1),
+				gem("Vaal Haste", "21", "+0%", 2),
+				gem("Anomalous Fireball", "1", "+3%", 3),
+				gem("Enlighten Support", "3 (Max)", "+0%", 4),
+			},
+		})
+
+		type found struct {
+			reason string
+			socket int
+		}
+		var reasons []found
+		for _, f := range failures {
+			require.Equal(t, "host", f.ParentItemName)
+			require.Equal(t, "Helm", f.ParentItemSlot)
+			reasons = append(reasons, found{f.Reason, f.SocketIndex})
+		}
+		require.Equal(t, []found{
+			{items.PolicyFailureReasonAwakenedGem, 1},
+			{items.PolicyFailureReasonVaalGem, 2},
+			{items.PolicyFailureReasonGemLevel, 2},
+			{items.PolicyFailureReasonAlternateQualityGem, 3},
+			{items.PolicyFailureReasonGemQuality, 3},
+			{items.PolicyFailureReasonBannedGem, 4},
+		}, reasons)
+	})
+
+	t.Run("invalid gems", func(t *testing.T) {
+		_, err := ParseFile("league.yaml", []byte(`
+gems:
+  maxLevel: -1
+  banned: ["[Enlighten"]
+`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 2)
+		require.Contains(t, fileErr.Problems[0], "gems.maxLevel")
+		require.Contains(t, fileErr.Problems[1], "gems.banned[0]")
+	})
+
+	t.Run("classes", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+classes:
+  allow: [Witch, Scion]
+  deny: [Necromancer]
+`))
+		require.NoError(t, err)
+
+		check := func(class string) []items.PolicyFailure {
+			e := fixtureEntry(90)
+			e.Character.Class = class
+			return p.CheckLadder(CharacterSnapshot{Entry: e})
+		}
+		require.Empty(t, check("Scion"))
+		require.Empty(t, check("Ascendant"))
+		require.Empty(t, check("Elementalist"))
+		require.Len(t, check("Necromancer"), 1)
+		require.Len(t, check("Slayer"), 1)
+		require.Equal(t, items.PolicyFailureReasonClass, check("Duelist")[0].Reason)
+	})
+
+	t.Run("invalid classes", func(t *testing.T) {
+		_, err := ParseFile("league.yaml", []byte(`
+classes:
+  allow: [Scion, Bard]
+  deny: [Necromancr]
+`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 2)
+		require.Contains(t, fileErr.Problems[0], "classes.allow[1]")
+		require.Contains(t, fileErr.Problems[1], "classes.deny[0]")
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+uniques:
+  deny: [Tabula Rasa]
+bases:
+  allow: [Iron Ring]
+  deny: [Simple Robe]
+`))
+		require.NoError(t, err)
+
+		failures := run(p, 90,
+			items.ItemResp{FrameType: items.FrameTypeUnique, Name: "Tabula Rasa",
+				InventoryID: "BodyArmour"},
+			items.ItemResp{FrameType: items.FrameTypeUnique, Name: "Goldrim",
+				TypeLine: "Simple Robe", InventoryID: "Helm"},
+			items.ItemResp{FrameType: items.FrameTypeRare, TypeLine: "Iron Ring",
+				InventoryID: "Ring"},
+		)
+		reasons := make([]string, 0, len(failures))
+		for _, f := range failures {
+			reasons = append(reasons, f.Reason)
+		}
+		require.Equal(t, []string{
+			items.PolicyFailureReasonBannedUnique,
+			items.PolicyFailureReasonBannedBase,
+		}, reasons)
+	})
+
+	t.Run("syntax errors have lines", func(t *testing.T) {
+		_, err := ParseFile("league.json", []byte(`{
+	"graceLevel": 2,,
+}`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 1)
+		require.Contains(t, fileErr.Problems[0], "line 2, column 18")
+	})
+
+	t.Run("empty json is rejected", func(t *testing.T) {
+		_, err := ParseFile("league.json", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown fields have lines", func(t *testing.T) {
+		_, err := ParseFile("league.yaml", []byte(`
+graceLevel: 2
+grace_level: 3
+allowed: unique
+`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 2)
+		require.Contains(t, fileErr.Problems[0], "line 3")
+		require.Contains(t, fileErr.Problems[1], "line 4")
+	})
+
+	t.Run("invalid values have locations", func(t *testing.T) {
+		_, err := ParseFile("league.yaml", []byte(`
+graceLevel: -1
+allowed: [unique, legendary]
+slots:
+  Weapon: [magic]
+  Hat: [magic]
+abyssJewels:
+  slots:
+    PassiveJewels: [shiny]
+uniques:
+  allow: [Goldrim]
+  deny: [Goldrim, "", "[Tabula"]
+`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 7)
+		require.Contains(t, fileErr.Problems[0], "graceLevel")
+		require.Contains(t, fileErr.Problems[1], "allowed[1]")
+		require.Contains(t, fileErr.Problems[2], "slots.Hat")
+		require.Contains(t, fileErr.Problems[3], "abyssJewels.slots.PassiveJewels[0]")
+		require.Contains(t, fileErr.Problems[4], "uniques.deny[0]")
+		require.Contains(t, fileErr.Problems[5], "uniques.deny[1]")
+		require.Contains(t, fileErr.Problems[6], "uniques.deny[2]: malformed pattern")
+	})
+
+	t.Run("passives", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "policy")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "tree.json"),
+			[]byte(`{"nodes": {
+	"1": {"skill": 1, "name": "Chaos Inoculation", "isKeystone": true},
+	"2": {"skill": 2, "name": "Mistress of Sacrifice", "ascendancyName": "Necromancer"}
+}}`), 0644))
+
+		p, err := ParseFile(filepath.Join(dir, "league.yaml"), []byte(`
+passives:
+  tree: tree.json
+  bannedNodes: [Chaos Inoculation]
+  maxAscendancyPoints: 0
+`))
+		require.NoError(t, err, "tree is relative to the policy file")
+
+		failures := p.Check(CharacterSnapshot{
+			Entry:    fixtureEntry(90),
+			Passives: &passives.GetPassivesResp{Hashes: []int{1, 2}},
+			When:     now,
+		})
+		require.Len(t, failures, 2)
+		require.Equal(t, items.PolicyFailureReasonBannedPassive, failures[0].Reason)
+		require.Equal(t, items.PolicyFailureReasonAscendancyPoints, failures[1].Reason)
+
+		_, err = ParseFile(filepath.Join(dir, "league.yaml"), []byte(`
+passives:
+  tree: tree.json
+  bannedNodes: [Chaos Innoculation, "Mistress *"]
+  maxPoints: -1
+`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 2)
+		require.Contains(t, fileErr.Problems[0], "passives.maxPoints")
+		require.Contains(t, fileErr.Problems[1], "passives.bannedNodes[0]: no node named")
+
+		_, err = ParseFile("league.yaml", []byte(`
+passives:
+  tree: 0.0.0
+`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "passives.tree: tree version 0.0.0 is not bundled")
+
+		_, err = ParseFile("league.yaml", []byte(`
+passives:
+  bannedNodes: [Chaos Inoculation]
+`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "passives.tree: missing")
+	})
+
+	t.Run("severities", func(t *testing.T) {
+		p, err := ParseFile("league.yaml", []byte(`
+graceLevel: 2
+warnLevel: 5
+allowed: [unique]
+exempt:
+  gems: true
+severities:
+  gems: info
+gems:
+  noVaal: true
+`))
+		require.NoError(t, err)
+
+		vaal := items.ItemResp{FrameType: items.FrameTypeGem, TypeLine: "Vaal Haste"}
+		rare := items.ItemResp{FrameType: items.FrameTypeRare, InventoryID: "Weapon",
+			SocketedItems: []items.ItemResp{vaal}}
+
+		failures := run(p, 4, rare)
+		require.Len(t, failures, 2)
+		require.Equal(t, items.SeverityWarning, failures[0].Severity)
+		require.Equal(t, RuleIDGems, failures[1].RuleID)
+		require.Equal(t, items.SeverityInfo, failures[1].Severity)
+
+		failures = run(p, 6, rare)
+		require.Len(t, failures, 2)
+		require.Equal(t, items.SeverityViolation, failures[0].Severity)
+
+		p, err = ParseFile("league.yaml", []byte(`
+severities:
+  private-profile: warning
+`))
+		require.NoError(t, err)
+		require.Equal(t, items.SeverityWarning,
+			p.Severities[items.RuleIDPrivateProfile])
+
+		_, err = ParseFile("league.yaml", []byte(`
+warnLevel: 101
+severities:
+  gems: catastrophic
+  shoes: warning
+`))
+		require.Error(t, err)
+		fileErr, ok := err.(*FileError)
+		require.True(t, ok)
+		require.Len(t, fileErr.Problems, 3)
+		require.Contains(t, fileErr.Problems[0], "warnLevel")
+		require.Contains(t, fileErr.Problems[1], "severities.gems: unknown severity")
+		require.Contains(t, fileErr.Problems[2], "severities.shoes: unknown rule")
+	})
+}
@@ -0,0 +1,67 @@
+package policy
+
+import (
+	"sort"
+
+	"github.com/Everlag/slippery-policy/items"
+)
+
+// Flasks restricts equipped flasks.
+//
+// Flasks are often exempted from Rarity; this allows leagues to
+// still place limits on them.
+type Flasks struct {
+	// Allowed, when non-empty, are the only FrameTypes flasks may have.
+	Allowed []int
+	// Max limits how many flasks of a FrameType may be equipped at once.
+	//
+	// Flasks past the limit are reported in slot order.
+	Max map[int]int
+	// NoCraftedMods disallows flasks with any crafted mods.
+	NoCraftedMods bool
+
+	// Scope should not exempt InventoryIDFlask; that disables the Rule.
+	Scope
+}
+
+// Check implements Rule
+func (r Flasks) Check(s CharacterSnapshot) []items.PolicyFailure {
+	var flasks []items.ItemResp
+	for _, i := range r.items(s) {
+		if i.InventoryID != items.InventoryIDFlask {
+			continue
+		}
+		flasks = append(flasks, i)
+	}
+	sort.Slice(flasks, func(a, b int) bool {
+		return flasks[a].X < flasks[b].X
+	})
+
+	var failures []items.PolicyFailure
+	seen := make(map[int]int, len(r.Max))
+	for _, f := range flasks {
+		if len(r.Allowed) > 0 && !containsInt(r.Allowed, f.FrameType) {
+			failures = append(failures,
+				s.ItemFailure(items.PolicyFailureReasonFlask, f))
+		}
+
+		seen[f.FrameType]++
+		if max, ok := r.Max[f.FrameType]; ok && seen[f.FrameType] > max {
+			failures = append(failures,
+				s.ItemFailure(items.PolicyFailureReasonTooManyFlasks, f))
+		}
+
+		if r.NoCraftedMods && len(f.CraftedMods) > 0 {
+			failures = append(failures,
+				s.ItemFailure(items.PolicyFailureReasonCraftedFlask, f))
+		}
+	}
+	return failures
+}
+
+// ID implements IdentifiedRule
+func (r Flasks) ID() string {
+	return RuleIDFlasks
+}
+
+var _ IdentifiedRule = Flasks{}
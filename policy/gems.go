@@ -0,0 +1,82 @@
+package policy
+
+import (
+	"strings"
+
+	"github.com/Everlag/slippery-policy/items"
+)
+
+// Gems restricts gems socketed into equipment.
+type Gems struct {
+	// MaxLevel, when present, is the highest gem level permitted.
+	MaxLevel *int
+	// MaxQuality, when present, is the highest gem quality permitted.
+	MaxQuality *int
+
+	NoAwakened         bool
+	NoVaal             bool
+	NoAlternateQuality bool
+
+	// Banned are gems which are never permitted, matched against
+	// their TypeLine as described by MatchName.
+	Banned []string
+
+	Scope
+}
+
+// Check implements Rule
+func (r Gems) Check(s CharacterSnapshot) []items.PolicyFailure {
+	var failures []items.PolicyFailure
+	for _, host := range r.items(s) {
+		for _, gem := range host.SocketedItems {
+			if gem.FrameType != items.FrameTypeGem {
+				continue
+			}
+			for _, reason := range r.check(gem) {
+				failures = append(failures,
+					s.SocketedItemFailure(reason, host, gem))
+			}
+		}
+	}
+	return failures
+}
+
+// ID implements IdentifiedRule
+func (r Gems) ID() string {
+	return RuleIDGems
+}
+
+var _ IdentifiedRule = Gems{}
+
+// check returns every reason a gem is not permitted.
+func (r Gems) check(gem items.ItemResp) []string {
+	var reasons []string
+	if MatchName(r.Banned, gem.TypeLine) {
+		reasons = append(reasons, items.PolicyFailureReasonBannedGem)
+	}
+	if r.NoAwakened &&
+		strings.HasPrefix(gem.TypeLine, items.GemPrefixAwakened) {
+		reasons = append(reasons, items.PolicyFailureReasonAwakenedGem)
+	}
+	if r.NoVaal &&
+		strings.HasPrefix(gem.TypeLine, items.GemPrefixVaal) {
+		reasons = append(reasons, items.PolicyFailureReasonVaalGem)
+	}
+	if r.NoAlternateQuality {
+		for _, prefix := range items.GemPrefixesAlternateQuality {
+			if !strings.HasPrefix(gem.TypeLine, prefix) {
+				continue
+			}
+			reasons = append(reasons,
+				items.PolicyFailureReasonAlternateQualityGem)
+			break
+		}
+	}
+	if r.MaxLevel != nil && gem.GemLevel() > *r.MaxLevel {
+		reasons = append(reasons, items.PolicyFailureReasonGemLevel)
+	}
+	if r.MaxQuality != nil && gem.Quality() > *r.MaxQuality {
+		reasons = append(reasons, items.PolicyFailureReasonGemQuality)
+	}
+	return reasons
+}
@@ -0,0 +1,97 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Everlag/slippery-policy/fixtures"
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/passives"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected failures of golden cases")
+
+// goldenWhen is when every golden case is checked, so
+// expected failures are stable.
+var goldenWhen = time.Date(2020, time.January, 15, 4, 43, 27, 0, time.UTC)
+
+// goldenFixturesDir is the fixtures directory relative to this package,
+// which expected failures are written to when updating.
+const goldenFixturesDir = "../fixtures"
+
+func TestGolden(t *testing.T) {
+	cases := fixtures.FixtureDirs(t, fixtures.GoldenDir)
+	require.NotEmpty(t, cases, "golden corpus is missing; run packr in fixtures")
+
+	for _, name := range BuiltinNames() {
+		p, err := Builtin(name)
+		require.NoError(t, err)
+
+		for _, c := range cases {
+			dir := path.Join(fixtures.GoldenDir, c)
+			t.Run(fmt.Sprintf("%s/%s", name, c), func(t *testing.T) {
+				failures := p.Check(readGoldenCase(t, dir))
+				// Always compare against a list, even when empty
+				if failures == nil {
+					failures = []items.PolicyFailure{}
+				}
+				found, err := json.MarshalIndent(failures, "", "  ")
+				require.NoError(t, err)
+
+				expected := path.Join(dir, "expected", name+".json")
+				if *update {
+					filename := filepath.Join(goldenFixturesDir,
+						filepath.FromSlash(expected))
+					require.NoError(t, os.MkdirAll(filepath.Dir(filename), 0755))
+					require.NoError(t, ioutil.WriteFile(filename,
+						append(found, '\n'), 0644))
+					return
+				}
+
+				require.True(t, fixtures.HasFixture(expected),
+					"missing %s; run with -update to create it", expected)
+				require.JSONEq(t,
+					string(fixtures.FixtureBytes(t, expected)), string(found))
+			})
+		}
+	}
+}
+
+// readGoldenCase reads the CharacterSnapshot a golden case describes.
+func readGoldenCase(t *testing.T, dir string) CharacterSnapshot {
+	s := CharacterSnapshot{
+		When: goldenWhen,
+	}
+
+	var entry ladder.Entry
+	err := json.Unmarshal(
+		fixtures.FixtureBytes(t, path.Join(dir, "entry.json")), &entry)
+	require.NoError(t, err, "decoding entry")
+	s.Entry = entry
+
+	itemsFixture := path.Join(dir, "get-items.json")
+	if fixtures.HasFixture(itemsFixture) {
+		s.Items, err = items.ReadGetItemResp(
+			bytes.NewReader(fixtures.FixtureBytes(t, itemsFixture)))
+		require.NoError(t, err, "decoding items")
+	}
+
+	passivesFixture := path.Join(dir, "get-passive-skills.json")
+	if fixtures.HasFixture(passivesFixture) {
+		s.Passives, err = passives.ReadPassives(
+			bytes.NewReader(fixtures.FixtureBytes(t, passivesFixture)))
+		require.NoError(t, err, "decoding passives")
+	}
+
+	return s
+}
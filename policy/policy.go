@@ -0,0 +1,248 @@
+package policy
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/passives"
+	"github.com/pkg/errors"
+)
+
+// CharacterSnapshot is everything we know about a Character
+// at a single point in time.
+//
+// Items and Passives are nil when they were not fetched; Rules
+// that require them MUST treat that as nothing to check.
+type CharacterSnapshot struct {
+	Entry    ladder.Entry
+	Items    *items.GetItemResp
+	Passives *passives.GetPassivesResp
+
+	When time.Time
+
+	// ItemsFetched and PassivesFetched are when Items and Passives
+	// were fetched, they are zero when not fetched.
+	ItemsFetched    time.Time
+	PassivesFetched time.Time
+	// ItemsErr and PassivesErr are why Items and Passives are nil
+	// despite being fetched.
+	ItemsErr    error
+	PassivesErr error
+	// Private is set when the Character's profile could not be
+	// read; Items and Passives are then nil.
+	Private bool
+}
+
+// Level returns the most accurate level we have for the Character.
+//
+// The get-items response is preferred as the ladder may be cached.
+func (s *CharacterSnapshot) Level() int {
+	if s.Items != nil && s.Items.Character.Level > 0 {
+		return s.Items.Character.Level
+	}
+	return s.Entry.Character.Level
+}
+
+// Failure returns a PolicyFailure for the provided reason with all
+// character context filled out.
+func (s *CharacterSnapshot) Failure(reason string) items.PolicyFailure {
+	return items.PolicyFailure{
+		Reason:         reason,
+		CharacterName:  s.Entry.Character.Name,
+		CharacterLevel: s.Level(),
+		AccountName:    s.Entry.Account.Name,
+		When:           s.When,
+	}
+}
+
+// ItemFailure returns a PolicyFailure for the provided reason
+// with both character and item context filled out.
+func (s *CharacterSnapshot) ItemFailure(reason string,
+	i items.ItemResp) items.PolicyFailure {
+
+	f := s.Failure(reason)
+	f.ItemName = i.FullName()
+	f.ItemLevel = i.Ilvl
+	f.ItemSlot = i.Slot()
+	f.Evidence = i.Evidence()
+	return f
+}
+
+// SocketedItemFailure returns a PolicyFailure for the provided reason
+// with character, item and host item context filled out.
+func (s *CharacterSnapshot) SocketedItemFailure(reason string,
+	host, i items.ItemResp) items.PolicyFailure {
+
+	f := s.ItemFailure(reason, i)
+	f.ParentItemName = host.FullName()
+	f.ParentItemSlot = host.Slot()
+	f.SocketIndex = i.Socket
+	return f
+}
+
+// NodeFailure returns a PolicyFailure for the provided reason with
+// character context filled out and the passive tree Node as the item.
+func (s *CharacterSnapshot) NodeFailure(reason string,
+	n passives.Node) items.PolicyFailure {
+
+	f := s.Failure(reason)
+	f.ItemName = n.Name
+	f.ItemSlot = items.InventoryIDPassiveTree
+	return f
+}
+
+// Rule is a single restriction evaluated against a CharacterSnapshot.
+type Rule interface {
+	// Check returns every PolicyFailure the CharacterSnapshot has
+	// under this Rule.
+	Check(s CharacterSnapshot) []items.PolicyFailure
+}
+
+// IdentifiedRule is implemented by Rules which have a RuleID; the
+// RuleID is set on every PolicyFailure the Rule returns.
+type IdentifiedRule interface {
+	Rule
+	ID() string
+}
+
+// RuleIDs of the Rules provided by this package.
+const (
+	RuleIDClasses     = "classes"
+	RuleIDRarity      = "rarity"
+	RuleIDFlasks      = "flasks"
+	RuleIDGems        = "gems"
+	RuleIDItemLists   = "lists"
+	RuleIDPassiveTree = "passives"
+)
+
+// RuleIDs are the RuleIDs of every Rule provided by this package.
+var RuleIDs = []string{
+	RuleIDClasses,
+	RuleIDRarity,
+	RuleIDFlasks,
+	RuleIDGems,
+	RuleIDItemLists,
+	RuleIDPassiveTree,
+}
+
+// RuleFunc allows a bare function to act as a Rule.
+type RuleFunc func(s CharacterSnapshot) []items.PolicyFailure
+
+// Check calls the underlying function.
+func (f RuleFunc) Check(s CharacterSnapshot) []items.PolicyFailure {
+	return f(s)
+}
+
+var _ Rule = RuleFunc(nil)
+
+// Policy is a named set of Rules which are all enforced together.
+type Policy struct {
+	Name  string
+	Rules []Rule
+
+	// Severities overrides the Severity of PolicyFailures by RuleID.
+	//
+	// PolicyFailures are otherwise a SeverityViolation.
+	Severities map[string]items.Severity
+	// WarnLevel is the character level at or below which violations
+	// are downgraded to warnings.
+	//
+	// This allows characters still wearing starter gear to be
+	// flagged without being treated as banned.
+	WarnLevel int
+}
+
+// Check returns the PolicyFailures of every Rule in the Policy,
+// in the order the Rules were declared.
+func (p Policy) Check(s CharacterSnapshot) []items.PolicyFailure {
+	var failures []items.PolicyFailure
+	for _, r := range p.Rules {
+		failures = append(failures, p.grade(s, r, r.Check(s))...)
+	}
+	return failures
+}
+
+// Grade fills out the Severity of PolicyFailures found outside of
+// the Rules of the Policy, ie of a Character which could not be
+// checked, as if a Rule had returned them.
+func (p Policy) Grade(s CharacterSnapshot,
+	failures []items.PolicyFailure) []items.PolicyFailure {
+
+	return p.grade(s, nil, failures)
+}
+
+// grade fills out the RuleID and Severity of PolicyFailures
+// returned from the provided Rule.
+//
+// PolicyFailures which already have a Severity, ie from a nested
+// Policy, are left as-is.
+func (p Policy) grade(s CharacterSnapshot, r Rule,
+	failures []items.PolicyFailure) []items.PolicyFailure {
+
+	var id string
+	if identified, ok := r.(IdentifiedRule); ok {
+		id = identified.ID()
+	}
+	for i := range failures {
+		f := &failures[i]
+		if len(f.RuleID) == 0 {
+			f.RuleID = id
+		}
+		if len(f.Severity) > 0 {
+			continue
+		}
+		f.Severity = items.SeverityViolation
+		if severity, ok := p.Severities[f.RuleID]; ok {
+			f.Severity = severity
+		}
+		if f.Severity == items.SeverityViolation && s.Level() <= p.WarnLevel {
+			f.Severity = items.SeverityWarning
+		}
+	}
+	return failures
+}
+
+// CheckLadder returns the PolicyFailures of every LadderRule in the Policy.
+//
+// Only the Entry of the CharacterSnapshot needs to be present.
+func (p Policy) CheckLadder(s CharacterSnapshot) []items.PolicyFailure {
+	var failures []items.PolicyFailure
+	for _, r := range p.Rules {
+		switch r := r.(type) {
+		case Policy:
+			failures = append(failures, p.grade(s, r, r.CheckLadder(s))...)
+		case LadderRule:
+			failures = append(failures, p.grade(s, r, r.Check(s))...)
+		}
+	}
+	return failures
+}
+
+var _ Rule = Policy{}
+
+// builtins are the Policies that ship with the tool, keyed by name.
+var builtins = map[string]func() Policy{
+	GucciHoboName: GucciHobo,
+}
+
+// Builtin returns the built-in Policy with the provided name.
+func Builtin(name string) (Policy, error) {
+	p, ok := builtins[name]
+	if !ok {
+		return Policy{}, errors.Errorf("unknown policy %q, known policies are %v",
+			name, BuiltinNames())
+	}
+	return p(), nil
+}
+
+// BuiltinNames returns the names of all built-in Policies, sorted.
+func BuiltinNames() []string {
+	names := make([]string, 0, len(builtins))
+	for n := range builtins {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,645 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/Everlag/slippery-policy/passives"
+	"github.com/stretchr/testify/require"
+)
+
+const charName = "some-character"
+const accountName = "some-account"
+
+func fixtureTime(t *testing.T) time.Time {
+	now, err := time.Parse("Mon Jan 2 15:04:05 -0700 MST 2006", "Mon Jan 2 15:04:05 -0700 MST 2006")
+	require.NoError(t, err, "sample time must parse")
+	return now
+}
+
+func fixtureEntry(charLevel int) ladder.Entry {
+	var e ladder.Entry
+	e.Character.Name = charName
+	e.Character.Level = charLevel
+	e.Account.Name = accountName
+	return e
+}
+
+func TestBuiltin(t *testing.T) {
+	t.Run("known policy", func(t *testing.T) {
+		p, err := Builtin(GucciHoboName)
+		require.NoError(t, err)
+		require.Equal(t, GucciHoboName, p.Name)
+		require.NotEmpty(t, p.Rules)
+	})
+
+	t.Run("unknown policy", func(t *testing.T) {
+		_, err := Builtin("not-a-policy")
+		require.Error(t, err)
+	})
+
+	t.Run("names include gucci hobo", func(t *testing.T) {
+		require.Contains(t, BuiltinNames(), GucciHoboName)
+	})
+}
+
+func TestPolicyCheck(t *testing.T) {
+	reason := func(r string) Rule {
+		return RuleFunc(func(s CharacterSnapshot) []items.PolicyFailure {
+			return []items.PolicyFailure{s.Failure(r)}
+		})
+	}
+
+	p := Policy{
+		Rules: []Rule{reason("first"), reason("second")},
+	}
+	failures := p.Check(CharacterSnapshot{Entry: fixtureEntry(90)})
+	require.Len(t, failures, 2)
+	require.Equal(t, "first", failures[0].Reason)
+	require.Equal(t, "second", failures[1].Reason)
+	require.Equal(t, charName, failures[0].CharacterName)
+	require.Equal(t, accountName, failures[0].AccountName)
+	require.Equal(t, 90, failures[0].CharacterLevel)
+}
+
+func TestGucciHoboItems(t *testing.T) {
+	now := fixtureTime(t)
+
+	run := func(charLevel int, equipped ...items.ItemResp) []items.PolicyFailure {
+		s := CharacterSnapshot{
+			Entry: fixtureEntry(charLevel),
+			Items: &items.GetItemResp{
+				Character: items.CharacterResp{
+					Name:  charName,
+					Level: charLevel,
+				},
+				Items: equipped,
+			},
+			When: now,
+		}
+
+		return GucciHobo().Check(s)
+	}
+
+	t.Run("happy path unique item", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			FrameType: items.FrameTypeUnique,
+		})
+		require.Empty(t, failures)
+	})
+
+	t.Run("level 2 character allowed with magic item", func(t *testing.T) {
+		failures := run(2, items.ItemResp{
+			FrameType: items.FrameTypeMagic,
+		})
+		require.Empty(t, failures)
+	})
+
+	t.Run("failure reports details", func(t *testing.T) {
+		badName := "some-item-name"
+		badSlot := "Weapon"
+		failures := run(99,
+			items.ItemResp{
+				ID:           "some-item-id",
+				Name:         badName,
+				FrameType:    items.FrameTypeNormal,
+				InventoryID:  badSlot,
+				Ilvl:         84,
+				ExplicitMods: []string{"+10 to Strength"},
+			},
+		)
+		require.NotEmpty(t, failures)
+
+		// We ensure these are exactly equivalent as it enforces
+		// that the test is updated if the code is updated.
+		exactFailure := items.PolicyFailure{
+			Reason:      items.PolicyFailureReasonItem,
+			RuleID:      RuleIDRarity,
+			Severity:    items.SeverityViolation,
+			AccountName: accountName,
+
+			CharacterLevel: 99,
+			CharacterName:  charName,
+
+			ItemName:  badName,
+			ItemLevel: 84,
+			ItemSlot:  badSlot,
+
+			When: now,
+
+			Evidence: &items.Evidence{
+				ItemID:       "some-item-id",
+				Rarity:       "normal",
+				Name:         badName,
+				ExplicitMods: []string{"+10 to Strength"},
+			},
+		}
+		require.Equal(t, exactFailure, failures[0])
+	})
+
+	t.Run("non-unique non-flask invalid", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			FrameType: items.FrameTypeMagic,
+		})
+		require.NotEmpty(t, failures)
+	})
+
+	t.Run("non-unique flask valid", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			FrameType:   items.FrameTypeMagic,
+			InventoryID: items.InventoryIDFlask,
+		})
+		require.Empty(t, failures)
+	})
+
+	t.Run("multiple items succeed when all in policy", func(t *testing.T) {
+		failures := run(99,
+			items.ItemResp{
+				FrameType:   items.FrameTypeUnique,
+				InventoryID: "Offhand",
+			},
+			items.ItemResp{
+				FrameType:   items.FrameTypeUnique,
+				InventoryID: "Gloves",
+			},
+			items.ItemResp{
+				FrameType:   items.FrameTypeUnique,
+				InventoryID: "Ring",
+			},
+			items.ItemResp{
+				FrameType:   items.FrameTypeNormal,
+				InventoryID: "Flask",
+			},
+		)
+		require.Empty(t, failures)
+	})
+
+	t.Run("multiple items fail when one not in policy", func(t *testing.T) {
+		badSlot := "Weapon"
+		failures := run(99,
+			items.ItemResp{
+				FrameType:   items.FrameTypeUnique,
+				InventoryID: "Offhand",
+			},
+			items.ItemResp{
+				FrameType:   items.FrameTypeUnique,
+				InventoryID: "Gloves",
+			},
+			items.ItemResp{
+				FrameType:   items.FrameTypeUnique,
+				InventoryID: "Ring",
+			},
+			items.ItemResp{
+				FrameType:   items.FrameTypeNormal,
+				InventoryID: "Flask",
+			},
+			items.ItemResp{
+				FrameType:   items.FrameTypeNormal,
+				InventoryID: badSlot,
+			},
+		)
+		require.NotEmpty(t, failures)
+	})
+
+	t.Run("socketed gem valid", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			FrameType: items.FrameTypeUnique,
+			SocketedItems: []items.ItemResp{
+				items.ItemResp{
+					FrameType: items.FrameTypeGem,
+				},
+			},
+		})
+		require.Empty(t, failures)
+	})
+
+	t.Run("unique socketed jewel valid", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			FrameType: items.FrameTypeUnique,
+			SocketedItems: []items.ItemResp{
+				items.ItemResp{
+					FrameType: items.FrameTypeUnique,
+				},
+			},
+		})
+		require.Empty(t, failures)
+	})
+
+	t.Run("non-unique socketed jewel invalid", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			FrameType: items.FrameTypeUnique,
+			SocketedItems: []items.ItemResp{
+				items.ItemResp{
+					FrameType: items.FrameTypeRare,
+				},
+			},
+		})
+		require.NotEmpty(t, failures)
+	})
+
+	t.Run("every socketed failure reports its host", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			Name:        "host-name",
+			TypeLine:    "host-base",
+			FrameType:   items.FrameTypeRare,
+			InventoryID: "BodyArmour",
+			SocketedItems: []items.ItemResp{
+				items.ItemResp{
+					FrameType: items.FrameTypeGem,
+					Socket:    0,
+				},
+				items.ItemResp{
+					TypeLine:  "first-jewel",
+					FrameType: items.FrameTypeRare,
+					Socket:    1,
+				},
+				items.ItemResp{
+					TypeLine:  "second-jewel",
+					FrameType: items.FrameTypeMagic,
+					Socket:    3,
+				},
+			},
+		})
+		require.Len(t, failures, 3)
+
+		require.Equal(t, "host-name host-base", failures[0].ItemName)
+		require.Equal(t, "BodyArmour", failures[0].ItemSlot)
+		require.Empty(t, failures[0].ParentItemSlot)
+
+		for i, expected := range []struct {
+			name   string
+			socket int
+		}{
+			{"first-jewel", 1},
+			{"second-jewel", 3},
+		} {
+			f := failures[i+1]
+			require.Equal(t, expected.name, f.ItemName)
+			require.Equal(t, expected.socket, f.SocketIndex)
+			require.Equal(t, "host-name host-base", f.ParentItemName)
+			require.Equal(t, "BodyArmour", f.ParentItemSlot)
+		}
+	})
+
+	t.Run("missing items are not checked", func(t *testing.T) {
+		failures := GucciHobo().Check(CharacterSnapshot{
+			Entry: fixtureEntry(99),
+			When:  now,
+		})
+		require.Empty(t, failures)
+	})
+}
+
+func TestGucciHoboPassives(t *testing.T) {
+	now := fixtureTime(t)
+
+	run := func(charLevel int, jewels ...items.ItemResp) []items.PolicyFailure {
+		s := CharacterSnapshot{
+			Entry: fixtureEntry(charLevel),
+			Passives: &passives.GetPassivesResp{
+				Items: jewels,
+			},
+			When: now,
+		}
+
+		return GucciHobo().Check(s)
+	}
+
+	t.Run("happy path unique item", func(t *testing.T) {
+		failures := run(99, items.ItemResp{
+			FrameType: items.FrameTypeUnique,
+		})
+		require.Empty(t, failures)
+	})
+
+	t.Run("failure reports details", func(t *testing.T) {
+		badName := "some-item-name"
+		badSlot := "PassiveJewels"
+		failures := run(99,
+			items.ItemResp{
+				ID:           "some-item-id",
+				Name:         badName,
+				FrameType:    items.FrameTypeNormal,
+				InventoryID:  badSlot,
+				Ilvl:         84,
+				ExplicitMods: []string{"+10 to Strength"},
+			},
+		)
+		require.NotEmpty(t, failures)
+
+		// We ensure these are exactly equivalent as it enforces
+		// that the test is updated if the code is updated.
+		exactFailure := items.PolicyFailure{
+			Reason:      items.PolicyFailureReasonItem,
+			RuleID:      RuleIDRarity,
+			Severity:    items.SeverityViolation,
+			AccountName: accountName,
+
+			CharacterLevel: 99,
+			CharacterName:  charName,
+
+			ItemName:  badName,
+			ItemLevel: 84,
+			ItemSlot:  badSlot,
+
+			When: now,
+
+			Evidence: &items.Evidence{
+				ItemID:       "some-item-id",
+				Rarity:       "normal",
+				Name:         badName,
+				ExplicitMods: []string{"+10 to Strength"},
+			},
+		}
+		require.Equal(t, exactFailure, failures[0])
+	})
+}
+
+func TestMatchName(t *testing.T) {
+	list := []string{"Tabula Rasa", "* Flask of Heat", "Skin of the Loyal"}
+
+	t.Run("exact", func(t *testing.T) {
+		require.True(t, MatchName(list, "Tabula Rasa"))
+		require.False(t, MatchName(list, "Tabula"))
+		require.False(t, MatchName(list, "tabula rasa"))
+	})
+
+	t.Run("pattern", func(t *testing.T) {
+		require.True(t, MatchName(list, "Experimenter's Basalt Flask of Heat"))
+		require.False(t, MatchName(list, "Basalt Flask"))
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		require.False(t, MatchName(nil, "Tabula Rasa"))
+	})
+}
+
+func TestItemLists(t *testing.T) {
+	lists := ItemLists{
+		AllowedUniques: []string{"Tabula Rasa", "Goldrim", "Wanderlust", "The * Flow"},
+		BannedUniques:  []string{"Tabula Rasa"},
+		BannedBases:    []string{"* Jewel"},
+	}
+
+	check := func(i items.ItemResp) []string {
+		failures := lists.Check(CharacterSnapshot{
+			Entry: fixtureEntry(90),
+			Items: &items.GetItemResp{
+				Items: []items.ItemResp{i},
+			},
+		})
+		reasons := make([]string, 0, len(failures))
+		for _, f := range failures {
+			reasons = append(reasons, f.Reason)
+		}
+		return reasons
+	}
+
+	t.Run("banned unique", func(t *testing.T) {
+		require.Equal(t, []string{items.PolicyFailureReasonBannedUnique},
+			check(items.ItemResp{FrameType: items.FrameTypeUnique,
+				Name: "Tabula Rasa", TypeLine: "Simple Robe"}))
+	})
+
+	t.Run("banned relic", func(t *testing.T) {
+		require.Equal(t, []string{items.PolicyFailureReasonBannedUnique},
+			check(items.ItemResp{FrameType: items.FrameTypeRelic,
+				Name: "Tabula Rasa", TypeLine: "Simple Robe"}))
+	})
+
+	t.Run("unique not allowed", func(t *testing.T) {
+		require.Equal(t, []string{items.PolicyFailureReasonUniqueNotAllowed},
+			check(items.ItemResp{FrameType: items.FrameTypeUnique,
+				Name: "Skin of the Loyal", TypeLine: "Sacrificial Garb"}))
+	})
+
+	t.Run("allowed unique pattern", func(t *testing.T) {
+		require.Empty(t, check(items.ItemResp{FrameType: items.FrameTypeUnique,
+			Name: "The Blood Flow", TypeLine: "Harbinger Bow"}))
+	})
+
+	t.Run("banned base pattern", func(t *testing.T) {
+		require.Equal(t, []string{items.PolicyFailureReasonBannedBase},
+			check(items.ItemResp{FrameType: items.FrameTypeMagic,
+				TypeLine: "Cobalt Jewel"}))
+	})
+
+	t.Run("non-unique names are ignored", func(t *testing.T) {
+		require.Empty(t, check(items.ItemResp{FrameType: items.FrameTypeRare,
+			Name: "Tabula Rasa", TypeLine: "Simple Robe"}))
+	})
+}
+
+func TestCheckLadder(t *testing.T) {
+	called := false
+	p := Policy{
+		Rules: []Rule{
+			RuleFunc(func(s CharacterSnapshot) []items.PolicyFailure {
+				called = true
+				return nil
+			}),
+			Policy{
+				Rules: []Rule{
+					Classes{Allowed: []string{"Scion"}},
+				},
+			},
+		},
+	}
+
+	e := fixtureEntry(90)
+	e.Character.Class = "Necromancer"
+	failures := p.CheckLadder(CharacterSnapshot{Entry: e})
+	require.Len(t, failures, 1)
+	require.Equal(t, items.PolicyFailureReasonClass, failures[0].Reason)
+	require.False(t, called, "non-ladder rules are not checked")
+}
+
+func TestPassiveTree(t *testing.T) {
+	now := fixtureTime(t)
+
+	tree := &passives.Tree{
+		Version: "test",
+		Nodes: map[int]passives.Node{
+			1: {Hash: 1, Name: "Chaos Inoculation", Type: passives.NodeTypeKeystone},
+			2: {Hash: 2, Name: "Intelligence"},
+			3: {Hash: 3, Name: "Necromancer", Ascendancy: "Necromancer",
+				AscendancyStart: true},
+			4: {Hash: 4, Name: "Mistress of Sacrifice", Ascendancy: "Necromancer"},
+		},
+	}
+	maxPoints, maxAscendancyPoints := 1, 0
+
+	r := PassiveTree{
+		Tree:                tree,
+		BannedNodes:         []string{"Chaos Inoculation"},
+		MaxPoints:           &maxPoints,
+		MaxAscendancyPoints: &maxAscendancyPoints,
+		GraceLevel:          2,
+	}
+	run := func(charLevel int, hashes ...int) []items.PolicyFailure {
+		return r.Check(CharacterSnapshot{
+			Entry: fixtureEntry(charLevel),
+			Passives: &passives.GetPassivesResp{
+				Hashes: hashes,
+			},
+			When: now,
+		})
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		require.Empty(t, run(90, 2, 3), "ascendancy start is free")
+		require.Empty(t, run(2, 1, 2, 4), "grace level")
+		require.Empty(t, r.Check(CharacterSnapshot{Entry: fixtureEntry(90)}),
+			"passives not fetched")
+	})
+
+	t.Run("banned node reports details", func(t *testing.T) {
+		failures := run(90, 1)
+		require.Equal(t, []items.PolicyFailure{{
+			Reason:         items.PolicyFailureReasonBannedPassive,
+			AccountName:    accountName,
+			CharacterLevel: 90,
+			CharacterName:  charName,
+			ItemName:       "Chaos Inoculation",
+			ItemSlot:       items.InventoryIDPassiveTree,
+			When:           now,
+		}}, failures)
+	})
+
+	t.Run("unknown hashes warn", func(t *testing.T) {
+		failures := run(90, 2, 404, 405)
+		require.Equal(t, []items.PolicyFailure{{
+			Reason:         items.PolicyFailureReasonUnknownPassives,
+			Severity:       items.SeverityWarning,
+			AccountName:    accountName,
+			CharacterLevel: 90,
+			CharacterName:  charName,
+			ItemName:       "2 passives unknown to tree test",
+			ItemSlot:       items.InventoryIDPassiveTree,
+			When:           now,
+		}}, failures)
+	})
+
+	t.Run("points", func(t *testing.T) {
+		var reasons []string
+		for _, f := range run(90, 2, 3, 4) {
+			reasons = append(reasons, f.Reason)
+		}
+		require.Equal(t, []string{
+			items.PolicyFailureReasonAscendancyPoints,
+		}, reasons)
+
+		reasons = nil
+		for _, f := range run(90, 1, 2) {
+			reasons = append(reasons, f.Reason)
+		}
+		require.Equal(t, []string{
+			items.PolicyFailureReasonBannedPassive,
+			items.PolicyFailureReasonPassivePoints,
+		}, reasons)
+	})
+}
+
+func TestSeverity(t *testing.T) {
+	now := fixtureTime(t)
+
+	rare := items.ItemResp{FrameType: items.FrameTypeRare, InventoryID: "Weapon"}
+	run := func(p Policy, charLevel int) []items.PolicyFailure {
+		return p.Check(CharacterSnapshot{
+			Entry: fixtureEntry(charLevel),
+			Items: &items.GetItemResp{
+				Character: items.CharacterResp{Name: charName, Level: charLevel},
+				Items:     []items.ItemResp{rare},
+			},
+			When: now,
+		})
+	}
+
+	t.Run("violation by default", func(t *testing.T) {
+		failures := run(GucciHobo(), 90)
+		require.Len(t, failures, 1)
+		require.Equal(t, RuleIDRarity, failures[0].RuleID)
+		require.Equal(t, items.SeverityViolation, failures[0].Severity)
+	})
+
+	t.Run("warn level downgrades violations", func(t *testing.T) {
+		p := GucciHobo()
+		p.WarnLevel = 5
+
+		failures := run(p, 5)
+		require.Len(t, failures, 1)
+		require.Equal(t, items.SeverityWarning, failures[0].Severity)
+
+		failures = run(p, 6)
+		require.Len(t, failures, 1)
+		require.Equal(t, items.SeverityViolation, failures[0].Severity)
+	})
+
+	t.Run("overrides by rule id", func(t *testing.T) {
+		p := GucciHobo()
+		p.WarnLevel = 5
+		p.Severities = map[string]items.Severity{
+			RuleIDRarity: items.SeverityInfo,
+		}
+
+		for _, level := range []int{3, 90} {
+			failures := run(p, level)
+			require.Len(t, failures, 1)
+			require.Equal(t, items.SeverityInfo, failures[0].Severity)
+		}
+	})
+
+	t.Run("nested policies keep their severity", func(t *testing.T) {
+		inner := GucciHobo()
+		inner.Severities = map[string]items.Severity{
+			RuleIDRarity: items.SeverityWarning,
+		}
+		outer := Policy{Rules: []Rule{inner}}
+
+		failures := run(outer, 90)
+		require.Len(t, failures, 1)
+		require.Equal(t, RuleIDRarity, failures[0].RuleID)
+		require.Equal(t, items.SeverityWarning, failures[0].Severity)
+	})
+
+	t.Run("ladder rules are graded", func(t *testing.T) {
+		p := Policy{
+			Rules:     []Rule{Classes{Banned: []string{"Witch"}}},
+			WarnLevel: 10,
+		}
+		entry := fixtureEntry(4)
+		entry.Character.Class = "Witch"
+
+		failures := p.CheckLadder(CharacterSnapshot{Entry: entry, When: now})
+		require.Len(t, failures, 1)
+		require.Equal(t, RuleIDClasses, failures[0].RuleID)
+		require.Equal(t, items.SeverityWarning, failures[0].Severity)
+	})
+	t.Run("failures outside rules are graded", func(t *testing.T) {
+		p := GucciHobo()
+		p.WarnLevel = 5
+		private := items.PolicyFailure{
+			Reason: items.PolicyFailureReasonPrivateProfile,
+			RuleID: items.RuleIDPrivateProfile,
+		}
+		grade := func(charLevel int) items.Severity {
+			failures := p.Grade(CharacterSnapshot{
+				Entry: fixtureEntry(charLevel),
+				When:  now,
+			}, []items.PolicyFailure{private})
+			require.Len(t, failures, 1)
+			require.Equal(t, items.RuleIDPrivateProfile, failures[0].RuleID)
+			return failures[0].Severity
+		}
+
+		require.Equal(t, items.SeverityViolation, grade(90))
+		require.Equal(t, items.SeverityWarning, grade(3))
+
+		p.Severities = map[string]items.Severity{
+			items.RuleIDPrivateProfile: items.SeverityInfo,
+		}
+		require.Equal(t, items.SeverityInfo, grade(90))
+	})
+}
@@ -0,0 +1,262 @@
+package policy
+
+import (
+	"path"
+	"strings"
+
+	"github.com/Everlag/slippery-policy/items"
+)
+
+// GucciHoboName is the name of the GucciHobo Policy.
+const GucciHoboName = "gucci-hobo"
+
+// GucciHobo returns the 'Gucci Hobo' Policy:
+//
+// - No non-unique, non-flask items equipped(past level 2)
+// - No non-unique jewels in the passive tree
+func GucciHobo() Policy {
+	return Policy{
+		Name: GucciHoboName,
+		Rules: []Rule{
+			Rarity{
+				FrameTypes: FrameTypes{
+					Allowed: []int{
+						items.FrameTypeUnique,
+						// Relics are fancy uniques
+						items.FrameTypeRelic,
+						// Gems are always okay
+						items.FrameTypeGem,
+					},
+				},
+				Scope: Scope{
+					ExemptSlots: []string{items.InventoryIDFlask},
+					// The game requires you to use a piece of equipment to
+					// get past the twilight strand.
+					//
+					// Characters of that level are also not able to use
+					// passive tree jewels.
+					GraceLevel: 2,
+				},
+			},
+		},
+	}
+}
+
+// Scope limits which items of a CharacterSnapshot an item-level
+// Rule applies to.
+type Scope struct {
+	// ExemptSlots are InventoryIDs which are not checked at all,
+	// including their sockets.
+	ExemptSlots []string
+	// GraceLevel is the character level at or below which
+	// nothing is checked.
+	GraceLevel int
+}
+
+// items returns every top-level item in scope; this includes
+// both equipment and passive tree jewels.
+//
+// Socketed items are not flattened out.
+func (sc Scope) items(s CharacterSnapshot) []items.ItemResp {
+	if s.Level() <= sc.GraceLevel {
+		return nil
+	}
+
+	var found []items.ItemResp
+	if s.Items != nil {
+		found = append(found, s.Items.Items...)
+	}
+	if s.Passives != nil {
+		found = append(found, s.Passives.Items...)
+	}
+
+	inScope := found[:0]
+	for _, i := range found {
+		if containsString(sc.ExemptSlots, i.InventoryID) {
+			continue
+		}
+		inScope = append(inScope, i)
+	}
+	return inScope
+}
+
+// FrameTypes are the FrameTypes permitted for items, with
+// overrides for specific slots.
+type FrameTypes struct {
+	// Allowed are the FrameTypes that are permitted.
+	Allowed []int
+	// Slots overrides Allowed for specific InventoryIDs.
+	//
+	// Socketed items use the InventoryID of the item they are socketed in
+	// while jewels in the passive tree use InventoryIDPassiveJewels.
+	Slots map[string][]int
+}
+
+// For returns the FrameTypes permitted in the provided InventoryID
+func (f FrameTypes) For(slot string) []int {
+	if allowed, ok := f.Slots[slot]; ok {
+		return allowed
+	}
+	return f.Allowed
+}
+
+// Rarity requires every item, and everything socketed into it,
+// have an allowed FrameType.
+type Rarity struct {
+	FrameTypes
+	// AbyssJewels, when present, replaces FrameTypes for abyss jewels.
+	//
+	// This allows abyss jewels to be treated differently when socketed
+	// into equipment than when socketed into the passive tree.
+	AbyssJewels *FrameTypes
+	// AllowedBases are base types that are permitted regardless
+	// of their FrameType.
+	//
+	// These are matched as described by MatchName.
+	AllowedBases []string
+
+	Scope
+}
+
+// Check implements Rule
+func (r Rarity) Check(s CharacterSnapshot) []items.PolicyFailure {
+	var failures []items.PolicyFailure
+	for _, host := range r.items(s) {
+		if !r.permits(host.InventoryID, host) {
+			failures = append(failures,
+				s.ItemFailure(items.PolicyFailureReasonItem, host))
+		}
+
+		for _, socketed := range host.SocketedItems {
+			if r.permits(host.InventoryID, socketed) {
+				continue
+			}
+			failures = append(failures, s.SocketedItemFailure(
+				items.PolicyFailureReasonItem, host, socketed))
+		}
+	}
+	return failures
+}
+
+// ID implements IdentifiedRule
+func (r Rarity) ID() string {
+	return RuleIDRarity
+}
+
+var _ IdentifiedRule = Rarity{}
+
+// permits returns true when the item is allowed in the provided slot
+func (r Rarity) permits(slot string, i items.ItemResp) bool {
+	allowed := r.For(slot)
+	if i.AbyssJewel && r.AbyssJewels != nil {
+		allowed = r.AbyssJewels.For(slot)
+	}
+	return containsInt(allowed, i.FrameType) ||
+		MatchName(r.AllowedBases, i.TypeLine)
+}
+
+// ItemLists restricts items by their unique name or base type.
+//
+// Every list is matched as described by MatchName.
+type ItemLists struct {
+	// AllowedUniques, when non-empty, are the only uniques permitted.
+	AllowedUniques []string
+	// BannedUniques are uniques which are never permitted.
+	BannedUniques []string
+	// BannedBases are base types which are never permitted,
+	// regardless of FrameType.
+	BannedBases []string
+
+	Scope
+}
+
+// Check implements Rule
+func (r ItemLists) Check(s CharacterSnapshot) []items.PolicyFailure {
+	var failures []items.PolicyFailure
+	for _, host := range r.items(s) {
+		if reason, failed := r.check(host); failed {
+			failures = append(failures, s.ItemFailure(reason, host))
+		}
+
+		for _, socketed := range host.SocketedItems {
+			reason, failed := r.check(socketed)
+			if !failed {
+				continue
+			}
+			failures = append(failures,
+				s.SocketedItemFailure(reason, host, socketed))
+		}
+	}
+	return failures
+}
+
+// ID implements IdentifiedRule
+func (r ItemLists) ID() string {
+	return RuleIDItemLists
+}
+
+var _ IdentifiedRule = ItemLists{}
+
+// check returns the reason an item is not permitted.
+func (r ItemLists) check(i items.ItemResp) (string, bool) {
+	if MatchName(r.BannedBases, i.TypeLine) {
+		return items.PolicyFailureReasonBannedBase, true
+	}
+
+	if i.FrameType != items.FrameTypeUnique &&
+		i.FrameType != items.FrameTypeRelic {
+		return "", false
+	}
+
+	switch {
+	case MatchName(r.BannedUniques, i.Name):
+		return items.PolicyFailureReasonBannedUnique, true
+	case len(r.AllowedUniques) > 0 &&
+		!MatchName(r.AllowedUniques, i.Name):
+		return items.PolicyFailureReasonUniqueNotAllowed, true
+	}
+
+	return "", false
+}
+
+// namePatternChars are the characters which make a name a pattern
+// rather than an exact match.
+const namePatternChars = "*?["
+
+// MatchName returns true when the name is present in the provided list.
+//
+// Entries containing any of *?[ are treated as patterns, as in path.Match,
+// ie "* Flask of Heat". All other entries must match exactly.
+func MatchName(list []string, name string) bool {
+	for _, entry := range list {
+		if !strings.ContainsAny(entry, namePatternChars) {
+			if entry == name {
+				return true
+			}
+			continue
+		}
+		// Malformed patterns are rejected when loading a File
+		if matched, _ := path.Match(entry, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(haystack []int, needle int) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
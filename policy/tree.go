@@ -0,0 +1,75 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/passives"
+)
+
+// PassiveTree restricts the allocated passive tree of a Character.
+//
+// The Tree should match the version of the league being checked; hashes
+// not present in the Tree cannot be checked, so they are reported as a
+// single SeverityWarning.
+type PassiveTree struct {
+	Tree *passives.Tree
+
+	// BannedNodes are nodes which may never be allocated, matched
+	// against their name as described by MatchName, ie "Chaos Inoculation"
+	BannedNodes []string
+	// MaxPoints, when present, is the most passive points which may
+	// be spent on the main tree.
+	MaxPoints *int
+	// MaxAscendancyPoints, when present, is the most ascendancy
+	// points which may be spent.
+	MaxAscendancyPoints *int
+
+	// GraceLevel is the character level at or below which
+	// nothing is checked.
+	GraceLevel int
+}
+
+// Check implements Rule
+func (r PassiveTree) Check(s CharacterSnapshot) []items.PolicyFailure {
+	if s.Passives == nil || r.Tree == nil || s.Level() <= r.GraceLevel {
+		return nil
+	}
+
+	allocation := r.Tree.Allocate(s.Passives.Hashes)
+
+	var failures []items.PolicyFailure
+	if len(allocation.Unknown) > 0 {
+		f := s.Failure(items.PolicyFailureReasonUnknownPassives)
+		f.ItemName = fmt.Sprintf("%d passives unknown to tree %s",
+			len(allocation.Unknown), r.Tree.Version)
+		f.ItemSlot = items.InventoryIDPassiveTree
+		// Banned nodes may have been missed rather than broken
+		f.Severity = items.SeverityWarning
+		failures = append(failures, f)
+	}
+	for _, node := range allocation.Nodes {
+		if !MatchName(r.BannedNodes, node.Name) {
+			continue
+		}
+		failures = append(failures,
+			s.NodeFailure(items.PolicyFailureReasonBannedPassive, node))
+	}
+	if r.MaxPoints != nil && allocation.Points > *r.MaxPoints {
+		failures = append(failures,
+			s.Failure(items.PolicyFailureReasonPassivePoints))
+	}
+	if r.MaxAscendancyPoints != nil &&
+		allocation.AscendancyPoints > *r.MaxAscendancyPoints {
+		failures = append(failures,
+			s.Failure(items.PolicyFailureReasonAscendancyPoints))
+	}
+	return failures
+}
+
+// ID implements IdentifiedRule
+func (r PassiveTree) ID() string {
+	return RuleIDPassiveTree
+}
+
+var _ IdentifiedRule = PassiveTree{}
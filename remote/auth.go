@@ -0,0 +1,136 @@
+package remote
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SessionCookieName is the cookie GGG uses to identify a logged in session.
+const SessionCookieName = "POESESSID"
+
+// CredentialEnv is the environment variable a Credential is read
+// from when no file is provided.
+const CredentialEnv = "SLIPPERY_CREDENTIAL"
+
+// Credential authenticates requests as an account, allowing the private
+// profiles of players who granted that account access to be read.
+//
+// Credentials are never included in their String form, so they are
+// safe to log.
+type Credential struct {
+	// SessionID is the value of a POESESSID cookie.
+	SessionID string
+	// Token is an OAuth bearer token.
+	Token string
+}
+
+// ParseCredential parses a Credential formatted as either
+// POESESSID=<session id> or Bearer <token>.
+func ParseCredential(value string) (Credential, error) {
+	value = strings.TrimSpace(value)
+	switch {
+	case strings.HasPrefix(value, SessionCookieName+"="):
+		id := strings.TrimPrefix(value, SessionCookieName+"=")
+		if len(id) == 0 {
+			return Credential{}, errors.New("empty session id")
+		}
+		return Credential{SessionID: id}, nil
+	case strings.HasPrefix(value, "Bearer "):
+		token := strings.TrimSpace(strings.TrimPrefix(value, "Bearer "))
+		if len(token) == 0 {
+			return Credential{}, errors.New("empty bearer token")
+		}
+		return Credential{Token: token}, nil
+	}
+	return Credential{}, errors.Errorf(
+		"expected %s=<session id> or Bearer <token>", SessionCookieName)
+}
+
+// LoadCredential reads the Credential from the provided file or,
+// if no file is provided, CredentialEnv.
+//
+// The zero Credential is returned when neither is present.
+func LoadCredential(file string) (Credential, error) {
+	value := os.Getenv(CredentialEnv)
+	if len(file) > 0 {
+		blob, err := ioutil.ReadFile(file)
+		if err != nil {
+			return Credential{}, errors.Wrap(err, "reading credential file")
+		}
+		value = string(blob)
+	}
+	if len(strings.TrimSpace(value)) == 0 {
+		return Credential{}, nil
+	}
+	return ParseCredential(value)
+}
+
+// IsZero returns true when the Credential authenticates nothing.
+func (c Credential) IsZero() bool {
+	return len(c.SessionID) == 0 && len(c.Token) == 0
+}
+
+// authenticatedEndpoints are the character-window endpoints which
+// read private profiles; the Credential is sent to no others.
+var authenticatedEndpoints = map[string]bool{
+	EndpointItems:      true,
+	EndpointPassives:   true,
+	EndpointCharacters: true,
+}
+
+// apply authenticates the request.
+//
+// The Credential is only sent over https, or plain http to a loopback
+// stand-in, so it cannot be read off the wire.
+func (c Credential) apply(req *http.Request) error {
+	if c.IsZero() {
+		return nil
+	}
+	if !secureURL(req.URL) {
+		return errors.Errorf("refusing to send credential to %s over %s",
+			req.URL.Host, req.URL.Scheme)
+	}
+
+	if len(c.SessionID) > 0 {
+		req.AddCookie(&http.Cookie{Name: SessionCookieName, Value: c.SessionID})
+	}
+	if len(c.Token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	return nil
+}
+
+// secureURL returns true when the URL is https or a loopback address.
+func secureURL(u *url.URL) bool {
+	if u.Scheme == "https" {
+		return true
+	}
+	host := u.Hostname()
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// String describes the kind of Credential, redacting its value.
+func (c Credential) String() string {
+	switch {
+	case len(c.SessionID) > 0:
+		return SessionCookieName + "=<redacted>"
+	case len(c.Token) > 0:
+		return "Bearer <redacted>"
+	}
+	return "<none>"
+}
+
+// GoString redacts the Credential when formatted with %#v
+func (c Credential) GoString() string {
+	return c.String()
+}
@@ -0,0 +1,100 @@
+package remote
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredential(t *testing.T) {
+	t.Run("session", func(t *testing.T) {
+		c, err := ParseCredential("POESESSID=some-session\n")
+		require.NoError(t, err)
+		require.Equal(t, Credential{SessionID: "some-session"}, c)
+	})
+
+	t.Run("bearer", func(t *testing.T) {
+		c, err := ParseCredential("Bearer some-token")
+		require.NoError(t, err)
+		require.Equal(t, Credential{Token: "some-token"}, c)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := ParseCredential("some-session")
+		require.Error(t, err)
+		_, err = ParseCredential("POESESSID=")
+		require.Error(t, err)
+	})
+
+	t.Run("redacted", func(t *testing.T) {
+		for _, c := range []Credential{
+			{SessionID: "some-session"},
+			{Token: "some-token"},
+		} {
+			for _, format := range []string{"%s", "%v", "%+v", "%#v"} {
+				formatted := fmt.Sprintf(format, c)
+				require.NotContains(t, formatted, "some-session")
+				require.NotContains(t, formatted, "some-token")
+			}
+		}
+	})
+
+	t.Run("load file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "credential")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		file := filepath.Join(dir, "credential")
+		require.NoError(t, ioutil.WriteFile(file,
+			[]byte("POESESSID=some-session\n"), 0600))
+
+		c, err := LoadCredential(file)
+		require.NoError(t, err)
+		require.Equal(t, Credential{SessionID: "some-session"}, c)
+	})
+
+	t.Run("load env", func(t *testing.T) {
+		defer os.Unsetenv(CredentialEnv)
+		require.NoError(t, os.Setenv(CredentialEnv, "Bearer some-token"))
+
+		c, err := LoadCredential("")
+		require.NoError(t, err)
+		require.Equal(t, Credential{Token: "some-token"}, c)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		c := Credential{SessionID: "some-session"}
+		for _, target := range []string{
+			"https://www.pathofexile.com/character-window/get-items",
+			"http://localhost:8080/character-window/get-items",
+			"http://127.0.0.1:8080/character-window/get-items",
+			"http://[::1]:8080/character-window/get-items",
+		} {
+			req, err := http.NewRequest("GET", target, nil)
+			require.NoError(t, err)
+			require.NoError(t, c.apply(req), target)
+			cookie, err := req.Cookie(SessionCookieName)
+			require.NoError(t, err, target)
+			require.Equal(t, "some-session", cookie.Value)
+		}
+	})
+
+	t.Run("apply refuses plain http", func(t *testing.T) {
+		c := Credential{Token: "some-token"}
+		req, err := http.NewRequest("GET", "http://api.pathofexile.com/ladders", nil)
+		require.NoError(t, err)
+		require.Error(t, c.apply(req))
+		require.Empty(t, req.Header.Get("Authorization"))
+	})
+
+	t.Run("load nothing", func(t *testing.T) {
+		c, err := LoadCredential("")
+		require.NoError(t, err)
+		require.True(t, c.IsZero())
+	})
+}
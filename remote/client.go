@@ -0,0 +1,390 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// GetItemsURL is the remote endpoint to fetch character information from
+const GetItemsURL = "https://www.pathofexile.com/character-window/get-items"
+
+// GetPassivesURL is the remote endpoint to fetch passives information from
+const GetPassivesURL = "https://www.pathofexile.com/character-window/get-passive-skills"
+
+// GetCharactersURL is the remote endpoint to fetch every character of an account from
+const GetCharactersURL = "https://www.pathofexile.com/character-window/get-characters"
+
+// GetLadderURL is the remote endpoint to fetch ladder information
+//
+// Note that the ladder name, ie Slippery%20Hobo%20League%20(PL5357),
+// must be appended at the end of the path with a separating slash.
+const GetLadderURL = "http://api.pathofexile.com/ladders"
+
+// DefaultUserAgent identifies us to GGG, as they request of every
+// tool using their API.
+const DefaultUserAgent = "slippery-policy (+https://github.com/Everlag/slippery-policy)"
+
+// Client fetches from the GGG API, or anything which looks like it.
+//
+// Clients are safe for concurrent use.
+type Client struct {
+	// ItemsURL, PassivesURL, CharactersURL and LadderURL are the
+	// endpoints fetched from.
+	//
+	// These default to GetItemsURL, GetPassivesURL, GetCharactersURL
+	// and GetLadderURL.
+	ItemsURL      string
+	PassivesURL   string
+	CharactersURL string
+	LadderURL     string
+
+	HTTP      *http.Client
+	UserAgent string
+	// Credential, when present, authenticates every request.
+	Credential Credential
+
+	Limiters *Limiters
+	// Retry describes how transient failures are retried, this
+	// defaults to DefaultRetry.
+	Retry Retry
+}
+
+// NewClient returns a Client against the GGG API which limits every
+// fetch using the provided Limiters.
+func NewClient(limiters *Limiters) *Client {
+	return &Client{
+		ItemsURL:      GetItemsURL,
+		PassivesURL:   GetPassivesURL,
+		CharactersURL: GetCharactersURL,
+		LadderURL:     GetLadderURL,
+		HTTP:          http.DefaultClient,
+		UserAgent:     DefaultUserAgent,
+		Limiters:      limiters,
+		Retry:         DefaultRetry,
+	}
+}
+
+// do performs the request to the provided endpoint with our user agent
+// and, for character-window endpoints, our Credential, cancelling it
+// when the context is done.
+func (c *Client) do(ctx context.Context, endpoint string,
+	req *http.Request) (*http.Response, error) {
+
+	req.Header.Set("User-Agent", c.UserAgent)
+	if authenticatedEndpoints[endpoint] {
+		if err := c.Credential.apply(req); err != nil {
+			return nil, err
+		}
+	}
+	return c.HTTP.Do(req.WithContext(ctx))
+}
+
+// observe parses the RateLimitState of a response from the provided
+// endpoint and records it with our Limiters.
+//
+// This is the only place a response's RateLimitState is recorded. A
+// 429 is always recorded as saturated, so we aggressively back off
+// even when it lacks rate limit information.
+func (c *Client) observe(endpoint string, resp *http.Response) error {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		c.Limiters.Observe(endpoint, tooManyRequestsState(resp.Header))
+		return nil
+	}
+
+	s, err := ParseRateLimitState(resp.Header)
+	if err != nil {
+		return err
+	}
+	c.Limiters.Observe(endpoint, s)
+	return nil
+}
+
+// fetch runs the request built by the provided function under the
+// Limiter of the endpoint, retrying transient failures, and returns
+// the body of the response.
+//
+// The request is built again for every attempt.
+func (c *Client) fetch(ctx context.Context, logContext *zap.Logger,
+	endpoint string, build func() (*http.Request, error)) ([]byte, error) {
+
+	var body []byte
+	err := c.Retry.Do(ctx, logContext, func() error {
+		// Our Limiter may change as the endpoint's policy is discovered
+		l := c.Limiters.For(endpoint)
+		return l.Run(ctx, func() (RateLimitState, error) {
+			req, err := build()
+			if err != nil {
+				return RateLimitState{}, errors.Wrap(err, "building request")
+			}
+
+			resp, err := c.do(ctx, endpoint, req)
+			if err != nil {
+				return RateLimitState{}, errors.Wrap(err, "fetching remote")
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				logContext.Debug("fetch not OK",
+					zap.Int("status code", resp.StatusCode),
+					zap.String("status text", resp.Status))
+
+				// The request still counted against our limits,
+				// ie for private profiles, or was rate limited.
+				c.observe(endpoint, resp)
+				return RateLimitState{}, responseError(resp)
+			}
+
+			buf := bytes.NewBuffer(make([]byte, 0, 6*1024))
+			_, err = buf.ReadFrom(resp.Body)
+			if err != nil {
+				return RateLimitState{},
+					errors.Wrap(err, "failed to read response body")
+			}
+			body = buf.Bytes()
+
+			// Our state is recorded by observe rather than by Run
+			err = c.observe(endpoint, resp)
+			if err != nil && endpoint == EndpointPassives {
+				// Passives have historically not been rate limited,
+				// so there may be nothing to observe.
+				logContext.Debug("passives without rate limit information",
+					zap.Error(err))
+				return RateLimitState{}, nil
+			}
+			return RateLimitState{}, err
+		})
+	})
+	if err != nil {
+		if err == ErrLimiterHalted || err == ctx.Err() {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to run fetch")
+	}
+
+	return body, nil
+}
+
+// FetchCharacter resolves the ItemsURL for a provided character under a specified account.
+//
+// This returns the contents of the body fetched; the fetch is abandoned
+// when the context is done.
+func (c *Client) FetchCharacter(ctx context.Context, logContext *zap.Logger,
+	accountName, characterName string) ([]byte, error) {
+
+	form := url.Values{"accountName": {accountName}, "character": {characterName}}
+
+	return c.fetch(ctx, logContext, EndpointItems, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", c.ItemsURL,
+			strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req, nil
+	})
+}
+
+// Ladder types, as accepted by the ladder endpoint.
+const (
+	LadderTypeLeague    = "league"
+	LadderTypeLabyrinth = "labyrinth"
+)
+
+// Ladder sorts, as accepted by the ladder endpoint for the league
+// ladder type.
+const (
+	LadderSortDepth     = "depth"
+	LadderSortDepthSolo = "depthsolo"
+)
+
+// Labyrinth difficulties, as accepted by the ladder endpoint for the
+// labyrinth ladder type.
+const (
+	LabyrinthNormal    = "Normal"
+	LabyrinthCruel     = "Cruel"
+	LabyrinthMerciless = "Merciless"
+	LabyrinthEternal   = "Eternal"
+)
+
+// LabyrinthDifficulties is every labyrinth difficulty.
+var LabyrinthDifficulties = []string{
+	LabyrinthNormal, LabyrinthCruel, LabyrinthMerciless, LabyrinthEternal,
+}
+
+// LadderOptions selects which ladder of the league FetchLadder fetches;
+// the zero value is the experience ladder.
+//
+// The pvp ladders are not supported as they belong to pvp seasons
+// rather than leagues.
+type LadderOptions struct {
+	// Type is the kind of ladder, ie LadderTypeLabyrinth; the
+	// league ladder when empty.
+	Type string
+	// Sort orders the league ladder other than by experience, ie by
+	// delve depth with LadderSortDepth
+	Sort string
+	// Track includes dead characters, marked as such, in
+	// leagues where characters can die.
+	Track bool
+	// AccountName restricts the ladder to characters of
+	// a single account.
+	AccountName string
+
+	// Difficulty and Start select a labyrinth ladder; its difficulty,
+	// ie LabyrinthMerciless, and any time on the day of its race.
+	Difficulty string
+	Start      time.Time
+}
+
+// Validate returns an error when the options do not select a ladder
+// the endpoint serves.
+func (o LadderOptions) Validate() error {
+	switch o.Sort {
+	case "", LadderSortDepth, LadderSortDepthSolo:
+	default:
+		return errors.Errorf("unknown ladder sort %q, expected %s or %s",
+			o.Sort, LadderSortDepth, LadderSortDepthSolo)
+	}
+
+	switch o.Type {
+	case "", LadderTypeLeague:
+		if len(o.Difficulty) > 0 || !o.Start.IsZero() {
+			return errors.New("difficulty and start only apply to the labyrinth ladder")
+		}
+	case LadderTypeLabyrinth:
+		if len(o.Sort) > 0 {
+			return errors.New("sort only applies to the league ladder")
+		}
+		known := false
+		for _, d := range LabyrinthDifficulties {
+			known = known || d == o.Difficulty
+		}
+		if !known {
+			return errors.Errorf("unknown labyrinth difficulty %q, expected one of %v",
+				o.Difficulty, LabyrinthDifficulties)
+		}
+		if o.Start.IsZero() {
+			return errors.New("the labyrinth ladder requires the day of its race")
+		}
+	default:
+		return errors.Errorf("unknown ladder type %q, expected %s or %s",
+			o.Type, LadderTypeLeague, LadderTypeLabyrinth)
+	}
+	return nil
+}
+
+// values returns the query parameters selecting the ladder.
+func (o LadderOptions) values() url.Values {
+	v := url.Values{}
+	if len(o.Type) > 0 {
+		v.Set("type", o.Type)
+	}
+	if len(o.Sort) > 0 {
+		v.Set("sort", o.Sort)
+	}
+	if o.Track {
+		v.Set("track", "true")
+	}
+	if len(o.AccountName) > 0 {
+		v.Set("accountName", o.AccountName)
+	}
+	if len(o.Difficulty) > 0 {
+		v.Set("difficulty", o.Difficulty)
+	}
+	if !o.Start.IsZero() {
+		// Races are daily, starting at midnight UTC
+		day := o.Start.UTC().Truncate(24 * time.Hour)
+		v.Set("start", strconv.FormatInt(day.Unix(), 10))
+	}
+	return v
+}
+
+func ladderURL(baseURL string, cursor ladder.PageCursor,
+	ladderName string, opts LadderOptions) (*url.URL, error) {
+
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "selecting ladder")
+	}
+
+	// Make sure we're not putting anything funky into our URL
+	ladderName = url.PathEscape(ladderName)
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing base URL")
+	}
+	path := path.Join(base.Path, ladderName)
+	endpoint, err := base.Parse(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing updated path")
+	}
+
+	// Update with the query parameters we have
+	query := opts.values()
+	query.Set("offset", strconv.Itoa(cursor.Offset))
+	query.Set("limit", strconv.Itoa(cursor.Limit))
+	endpoint.RawQuery = query.Encode()
+
+	return endpoint, nil
+}
+
+// FetchLadder resolves the LadderURL for a provided ladder page,
+// of the ladder selected by the provided LadderOptions.
+//
+// This returns the contents of the body fetched; the fetch is abandoned
+// when the context is done.
+func (c *Client) FetchLadder(ctx context.Context, logContext *zap.Logger,
+	cursor ladder.PageCursor, ladderName string,
+	opts LadderOptions) ([]byte, error) {
+
+	ladderURL, err := ladderURL(c.LadderURL, cursor, ladderName, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "computing URL")
+	}
+	endpoint := ladderURL.String()
+
+	return c.fetch(ctx, logContext, EndpointLadder, func() (*http.Request, error) {
+		return http.NewRequest("GET", endpoint, nil)
+	})
+}
+
+// FetchPassives resolves the PassivesURL for a provided character under a specified account.
+//
+// This returns the contents of the body fetched; the fetch is abandoned
+// when the context is done.
+func (c *Client) FetchPassives(ctx context.Context, logContext *zap.Logger,
+	accountName, characterName string) ([]byte, error) {
+
+	form := url.Values{"accountName": {accountName}, "character": {characterName}}
+	endpoint := c.PassivesURL + "?" + form.Encode()
+
+	return c.fetch(ctx, logContext, EndpointPassives, func() (*http.Request, error) {
+		return http.NewRequest("GET", endpoint, nil)
+	})
+}
+
+// FetchCharacters resolves the CharactersURL for a specified account,
+// listing every character of the account across all leagues.
+//
+// This returns the contents of the body fetched; the fetch is abandoned
+// when the context is done.
+func (c *Client) FetchCharacters(ctx context.Context, logContext *zap.Logger,
+	accountName string) ([]byte, error) {
+
+	form := url.Values{"accountName": {accountName}}
+	endpoint := c.CharactersURL + "?" + form.Encode()
+
+	return c.fetch(ctx, logContext, EndpointCharacters, func() (*http.Request, error) {
+		return http.NewRequest("GET", endpoint, nil)
+	})
+}
@@ -0,0 +1,380 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Everlag/slippery-policy/ladder"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testClient returns a Client with every endpoint pointed at the
+// provided handler, alongside a function to shut the handler down.
+func testClient(t *testing.T, handler http.HandlerFunc) (*Client, func()) {
+	s := httptest.NewServer(handler)
+
+	c := NewClient(NewLimiters(time.Microsecond, time.Millisecond, zap.NewNop()))
+	c.ItemsURL = s.URL + "/character-window/get-items"
+	c.PassivesURL = s.URL + "/character-window/get-passive-skills"
+	c.CharactersURL = s.URL + "/character-window/get-characters"
+	c.LadderURL = s.URL + "/ladders"
+	c.HTTP = s.Client()
+	c.UserAgent = "some-agent"
+	c.Retry = Retry{Attempts: 3, Base: time.Microsecond, Max: time.Millisecond}
+	return c, s.Close
+}
+
+// recordRequests returns a handler which sends a copy of every request,
+// with its form parsed, to the returned channel before calling the
+// provided handler.
+//
+// This allows requests to be checked from the test goroutine; require
+// cannot stop a test from a handler.
+func recordRequests(handler http.HandlerFunc) (http.HandlerFunc, <-chan *http.Request) {
+	requests := make(chan *http.Request, 10)
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		requests <- r.Clone(context.Background())
+		handler(w, r)
+	}, requests
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	t.Run("fetch character", func(t *testing.T) {
+		handler, requests := recordRequests(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+			w.Write([]byte(`{"items": []}`))
+		})
+		c, done := testClient(t, handler)
+		defer done()
+
+		body, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.NoError(t, err)
+		require.Equal(t, `{"items": []}`, string(body))
+
+		r := <-requests
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/character-window/get-items", r.URL.Path)
+		require.Equal(t, "some-agent", r.UserAgent())
+		require.Equal(t, "some-account", r.FormValue("accountName"))
+		require.Equal(t, "some-character", r.FormValue("character"))
+	})
+
+	t.Run("fetch character private", func(t *testing.T) {
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+		defer done()
+
+		_, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.Error(t, err)
+		require.Equal(t, ErrPrivateProfile, errors.Cause(err))
+	})
+
+	t.Run("fetch character private observes limits", func(t *testing.T) {
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Rate-Limit-Policy", "some-policy")
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "40:60:0")
+			w.WriteHeader(http.StatusForbidden)
+		})
+		defer done()
+
+		_, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.Equal(t, ErrPrivateProfile, errors.Cause(err))
+
+		state := c.Limiters.For(EndpointItems).State()
+		require.Equal(t, "some-policy", state.Policy)
+		require.Equal(t, 40, state.Current)
+		require.Equal(t, 45, state.Max)
+	})
+
+	t.Run("rate limited registers policy", func(t *testing.T) {
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Rate-Limit-Policy", "some-policy")
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "46:60:60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		defer done()
+		c.Retry = Retry{}
+
+		_, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.IsType(t, ErrRateLimited{}, errors.Cause(err))
+
+		c.Limiters.Lock()
+		shared := c.Limiters.byPolicy["some-policy"]
+		c.Limiters.Unlock()
+		require.NotNil(t, shared, "policy was not registered")
+		require.Equal(t, shared, c.Limiters.For(EndpointItems))
+
+		state := shared.State()
+		require.Equal(t, "some-policy", state.Policy)
+		require.True(t, state.Rel() >= BackoffThreshold, "did not back off")
+	})
+
+	t.Run("fetch character authenticated", func(t *testing.T) {
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			cookie, err := r.Cookie(SessionCookieName)
+			if err != nil || cookie.Value != "some-session" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+			w.Write([]byte(`{"items": []}`))
+		})
+		defer done()
+
+		_, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.Equal(t, ErrPrivateProfile, errors.Cause(err))
+
+		c.Credential = Credential{SessionID: "some-session"}
+		body, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.NoError(t, err)
+		require.Equal(t, `{"items": []}`, string(body))
+	})
+
+	t.Run("fetch ladder unauthenticated", func(t *testing.T) {
+		var authenticated int32
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if _, err := r.Cookie(SessionCookieName); err == nil {
+				atomic.AddInt32(&authenticated, 1)
+			}
+			if len(r.Header.Get("Authorization")) > 0 {
+				atomic.AddInt32(&authenticated, 1)
+			}
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+			w.Write([]byte(`{"entries": []}`))
+		})
+		defer done()
+		c.Credential = Credential{SessionID: "some-session", Token: "some-token"}
+
+		_, err := c.FetchLadder(ctx, logger, ladder.PageCursor{Limit: 5},
+			"some-ladder", LadderOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int32(0), atomic.LoadInt32(&authenticated),
+			"credential sent to the ladder")
+	})
+
+	t.Run("credential refused over plain http", func(t *testing.T) {
+		var attempts int32
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+		})
+		defer done()
+		c.ItemsURL = "http://example.com/character-window/get-items"
+		c.Credential = Credential{SessionID: "some-session"}
+
+		_, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.Error(t, err)
+		require.Equal(t, int32(0), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("fetch passives bearer", func(t *testing.T) {
+		handler, requests := recordRequests(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"hashes": []}`))
+		})
+		c, done := testClient(t, handler)
+		defer done()
+		c.Credential = Credential{Token: "some-token"}
+
+		_, err := c.FetchPassives(ctx, logger, "some-account", "some-character")
+		require.NoError(t, err)
+		require.Equal(t, "Bearer some-token", (<-requests).Header.Get("Authorization"))
+	})
+
+	t.Run("fetch ladder", func(t *testing.T) {
+		handler, requests := recordRequests(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+			w.Write([]byte(`{"entries": []}`))
+		})
+		c, done := testClient(t, handler)
+		defer done()
+
+		body, err := c.FetchLadder(ctx, logger,
+			ladder.PageCursor{Limit: 95, Offset: 10}, "some-ladder (ABC12020)",
+			LadderOptions{})
+		require.NoError(t, err)
+		require.Equal(t, `{"entries": []}`, string(body))
+
+		r := <-requests
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/ladders/some-ladder (ABC12020)", r.URL.Path)
+		require.Equal(t, "some-agent", r.UserAgent())
+		require.Equal(t, "10", r.URL.Query().Get("offset"))
+		require.Equal(t, "95", r.URL.Query().Get("limit"))
+	})
+
+	t.Run("fetch ladder with options", func(t *testing.T) {
+		handler, requests := recordRequests(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+			w.Write([]byte(`{"entries": []}`))
+		})
+		c, done := testClient(t, handler)
+		defer done()
+
+		_, err := c.FetchLadder(ctx, logger,
+			ladder.PageCursor{Limit: 95, Offset: 10}, "some-ladder (ABC12020)",
+			LadderOptions{
+				Type:        LadderTypeLeague,
+				Sort:        LadderSortDepth,
+				Track:       true,
+				AccountName: "some-account",
+			})
+		require.NoError(t, err)
+
+		r := <-requests
+		require.Equal(t, "/ladders/some-ladder (ABC12020)", r.URL.Path)
+		query := r.URL.Query()
+		require.Equal(t, "10", query.Get("offset"))
+		require.Equal(t, "95", query.Get("limit"))
+		require.Equal(t, LadderTypeLeague, query.Get("type"))
+		require.Equal(t, LadderSortDepth, query.Get("sort"))
+		require.Equal(t, "true", query.Get("track"))
+		require.Equal(t, "some-account", query.Get("accountName"))
+	})
+
+	t.Run("fetch passives", func(t *testing.T) {
+		handler, requests := recordRequests(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"hashes": []}`))
+		})
+		c, done := testClient(t, handler)
+		defer done()
+
+		body, err := c.FetchPassives(ctx, logger, "some-account", "some-character")
+		require.NoError(t, err)
+		require.Equal(t, `{"hashes": []}`, string(body))
+
+		r := <-requests
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/character-window/get-passive-skills", r.URL.Path)
+		require.Equal(t, "some-agent", r.UserAgent())
+		require.Equal(t, "some-account", r.URL.Query().Get("accountName"))
+		require.Equal(t, "some-character", r.URL.Query().Get("character"))
+	})
+
+	t.Run("fetch characters", func(t *testing.T) {
+		handler, requests := recordRequests(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+			w.Write([]byte(`[]`))
+		})
+		c, done := testClient(t, handler)
+		defer done()
+
+		body, err := c.FetchCharacters(ctx, logger, "some-account")
+		require.NoError(t, err)
+		require.Equal(t, `[]`, string(body))
+
+		r := <-requests
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/character-window/get-characters", r.URL.Path)
+		require.Equal(t, "some-agent", r.UserAgent())
+		require.Equal(t, "some-account", r.URL.Query().Get("accountName"))
+	})
+
+	t.Run("retry transient", func(t *testing.T) {
+		var attempts int32
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error": {"code": 4, "message": "Internal error"}}`))
+				return
+			}
+			w.Write([]byte(`{"hashes": []}`))
+		})
+		defer done()
+
+		body, err := c.FetchPassives(ctx, logger, "some-account", "some-character")
+		require.NoError(t, err)
+		require.Equal(t, `{"hashes": []}`, string(body))
+		require.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		var attempts int32
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		defer done()
+
+		_, err := c.FetchPassives(ctx, logger, "some-account", "some-character")
+		require.Equal(t, ErrMaintenance, errors.Cause(err))
+		require.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("not found is not retried", func(t *testing.T) {
+		var attempts int32
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 1, "message": "Resource not found"}}`))
+		})
+		defer done()
+
+		_, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.Equal(t, ErrNotFound, errors.Cause(err))
+		require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("cancelled while retrying", func(t *testing.T) {
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		defer done()
+		c.Retry = Retry{Attempts: 3, Base: time.Hour, Max: time.Hour}
+
+		ctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+		defer cancel()
+		_, err := c.FetchPassives(ctx, logger, "some-account", "some-character")
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("cancelled request is not retried", func(t *testing.T) {
+		var attempts int32
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			<-r.Context().Done()
+		})
+		defer done()
+		c.Retry = Retry{Attempts: 3, Base: time.Hour, Max: time.Hour}
+
+		ctx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+		defer cancel()
+		_, err := c.FetchPassives(ctx, logger, "some-account", "some-character")
+		require.Error(t, err)
+		require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("unreachable remote", func(t *testing.T) {
+		c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {})
+		defer done()
+		c.PassivesURL = "http://127.0.0.1:0/get-passive-skills"
+
+		_, err := c.FetchPassives(ctx, logger, "some-account", "some-character")
+		require.Error(t, err)
+	})
+}
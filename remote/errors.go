@@ -0,0 +1,109 @@
+package remote
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// ErrPrivateProfile is returned when a fetch receives a 401 or 403
+var ErrPrivateProfile = fmt.Errorf("got 403; profile is likely private")
+
+// ErrNotFound is returned when a fetch receives a 404; the character,
+// account or ladder does not exist, or no longer exists.
+var ErrNotFound = fmt.Errorf("got 404; not found")
+
+// ErrMaintenance is returned when the remote is unavailable, usually
+// for maintenance or a patch.
+var ErrMaintenance = fmt.Errorf("got 503; remote is likely in maintenance")
+
+// ErrRateLimited is returned when a fetch receives a 429.
+type ErrRateLimited struct {
+	// RetryAfter is how long the remote asked us to wait, or zero
+	// if it did not say.
+	RetryAfter time.Duration
+}
+
+func (e ErrRateLimited) Error() string {
+	return fmt.Sprintf("got 429; rate limited, retry after %s", e.RetryAfter)
+}
+
+// ErrServer is returned when a fetch receives any other non-200 response.
+type ErrServer struct {
+	StatusCode int
+	// Code and Message are from the error body GGG returns, when present.
+	Code    int
+	Message string
+}
+
+func (e ErrServer) Error() string {
+	if len(e.Message) == 0 {
+		return fmt.Sprintf("non-200 status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("non-200 status code: %d, error %d: %s",
+		e.StatusCode, e.Code, e.Message)
+}
+
+// Error codes GGG includes in error bodies, ie
+// {"error": {"code": 1, "message": "Resource not found"}}
+const (
+	apiErrorNotFound     = 1
+	apiErrorRateLimited  = 3
+	apiErrorForbidden    = 6
+	apiErrorUnavailable  = 7
+	apiErrorUnauthorized = 8
+
+	// apiErrorUnset marks a body which did not include a code.
+	apiErrorUnset = -1
+)
+
+// maxErrorBodyBytes is the most of an error body we read.
+const maxErrorBodyBytes = 64 * 1024
+
+// apiError is the body GGG returns alongside most non-200 responses.
+type apiError struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// responseError returns the error describing a non-200 response.
+//
+// The body is consumed but not closed.
+func responseError(resp *http.Response) error {
+	body := apiError{}
+	body.Error.Code = apiErrorUnset
+	blob, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	if err == nil {
+		// Bodies are frequently HTML, we can only try our best
+		jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal(blob, &body)
+	}
+
+	code := body.Error.Code
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized ||
+		resp.StatusCode == http.StatusForbidden ||
+		code == apiErrorForbidden || code == apiErrorUnauthorized:
+		return ErrPrivateProfile
+	case resp.StatusCode == http.StatusNotFound || code == apiErrorNotFound:
+		return ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests || code == apiErrorRateLimited:
+		return ErrRateLimited{
+			RetryAfter: tooManyRequestsState(resp.Header).Penalty,
+		}
+	case resp.StatusCode == http.StatusServiceUnavailable || code == apiErrorUnavailable:
+		return ErrMaintenance
+	}
+
+	e := ErrServer{StatusCode: resp.StatusCode}
+	if code != apiErrorUnset {
+		e.Code = code
+		e.Message = body.Error.Message
+	}
+	return e
+}
@@ -0,0 +1,109 @@
+package remote
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseError(t *testing.T) {
+	response := func(status int, body string, header http.Header) *http.Response {
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	t.Run("private", func(t *testing.T) {
+		err := responseError(response(http.StatusForbidden, "", nil))
+		require.Equal(t, ErrPrivateProfile, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := responseError(response(http.StatusNotFound,
+			`{"error": {"code": 1, "message": "Resource not found"}}`, nil))
+		require.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		err := responseError(response(http.StatusTooManyRequests,
+			`{"error": {"code": 3, "message": "Rate limit exceeded"}}`,
+			http.Header{"Retry-After": {"60"}}))
+		require.Equal(t, ErrRateLimited{RetryAfter: time.Minute}, err)
+		require.True(t, Transient(err))
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		err := responseError(response(http.StatusServiceUnavailable,
+			"<html>down for maintenance</html>", nil))
+		require.Equal(t, ErrMaintenance, err)
+		require.True(t, Transient(err))
+	})
+
+	t.Run("server", func(t *testing.T) {
+		err := responseError(response(http.StatusInternalServerError,
+			`{"error": {"code": 4, "message": "Internal error"}}`, nil))
+		require.Equal(t, ErrServer{
+			StatusCode: http.StatusInternalServerError,
+			Code:       4,
+			Message:    "Internal error",
+		}, err)
+		require.True(t, Transient(err))
+	})
+
+	t.Run("client", func(t *testing.T) {
+		err := responseError(response(http.StatusBadRequest,
+			`{"error": {"code": 2, "message": "Invalid query"}}`, nil))
+		require.Equal(t, ErrServer{
+			StatusCode: http.StatusBadRequest,
+			Code:       2,
+			Message:    "Invalid query",
+		}, err)
+		require.False(t, Transient(err))
+	})
+}
+
+// timeoutError is a net.Error which timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timed out" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestTransient(t *testing.T) {
+	urlErr := func(err error) error {
+		return &url.Error{Op: "Get", URL: "http://localhost", Err: err}
+	}
+
+	cases := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{"dial", urlErr(&net.OpError{Op: "dial", Err: io.EOF}), true},
+		{"connection closed", urlErr(io.ErrUnexpectedEOF), true},
+		{"timeout", urlErr(timeoutError{}), true},
+		{"cancelled", urlErr(context.Canceled), false},
+		{"deadline", urlErr(context.DeadlineExceeded), false},
+		{"bare cancelled", context.Canceled, false},
+		{"replay", urlErr(ErrReplay{io.EOF}), false},
+		{"other", urlErr(io.EOF), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.transient, Transient(c.err))
+		})
+	}
+}
@@ -4,6 +4,7 @@ package remote
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"time"
 
@@ -24,10 +25,9 @@ func TestFetchLadder(t *testing.T) {
 	logger, err := zap.NewProduction()
 	require.NoError(t, err)
 
-	l := NewLimiter(time.Millisecond*1500, time.Second*2,
-		5, logger.With(zap.String("limiter", "snapshot")))
-
-	result, err := FetchLadder(logger, l, cursor, ladderName)
+	c := NewClient(NewLimiters(time.Millisecond*1500, time.Second*2, logger))
+	result, err := c.FetchLadder(context.Background(), logger, cursor, ladderName,
+		LadderOptions{})
 	require.NoError(t, err)
 
 	parsed, err := ladder.ReadLadder(bytes.NewReader(result))
@@ -42,9 +42,8 @@ func TestFetchCharacter(t *testing.T) {
 
 	characterName := "SleeperSpectreBoi"
 	accountName := "Everlag"
-	l := NewLimiter(time.Millisecond*1500, time.Second*2,
-		5, logger.With(zap.String("limiter", "snapshot")))
-	result, err := FetchCharacter(logger, l, accountName, characterName)
+	c := NewClient(NewLimiters(time.Millisecond*1500, time.Second*2, logger))
+	result, err := c.FetchCharacter(context.Background(), logger, accountName, characterName)
 	require.NoError(t, err)
 
 	parsed, err := items.ReadGetItemResp(bytes.NewReader(result))
@@ -59,7 +58,8 @@ func TestFetchPassives(t *testing.T) {
 
 	characterName := "SleeperSpectreBoi"
 	accountName := "Everlag"
-	result, err := FetchPassives(logger, accountName, characterName)
+	c := NewClient(NewLimiters(time.Millisecond*1500, time.Second*2, logger))
+	result, err := c.FetchPassives(context.Background(), logger, accountName, characterName)
 	require.NoError(t, err)
 
 	parsed, err := passives.ReadPassives(bytes.NewReader(result))
@@ -67,3 +67,18 @@ func TestFetchPassives(t *testing.T) {
 
 	require.NotEmpty(t, parsed.Items)
 }
+
+func TestFetchCharacters(t *testing.T) {
+	logger, err := zap.NewProduction()
+	require.NoError(t, err)
+
+	accountName := "Everlag"
+	c := NewClient(NewLimiters(time.Millisecond*1500, time.Second*2, logger))
+	result, err := c.FetchCharacters(context.Background(), logger, accountName)
+	require.NoError(t, err)
+
+	parsed, err := ladder.ReadAccountCharacters(bytes.NewReader(result))
+	require.NoError(t, err)
+
+	require.NotEmpty(t, parsed)
+}
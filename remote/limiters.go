@@ -0,0 +1,134 @@
+package remote
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Endpoints of the GGG API, as Limiters knows them before their
+// rate limit policy is discovered.
+const (
+	EndpointItems      = "get-items"
+	EndpointPassives   = "get-passive-skills"
+	EndpointCharacters = "get-characters"
+	EndpointLadder     = "ladder"
+)
+
+// Limiters routes requests to a Limiter by the rate limit policy,
+// as described by X-Rate-Limit-Policy, of the endpoint they are for.
+//
+// GGG shares a token budget between every endpoint of a policy,
+// so endpoints sharing a policy must share a Limiter.
+//
+// The policy of an endpoint is discovered from its first response;
+// until then, each endpoint has its own Limiter at the initial tick.
+// Once a policy is discovered, its Limiter is paced by the strictest
+// window of its rules rather than the initial tick.
+type Limiters struct {
+	tick, backoff time.Duration
+
+	// byPolicy holds the Limiter shared by every endpoint of a policy.
+	byPolicy map[string]*Limiter
+	// byEndpoint holds the Limiter currently used by each endpoint.
+	byEndpoint map[string]*Limiter
+	// all is every Limiter created, so they can be halted.
+	all    []*Limiter
+	halted bool
+
+	log *zap.Logger
+
+	sync.Mutex
+}
+
+// NewLimiters returns an empty Limiters.
+//
+// tick is the period between requests to an endpoint before
+// its policy is discovered.
+// backoff is passed to every Limiter created, as in NewLimiter.
+func NewLimiters(tick, backoff time.Duration,
+	logContext *zap.Logger) *Limiters {
+
+	return &Limiters{
+		tick:       tick,
+		backoff:    backoff,
+		byPolicy:   make(map[string]*Limiter),
+		byEndpoint: make(map[string]*Limiter),
+		log:        logContext,
+	}
+}
+
+// For returns the Limiter requests to the provided endpoint
+// should be run under.
+func (r *Limiters) For(endpoint string) *Limiter {
+	r.Lock()
+	defer r.Unlock()
+
+	if l, ok := r.byEndpoint[endpoint]; ok {
+		return l
+	}
+	l := r.newLimiter(zap.String("endpoint", endpoint))
+	r.byEndpoint[endpoint] = l
+	return l
+}
+
+// newLimiter returns a new Limiter at the initial tick, which is
+// already halted if we are.
+//
+// This must be called with the lock held.
+func (r *Limiters) newLimiter(context zap.Field) *Limiter {
+	l := NewLimiter(r.tick, r.backoff, 0, r.log.With(context))
+	r.all = append(r.all, l)
+	if r.halted {
+		l.Halt()
+	}
+	return l
+}
+
+// Observe records the RateLimitState received from the provided
+// endpoint, routing it to the Limiter of its policy.
+//
+// The first endpoint to report a policy donates its Limiter to the
+// policy; later endpoints of the same policy are moved to it. States
+// without a policy are recorded with the endpoint's own Limiter.
+func (r *Limiters) Observe(endpoint string, s RateLimitState) {
+	if len(s.Policy) == 0 {
+		r.For(endpoint).updateState(s)
+		return
+	}
+
+	r.Lock()
+	shared, ok := r.byPolicy[s.Policy]
+	if !ok {
+		shared, ok = r.byEndpoint[endpoint]
+		if !ok {
+			shared = r.newLimiter(zap.String("policy", s.Policy))
+		}
+		r.byPolicy[s.Policy] = shared
+		r.log.Debug("discovered rate limit policy",
+			zap.String("endpoint", endpoint),
+			zap.String("policy", s.Policy))
+	}
+	r.byEndpoint[endpoint] = shared
+	r.Unlock()
+
+	if interval := s.Interval(); interval > 0 {
+		shared.setTick(interval)
+	}
+	shared.updateState(s)
+}
+
+// Halt halts every Limiter, as in Limiter.Halt.
+//
+// This method is idempotent; Limiters returned afterwards by For are
+// already halted.
+func (r *Limiters) Halt() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.halted = true
+	for _, l := range r.all {
+		l.Halt()
+	}
+}
@@ -0,0 +1,61 @@
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLimiters(t *testing.T) {
+	policyState := func(policy string) RateLimitState {
+		return RateLimitState{
+			Current: 1,
+			Max:     10,
+			Policy:  policy,
+			Rules: []RateLimitRule{
+				{
+					Name:   "Ip",
+					Limits: []RateLimitWindow{{Hits: 10, Period: time.Second}},
+					State:  []RateLimitWindow{{Hits: 1, Period: time.Second}},
+				},
+			},
+		}
+	}
+
+	t.Run("shared policy", func(t *testing.T) {
+		r := NewLimiters(time.Second, time.Millisecond, zap.NewNop())
+		items := r.For(EndpointItems)
+		require.NotEqual(t, items, r.For(EndpointPassives))
+
+		r.Observe(EndpointItems, policyState("some-policy"))
+		r.Observe(EndpointPassives, policyState("some-policy"))
+		require.Equal(t, items, r.For(EndpointItems))
+		require.Equal(t, items, r.For(EndpointPassives))
+	})
+
+	t.Run("distinct policies", func(t *testing.T) {
+		r := NewLimiters(time.Second, time.Millisecond, zap.NewNop())
+		r.Observe(EndpointItems, policyState("some-policy"))
+		r.Observe(EndpointLadder, policyState("other-policy"))
+		require.NotEqual(t, r.For(EndpointItems), r.For(EndpointLadder))
+	})
+
+	t.Run("without policy", func(t *testing.T) {
+		r := NewLimiters(time.Second, time.Millisecond, zap.NewNop())
+		items := r.For(EndpointItems)
+		r.Observe(EndpointItems, policyState(""))
+		r.Observe(EndpointPassives, policyState(""))
+		require.Equal(t, items, r.For(EndpointItems))
+		require.NotEqual(t, items, r.For(EndpointPassives))
+		require.Equal(t, time.Second, items.tick)
+		require.Equal(t, 1, items.State().Current, "state is recorded")
+	})
+
+	t.Run("paced by policy", func(t *testing.T) {
+		r := NewLimiters(time.Second, time.Millisecond, zap.NewNop())
+		r.Observe(EndpointItems, policyState("some-policy"))
+		require.Equal(t, time.Millisecond*100, r.For(EndpointItems).tick)
+	})
+}
@@ -0,0 +1,235 @@
+package remote
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RateLimitStateName is the regexp we use to match rate limit state info
+var RateLimitStateName = regexp.MustCompile(`X-Rate-Limit-.*-State`)
+
+// Headers GGG describes rate limiting with.
+//
+// Every rule listed in X-Rate-Limit-Rules, ie Ip, has its windows in
+// X-Rate-Limit-<rule> and the current state of those windows in
+// X-Rate-Limit-<rule>-State.
+const (
+	RateLimitPolicyHeader = "X-Rate-Limit-Policy"
+	RateLimitRulesHeader  = "X-Rate-Limit-Rules"
+	RateLimitHeaderPrefix = "X-Rate-Limit-"
+	RetryAfterHeader      = "Retry-After"
+)
+
+// RateLimitWindow is a single window of a rate limit rule,
+// formatted hits:period:penalty in seconds.
+type RateLimitWindow struct {
+	// Hits is the most requests allowed in the Period for a
+	// rule and the requests made in the Period for a state.
+	Hits   int
+	Period time.Duration
+	// Penalty is how long requests are refused after exceeding the
+	// window for a rule and how long remains of an active penalty
+	// for a state.
+	Penalty time.Duration
+}
+
+// String formats the RateLimitWindow as it appears in headers,
+// ie 45:60:60
+func (w RateLimitWindow) String() string {
+	return fmt.Sprintf("%d:%d:%d", w.Hits,
+		int(w.Period/time.Second), int(w.Penalty/time.Second))
+}
+
+// RateLimitRule is a named set of windows, ie Ip or Account,
+// alongside their current state.
+type RateLimitRule struct {
+	Name   string
+	Limits []RateLimitWindow
+	State  []RateLimitWindow
+}
+
+// RateLimitState describes a snapshot of a remote service's rate-limiting
+// behavior.
+type RateLimitState struct {
+	// Current and Max are the hits of the most saturated window.
+	Current int
+	Max     int
+	// Penalty is how long must pass before another request is made,
+	// from either an active penalty or Retry-After.
+	Penalty time.Duration
+
+	// Policy is the name of the rate limit policy the endpoint
+	// belongs to; endpoints sharing a Policy share their limits.
+	Policy string
+	Rules  []RateLimitRule
+}
+
+// IsZero returns true when the RateLimitState holds nothing observed.
+func (s RateLimitState) IsZero() bool {
+	return s.Max == 0 && s.Penalty == 0 && len(s.Rules) == 0
+}
+
+// Rel returns the relative filled of this RateLimitState
+func (s RateLimitState) Rel() float32 {
+	if s.Max == 0 {
+		return 0
+	}
+	return float32(s.Current) / float32(s.Max)
+}
+
+// Interval returns the shortest period between requests which never
+// exceeds any window of the Rules, or zero if there are no Rules.
+func (s RateLimitState) Interval() time.Duration {
+	var interval time.Duration
+	for _, r := range s.Rules {
+		for _, limit := range r.Limits {
+			if limit.Hits <= 0 {
+				continue
+			}
+			if i := limit.Period / time.Duration(limit.Hits); i > interval {
+				interval = i
+			}
+		}
+	}
+	return interval
+}
+
+// ParseRateLimitState reads the RateLimitState from the headers
+// of a response.
+func ParseRateLimitState(h http.Header) (RateLimitState, error) {
+	s := RateLimitState{
+		Policy: h.Get(RateLimitPolicyHeader),
+	}
+
+	var names []string
+	if rules := h.Get(RateLimitRulesHeader); len(rules) > 0 {
+		for _, name := range strings.Split(rules, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	} else {
+		// Fall back to every state present
+		for header := range h {
+			if !RateLimitStateName.MatchString(header) {
+				continue
+			}
+			names = append(names, strings.TrimSuffix(
+				strings.TrimPrefix(header, RateLimitHeaderPrefix), "-State"))
+		}
+	}
+
+	for _, name := range names {
+		limits, err := rateLimitWindows(h.Get(RateLimitHeaderPrefix + name))
+		if err != nil {
+			return RateLimitState{}, errors.Wrapf(err, "parsing %s rule", name)
+		}
+		state, err := rateLimitWindows(h.Get(RateLimitHeaderPrefix + name + "-State"))
+		if err != nil {
+			return RateLimitState{}, errors.Wrapf(err, "parsing %s state", name)
+		}
+		s.Rules = append(s.Rules, RateLimitRule{
+			Name:   name,
+			Limits: limits,
+			State:  state,
+		})
+	}
+
+	for _, r := range s.Rules {
+		for _, current := range r.State {
+			if current.Penalty > s.Penalty {
+				s.Penalty = current.Penalty
+			}
+			for _, limit := range r.Limits {
+				if limit.Period != current.Period {
+					continue
+				}
+				candidate := RateLimitState{Current: current.Hits, Max: limit.Hits}
+				if s.Max == 0 || candidate.Rel() > s.Rel() {
+					s.Current, s.Max = candidate.Current, candidate.Max
+				}
+			}
+		}
+	}
+
+	if penalty := retryAfter(h); penalty > s.Penalty {
+		s.Penalty = penalty
+	}
+
+	if len(s.Rules) == 0 && s.Penalty == 0 {
+		return RateLimitState{}, errors.New("failed to find rate limit information")
+	}
+	return s, nil
+}
+
+// retryAfter returns how long the Retry-After header asks us to wait.
+//
+// This is either in seconds or until an HTTP-date, which is relative
+// to the Date of the response when present. A missing or malformed
+// header is zero, so it cannot discard the rules it accompanies.
+func retryAfter(h http.Header) time.Duration {
+	value := h.Get(RetryAfterHeader)
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	until, err := http.ParseTime(value)
+	if err != nil {
+		return 0
+	}
+	now, err := http.ParseTime(h.Get("Date"))
+	if err != nil {
+		now = time.Now()
+	}
+	if wait := until.Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// rateLimitWindows parses comma-separated hits:period:penalty windows.
+func rateLimitWindows(value string) ([]RateLimitWindow, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	var windows []RateLimitWindow
+	for _, w := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(w), ":")
+		if len(parts) != 3 {
+			return nil, errors.Errorf("expected hits:period:penalty, found %q", w)
+		}
+		var values [3]int
+		for i, p := range parts {
+			v, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parsing window %q", w)
+			}
+			values[i] = v
+		}
+		windows = append(windows, RateLimitWindow{
+			Hits:    values[0],
+			Period:  time.Duration(values[1]) * time.Second,
+			Penalty: time.Duration(values[2]) * time.Second,
+		})
+	}
+	return windows, nil
+}
+
+// tooManyRequestsState returns the RateLimitState to back off with
+// after being told we have made too many requests.
+//
+// This always triggers backoff, even when the response lacks
+// rate limit information.
+func tooManyRequestsState(h http.Header) RateLimitState {
+	s, _ := ParseRateLimitState(h)
+	s.Current, s.Max = 2, 1
+	return s
+}
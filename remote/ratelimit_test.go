@@ -0,0 +1,158 @@
+package remote
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimitState(t *testing.T) {
+	headers := func(kv ...string) http.Header {
+		h := http.Header{}
+		for i := 0; i < len(kv); i += 2 {
+			h.Set(kv[i], kv[i+1])
+		}
+		return h
+	}
+
+	t.Run("single rule", func(t *testing.T) {
+		state, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Policy", "character-window-request-limit",
+			"X-Rate-Limit-Rules", "Ip",
+			"X-Rate-Limit-Ip", "45:60:60,240:240:900",
+			"X-Rate-Limit-Ip-State", "3:60:0,3:240:0",
+		))
+		require.NoError(t, err)
+		require.Equal(t, RateLimitState{
+			Current: 3,
+			Max:     45,
+			Policy:  "character-window-request-limit",
+			Rules: []RateLimitRule{
+				{
+					Name: "Ip",
+					Limits: []RateLimitWindow{
+						{Hits: 45, Period: time.Minute, Penalty: time.Minute},
+						{Hits: 240, Period: 4 * time.Minute, Penalty: 15 * time.Minute},
+					},
+					State: []RateLimitWindow{
+						{Hits: 3, Period: time.Minute},
+						{Hits: 3, Period: 4 * time.Minute},
+					},
+				},
+			},
+		}, state)
+	})
+
+	t.Run("most saturated window", func(t *testing.T) {
+		state, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Rules", "Ip,Account",
+			"X-Rate-Limit-Ip", "45:60:60,240:240:900",
+			"X-Rate-Limit-Ip-State", "3:60:0,200:240:0",
+			"X-Rate-Limit-Account", "30:60:60",
+			"X-Rate-Limit-Account-State", "20:60:0",
+		))
+		require.NoError(t, err)
+		require.Equal(t, 200, state.Current)
+		require.Equal(t, 240, state.Max)
+		require.Len(t, state.Rules, 2)
+	})
+
+	t.Run("active penalty", func(t *testing.T) {
+		state, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Rules", "Ip",
+			"X-Rate-Limit-Ip", "45:60:60",
+			"X-Rate-Limit-Ip-State", "46:60:52",
+		))
+		require.NoError(t, err)
+		require.Equal(t, 52*time.Second, state.Penalty)
+	})
+
+	t.Run("retry after", func(t *testing.T) {
+		state, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Rules", "Ip",
+			"X-Rate-Limit-Ip", "45:60:60",
+			"X-Rate-Limit-Ip-State", "46:60:52",
+			"Retry-After", "60",
+		))
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, state.Penalty)
+	})
+
+	t.Run("retry after date", func(t *testing.T) {
+		state, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Rules", "Ip",
+			"X-Rate-Limit-Ip", "45:60:60",
+			"X-Rate-Limit-Ip-State", "46:60:52",
+			"Date", "Wed, 21 Oct 2015 07:28:00 GMT",
+			"Retry-After", "Wed, 21 Oct 2015 07:30:00 GMT",
+		))
+		require.NoError(t, err)
+		require.Equal(t, 2*time.Minute, state.Penalty)
+	})
+
+	t.Run("malformed retry after", func(t *testing.T) {
+		state, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Rules", "Ip",
+			"X-Rate-Limit-Ip", "45:60:60",
+			"X-Rate-Limit-Ip-State", "46:60:52",
+			"Retry-After", "soon",
+		))
+		require.NoError(t, err, "rules are kept")
+		require.Equal(t, 52*time.Second, state.Penalty)
+		require.Len(t, state.Rules, 1)
+	})
+
+	t.Run("without rules header", func(t *testing.T) {
+		state, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Ip", "45:60:60",
+			"X-Rate-Limit-Ip-State", "9:60:0",
+		))
+		require.NoError(t, err)
+		require.Equal(t, 9, state.Current)
+		require.Equal(t, 45, state.Max)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := ParseRateLimitState(headers())
+		require.Error(t, err)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := ParseRateLimitState(headers(
+			"X-Rate-Limit-Rules", "Ip",
+			"X-Rate-Limit-Ip", "45:60",
+			"X-Rate-Limit-Ip-State", "3:60:0",
+		))
+		require.Error(t, err)
+	})
+}
+
+func TestRateLimitInterval(t *testing.T) {
+	t.Run("strictest window", func(t *testing.T) {
+		s := RateLimitState{
+			Rules: []RateLimitRule{
+				{Limits: []RateLimitWindow{
+					{Hits: 45, Period: time.Minute},
+					{Hits: 240, Period: 4 * time.Minute},
+				}},
+				{Limits: []RateLimitWindow{
+					{Hits: 30, Period: time.Minute},
+				}},
+			},
+		}
+		require.Equal(t, 2*time.Second, s.Interval())
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		require.Zero(t, RateLimitState{}.Interval())
+	})
+}
+
+func TestRateLimitWindowString(t *testing.T) {
+	windows, err := rateLimitWindows("45:60:60,240:240:900")
+	require.NoError(t, err)
+	require.Equal(t, "45:60:60", windows[0].String())
+	require.Equal(t, "240:240:900", windows[1].String())
+}
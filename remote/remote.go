@@ -1,28 +1,17 @@
 package remote
 
 import (
-	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"fmt"
 	"math"
-	"net/http"
-	"net/url"
-	"path"
-	"regexp"
-	"strconv"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/Everlag/slippery-policy/ladder"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
-// RateLimitStateName is the regexp we use to match rate limit state info
-var RateLimitStateName = regexp.MustCompile(`X-Rate-Limit-.*-State`)
-
 // BackoffThreshold is the threshold of limit saturation at which we start to
 // backoff. A higher threshold is more aggressive.
 const BackoffThreshold float32 = 0.6
@@ -30,20 +19,27 @@ const BackoffThreshold float32 = 0.6
 // LimiterWindow is TODO why?
 var LimiterWindow = time.Second * 30
 
-// ErrPrivateProfile is returned when a FetchCharacter receives a 403
-var ErrPrivateProfile = fmt.Errorf("got 403; profile is likely private")
-
 // Limiter allows execution of arbitrary functions rate-limited
 type Limiter struct {
 	backoff time.Duration
 
+	tick   time.Duration
 	ticker *time.Ticker
 	signal chan struct{}
 
-	halt chan struct{}
+	// halt is closed, exactly once, to shutdown the Limiter
+	halt     chan struct{}
+	haltOnce sync.Once
 
 	state           RateLimitState
 	lastStateChange time.Time
+	// penaltyUntil is when the most recent penalty received ends;
+	// nothing runs before then.
+	penaltyUntil time.Time
+	// version increases whenever state or penaltyUntil change, with
+	// changed notified so a pending execution slot is re-evaluated.
+	version uint64
+	changed chan struct{}
 
 	log *zap.Logger
 
@@ -56,6 +52,10 @@ type Limiter struct {
 // backoff is the period of time backoff should last. This should
 // be longer than a single request.
 // Rate-limiting is performed based off of received RateLimiteState.
+//
+// Up to burst execution slots are handed out ahead of time; those are
+// not re-evaluated when the RateLimitState changes, so a burst of 0
+// is required to strictly honour penalties.
 func NewLimiter(tick, backoff time.Duration, burst int,
 	logContext *zap.Logger) *Limiter {
 
@@ -65,9 +65,11 @@ func NewLimiter(tick, backoff time.Duration, burst int,
 
 	l := &Limiter{
 		backoff: backoff,
+		tick:    tick,
 		ticker:  time.NewTicker(tick),
 		signal:  make(chan struct{}, burst),
 		halt:    make(chan struct{}),
+		changed: make(chan struct{}, 1),
 		log:     logContext.With(zap.String("Limiter", id)),
 	}
 
@@ -78,53 +80,113 @@ func NewLimiter(tick, backoff time.Duration, burst int,
 
 // start begins execution of the Limiter
 func (l *Limiter) start() {
+	defer func() {
+		l.log.Debug("start exiting off halted Limiter")
+		l.ticker.Stop()
+		close(l.signal)
+	}()
+
 	for {
 		select {
 		case <-l.ticker.C:
-			l.RWMutex.RLock()
-			snapshot := l.state
-			l.RWMutex.RUnlock()
+			if !l.release() {
+				return
+			}
+		case <-l.halt:
+			return
+		}
+	}
+}
 
-			// Check if we need to backoff for a threshold
-			if snapshot.Rel() >= BackoffThreshold {
-				// Determine how many units we need to wait to get
-				// under our threshold.
-				maxCurrent := (float64(snapshot.Max) * float64(BackoffThreshold))
-				waitUnits := maxCurrent - float64(snapshot.Current)
+// release hands out a single execution slot once the current
+// RateLimitState allows it, returning false if the Limiter was halted.
+//
+// The slot is only handed out under the state it was evaluated against;
+// if the state changes while waiting for a taker, ie a penalty arrives,
+// it is evaluated again.
+func (l *Limiter) release() bool {
+	for {
+		l.RWMutex.RLock()
+		snapshot := l.state
+		penaltyUntil := l.penaltyUntil
+		version := l.version
+		l.RWMutex.RUnlock()
+
+		// Wait out any penalty before considering the window
+		if penalty := time.Until(penaltyUntil); penalty > 0 {
+			l.log.Debug("waiting out penalty",
+				zap.Duration("penalty", penalty))
+
+			if !l.wait(penalty) {
+				return false
+			}
+		}
 
-				waitTime := l.backoff * time.Duration(math.Abs(waitUnits))
+		// Check if we need to backoff for a threshold
+		if snapshot.Rel() >= BackoffThreshold {
+			// Determine how many units we need to wait to get
+			// under our threshold.
+			maxCurrent := (float64(snapshot.Max) * float64(BackoffThreshold))
+			waitUnits := maxCurrent - float64(snapshot.Current)
 
-				l.log.Debug("backing off",
-					zap.Duration("waitTime", waitTime))
+			waitTime := l.backoff * time.Duration(math.Abs(waitUnits))
 
-				time.Sleep(waitTime)
+			l.log.Debug("backing off",
+				zap.Duration("waitTime", waitTime))
+
+			if !l.wait(waitTime) {
+				return false
 			}
+		}
 
-			l.signal <- struct{}{}
+		l.RWMutex.RLock()
+		stale := version != l.version
+		l.RWMutex.RUnlock()
+		if stale {
+			continue
+		}
+
+		select {
+		case l.signal <- struct{}{}:
+			return true
+		case <-l.changed:
+			// Stale notifications only cost a re-evaluation
 		case <-l.halt:
-			l.log.Debug("start exiting off halted Limiter")
-			close(l.signal)
-			return
+			return false
 		}
 	}
 }
 
+// wait sleeps for the provided duration, returning false
+// if the Limiter was halted in the meantime.
+func (l *Limiter) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-l.halt:
+		return false
+	}
+}
+
 // Backoff instructs the Limiter to immediately start limiting as though
 // the provided state, that would trigger rate-limiting, was received.
 func (l *Limiter) Backoff(s RateLimitState) {
 	l.log.Debug("manually backing off Limiter")
-	l.Lock()
-	defer l.Unlock()
-
 	l.updateState(s)
 }
 
-// Halt requests a Limiter to shutdown
+// Halt requests a Limiter to shutdown; every Run waiting on the
+// Limiter, or called afterwards, returns ErrLimiterHalted.
 //
-// This method is not idempotent and calling beyond the first time is unsafe.
+// This method is idempotent and safe to call concurrently.
 func (l *Limiter) Halt() {
-	l.log.Debug("halting Limiter")
-	l.halt <- struct{}{}
+	l.haltOnce.Do(func() {
+		l.log.Debug("halting Limiter")
+		close(l.halt)
+	})
 }
 
 func (l *Limiter) updateState(s RateLimitState) {
@@ -133,6 +195,16 @@ func (l *Limiter) updateState(s RateLimitState) {
 
 	now := time.Now()
 
+	changed := false
+
+	// Penalties are always honoured, regardless of the window
+	if s.Penalty > 0 {
+		if until := now.Add(s.Penalty); until.After(l.penaltyUntil) {
+			l.penaltyUntil = until
+			changed = true
+		}
+	}
+
 	// Check if we're ready for an update;
 	// or if the new rel is higher than we've recordered.
 	//
@@ -140,291 +212,70 @@ func (l *Limiter) updateState(s RateLimitState) {
 	if now.Sub(l.lastStateChange) > LimiterWindow ||
 		s.Rel() > l.state.Rel() {
 
+		changed = changed || s.Rel() != l.state.Rel()
 		l.state = s
+		l.lastStateChange = now
 	}
-}
-
-// ErrLimiterHalted is the distinguished error returned from Run
-// when the backing Limiter is no longer valid.
-var ErrLimiterHalted = errors.New("Limiter halted")
-
-// Run executes the provided callback while respecting rate limiting state.
-//
-// This blocks until an execution slot opens up.
-//
-// Errors from the provided function invalidate the RateLimitState and
-// are passed up.
-func (l *Limiter) Run(cb func() (RateLimitState, error)) error {
-	_, ok := <-l.signal
-	if !ok {
-		l.log.Debug("read failure off halted Limiter")
-		return ErrLimiterHalted
-	}
-	s, err := cb()
-	if err == nil {
-		l.updateState(s)
-	}
-
-	return err
-}
-
-// RateLimitState describes a snapshot of a remote service's rate-limiting
-// behavior.
-type RateLimitState struct {
-	Current int
-	Max     int
-}
-
-// Rel returns the relative filled of this RateLimitState
-func (s RateLimitState) Rel() float32 {
-	if s.Max == 0 {
-		return 0
-	}
-	return float32(s.Current) / float32(s.Max)
-}
-
-func rateLimitStateValue(state string) (RateLimitState, error) {
-
-	found := false
-	worst := RateLimitState{}
 
-	portions := strings.Split(state, ",")
-	for _, p := range portions {
-		var s RateLimitState
-		_, err := fmt.Sscanf(p, "%d:%d", &s.Current, &s.Max)
-		if err != nil {
-			return RateLimitState{},
-				errors.Wrap(err, "parsing out RateLimitStateValues")
-		}
-
-		if s.Rel() > worst.Rel() {
-			worst = s
+	if changed {
+		l.version++
+		select {
+		case l.changed <- struct{}{}:
+		default:
 		}
-		found = true
-	}
-	if !found {
-		return RateLimitState{}, errors.New("failed to find rate limiting header")
 	}
-
-	return worst, nil
 }
 
-// GetItemsURL is the remote endpoint to fetch character information from
-const GetItemsURL = "https://www.pathofexile.com/character-window/get-items"
-
-// GetPassivesURL is the remote endpoint to fetch passives information from
-const GetPassivesURL = "https://www.pathofexile.com/character-window/get-passive-skills"
-
-// GetLadderURL is the remote endpoint to fetch ladder information
-//
-// Note that the ladder name, ie Slippery%20Hobo%20League%20(PL5357),
-// must be appended at the end of the path with a separating slash.
-const GetLadderURL = "http://api.pathofexile.com/ladders"
-
-// FetchCharacter resolves the GetItemsURL for a provided character under a specified account.
-//
-// This returns the contents of the body fetched.
-func FetchCharacter(logContext *zap.Logger, l *Limiter,
-	accountName, characterName string) ([]byte, error) {
-
-	var resp *http.Response
-	defer func() {
-		if resp != nil {
-			resp.Body.Close()
-		}
-	}()
-
-	err := l.Run(func() (RateLimitState, error) {
-		var err error
-		resp, err = http.PostForm(GetItemsURL,
-			url.Values{"accountName": {accountName}, "character": {characterName}})
-		if err != nil {
-			return RateLimitState{}, errors.Wrap(err, "fetching remote")
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			// Specifically instruct the rate limiter to aggressively
-			// backoff when we encounter this scenario.
-			if resp.StatusCode == http.StatusTooManyRequests {
-				l.Backoff(RateLimitState{Current: 2, Max: 1})
-			}
-			// Handle private profiles specifically
-			if resp.StatusCode == http.StatusUnauthorized ||
-				resp.StatusCode == http.StatusForbidden {
-				return RateLimitState{},
-					errors.Wrap(ErrPrivateProfile, "403 received")
-			}
-			return RateLimitState{},
-				errors.Errorf("non-200 status code: %d", resp.StatusCode)
-		}
-
-		for h := range resp.Header {
-			if !RateLimitStateName.MatchString(h) {
-				continue
-			}
-
-			return rateLimitStateValue(resp.Header.Get(h))
-		}
-
-		return RateLimitState{}, errors.New("failed to find rate limit information")
-	})
-	if err != nil {
-		if err == ErrLimiterHalted {
-			return nil, err
-		}
-		return nil, errors.Wrap(err, "failed to run fetch")
-	}
+// setTick changes the minimum period between Takes for the Limiter.
+func (l *Limiter) setTick(tick time.Duration) {
+	l.Lock()
+	defer l.Unlock()
 
-	buf := bytes.NewBuffer(make([]byte, 0, 6*1024))
-	_, err = buf.ReadFrom(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to read response body")
+	if tick == l.tick {
+		return
 	}
-
-	return buf.Bytes(), nil
+	l.log.Debug("changing tick", zap.Duration("tick", tick))
+	l.tick = tick
+	l.ticker.Reset(tick)
 }
 
-func ladderURL(cursor ladder.PageCursor, ladderName string) (*url.URL, error) {
+// State returns the RateLimitState the Limiter is currently respecting.
+func (l *Limiter) State() RateLimitState {
+	l.RLock()
+	defer l.RUnlock()
 
-	// Make sure we're not putting anything funky into our URL
-	ladderName = url.PathEscape(ladderName)
-
-	base, err := url.Parse(GetLadderURL)
-	if err != nil {
-		return nil, errors.Wrap(err, "parsing base URL")
-	}
-	path := path.Join(base.Path, ladderName)
-	endpoint, err := base.Parse(path)
-	if err != nil {
-		return nil, errors.Wrap(err, "parsing updated path")
-	}
-
-	// Update with the query parameters we have
-	endpoint.RawQuery = url.Values{
-		"offset": []string{strconv.Itoa(cursor.Offset)},
-		"limit":  []string{strconv.Itoa(cursor.Limit)},
-	}.Encode()
-
-	return endpoint, nil
+	return l.state
 }
 
-// FetchLadder resolves the GetItemsURL for a provided character under a specified account.
-//
-// This returns the contents of the body fetched.
-func FetchLadder(logContext *zap.Logger, l *Limiter,
-	cursor ladder.PageCursor, ladderName string) ([]byte, error) {
-
-	var resp *http.Response
-	defer func() {
-		if resp != nil {
-			resp.Body.Close()
-		}
-	}()
-
-	ladderURL, err := ladderURL(cursor, ladderName)
-	if err != nil {
-		return nil, errors.Wrap(err, "computing URL")
-	}
-	endpoint := ladderURL.String()
-
-	err = l.Run(func() (RateLimitState, error) {
-		var err error
-		resp, err = http.Get(endpoint)
-		if err != nil {
-			return RateLimitState{}, errors.Wrap(err, "fetching remote")
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			logContext.Error("fetch not OK",
-				zap.Int("status code", resp.StatusCode),
-				zap.String("status text", resp.Status))
-
-			// Specifically instruct the rate limiter to aggressively
-			// backoff when we encounter this scenario.
-			if resp.StatusCode == http.StatusTooManyRequests {
-				l.Backoff(RateLimitState{Current: 2, Max: 1})
-			}
-			return RateLimitState{},
-				errors.Errorf("non-200 status code: %d", resp.StatusCode)
-		}
-
-		for h := range resp.Header {
-			if !RateLimitStateName.MatchString(h) {
-				continue
-			}
-
-			return rateLimitStateValue(resp.Header.Get(h))
-		}
-
-		return RateLimitState{}, errors.New("failed to find rate limit information")
-	})
-	if err != nil {
-		if err == ErrLimiterHalted {
-			return nil, err
-		}
-		return nil, errors.Wrap(err, "failed to run fetch")
-	}
-
-	buf := bytes.NewBuffer(make([]byte, 0, 6*1024))
-	_, err = buf.ReadFrom(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to read response body")
-	}
-
-	return buf.Bytes(), nil
-}
+// ErrLimiterHalted is the distinguished error returned from Run
+// when the backing Limiter is no longer valid.
+var ErrLimiterHalted = errors.New("Limiter halted")
 
-// FetchPassives resolves the GetPassivesURL for a provided character under a specified account.
+// Run executes the provided callback while respecting rate limiting state.
 //
-// This returns the contents of the body fetched.
-func FetchPassives(logContext *zap.Logger,
-	accountName, characterName string) ([]byte, error) {
-
-	var resp *http.Response
-	defer func() {
-		if resp != nil {
-			resp.Body.Close()
-		}
-	}()
-
-	req, err := http.NewRequest("GET", GetPassivesURL, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "building request")
-	}
-
-	q := req.URL.Query()
-	q.Add("character", characterName)
-	q.Add("accountName", accountName)
-	req.URL.RawQuery = q.Encode()
-
-	// In theory, passives requests are not rate limited.
-	// If this changes, our logging should catch it... hopefully
-	resp, err = http.DefaultClient.Do(req)
-
-	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusTooManyRequests {
-			logContext.Error("encountered unexpected rate limiting; backing off",
-				zap.Int("status code", resp.StatusCode),
-				zap.String("status text", resp.Status))
-
-			// This is a minimal delay to ensure we aren't seen as actively
-			// abusive.
-			time.Sleep(time.Second * 1)
-		}
-		// Handle private profiles specifically
-		if resp.StatusCode == http.StatusUnauthorized ||
-			resp.StatusCode == http.StatusForbidden {
-			return nil,
-				errors.Wrap(ErrPrivateProfile, "403 received")
+// This blocks until an execution slot opens up, the Limiter is halted
+// or the context is done; the context's error is returned for the latter.
+//
+// Errors from the provided function invalidate the RateLimitState and
+// are passed up; a zero RateLimitState, ie one the function has
+// already recorded elsewhere, leaves the current state as-is.
+func (l *Limiter) Run(ctx context.Context, cb func() (RateLimitState, error)) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.halt:
+		l.log.Debug("read failure off halted Limiter")
+		return ErrLimiterHalted
+	case _, ok := <-l.signal:
+		if !ok {
+			l.log.Debug("read failure off halted Limiter")
+			return ErrLimiterHalted
 		}
-		return nil, errors.New("non-200 status code")
 	}
-
-	buf := bytes.NewBuffer(make([]byte, 0, 6*1024))
-	_, err = buf.ReadFrom(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to read response body")
+	s, err := cb()
+	if err == nil && !s.IsZero() {
+		l.updateState(s)
 	}
 
-	return buf.Bytes(), nil
+	return err
 }
@@ -1,8 +1,10 @@
 package remote
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
@@ -40,36 +42,8 @@ func TestRateLimitHeaderName(t *testing.T) {
 	})
 }
 
-func TestRateLimitHeaderState(t *testing.T) {
-	t.Run("single tuple", func(t *testing.T) {
-		state, err := rateLimitStateValue("3:60:0")
-		require.NoError(t, err)
-		require.NotZero(t, state)
-		require.Equal(t, RateLimitState{
-			Current: 3, Max: 60,
-		}.Rel(), state.Rel())
-	})
-
-	t.Run("multiple tuples", func(t *testing.T) {
-		state, err := rateLimitStateValue("3:60:0,3:240:0")
-		require.NoError(t, err)
-		require.NotZero(t, state)
-		require.Equal(t, RateLimitState{
-			Current: 3, Max: 60,
-		}.Rel(), state.Rel())
-	})
-
-	t.Run("higher rel", func(t *testing.T) {
-		state, err := rateLimitStateValue("3:60:0,200:240:0")
-		require.NoError(t, err)
-		require.NotZero(t, state)
-		require.Equal(t, RateLimitState{
-			Current: 200, Max: 240,
-		}.Rel(), state.Rel())
-	})
-}
-
 func TestLimiter(t *testing.T) {
+	ctx := context.Background()
 	backoff := time.Millisecond * 20
 	getLimiter := func() *Limiter {
 		return NewLimiter(time.Microsecond, backoff, 0, zap.NewNop())
@@ -95,7 +69,7 @@ func TestLimiter(t *testing.T) {
 		var maxDelta time.Duration
 		for i := 0; i < 20; i++ {
 			start := time.Now()
-			l.Run(noBackoff)
+			l.Run(ctx, noBackoff)
 			end := time.Now()
 
 			delta := end.Sub(start)
@@ -110,13 +84,13 @@ func TestLimiter(t *testing.T) {
 
 	t.Run("constant backoff", func(t *testing.T) {
 		l := getLimiter()
-		l.Run(forceBackoff)
+		l.Run(ctx, forceBackoff)
 
 		// Get around the flakiness of the scheduler by requiring that
 		// we backoff at least once during a trial.
 		for i := 0; i < 20; i++ {
 			start := time.Now()
-			l.Run(forceBackoff)
+			l.Run(ctx, forceBackoff)
 			end := time.Now()
 
 			delta := end.Sub(start)
@@ -127,20 +101,102 @@ func TestLimiter(t *testing.T) {
 		t.Fatal("did not backoff during trials")
 	})
 
+	t.Run("zero state kept", func(t *testing.T) {
+		l := getLimiter()
+		l.Run(ctx, noBackoff)
+		version := l.version
+
+		// The state was already recorded elsewhere, ie by Limiters
+		l.Run(ctx, func() (RateLimitState, error) {
+			return RateLimitState{}, nil
+		})
+		require.Equal(t, 30, l.State().Current)
+		require.Equal(t, version, l.version)
+	})
+
+	t.Run("penalty", func(t *testing.T) {
+		l := getLimiter()
+		penalty := time.Millisecond * 100
+		l.Backoff(RateLimitState{Penalty: penalty})
+
+		start := time.Now()
+		l.Run(ctx, noBackoff)
+		require.True(t, time.Since(start) >= penalty/2,
+			"did not wait out penalty")
+	})
+
+	t.Run("penalty while pending", func(t *testing.T) {
+		l := getLimiter()
+		// Let the Limiter evaluate its state and block handing out
+		// a slot before the penalty arrives.
+		time.Sleep(time.Millisecond * 10)
+		penalty := time.Millisecond * 100
+		l.Backoff(RateLimitState{Penalty: penalty})
+
+		start := time.Now()
+		l.Run(ctx, noBackoff)
+		require.True(t, time.Since(start) >= penalty/2,
+			"pending slot ignored penalty")
+		l.Halt()
+	})
+
+	t.Run("threshold while pending", func(t *testing.T) {
+		l := getLimiter()
+		time.Sleep(time.Millisecond * 10)
+		l.Backoff(RateLimitState{Current: 30, Max: 30})
+
+		start := time.Now()
+		l.Run(ctx, noBackoff)
+		require.True(t, time.Since(start) >= backoff,
+			"pending slot ignored threshold")
+		l.Halt()
+	})
+
+	t.Run("halt", func(t *testing.T) {
+		l := getLimiter()
+		l.Halt()
+		l.Halt()
+		require.Equal(t, ErrLimiterHalted, l.Run(ctx, noBackoff))
+	})
+
+	t.Run("halt while waiting", func(t *testing.T) {
+		l := getLimiter()
+		l.Backoff(RateLimitState{Penalty: time.Hour})
+		// Let the Limiter consume its tick and start waiting out
+		// the penalty, so Run is left waiting.
+		time.Sleep(time.Millisecond * 10)
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			l.Halt()
+		}()
+		require.Equal(t, ErrLimiterHalted, l.Run(ctx, noBackoff))
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		l := getLimiter()
+		l.Backoff(RateLimitState{Penalty: time.Hour})
+		time.Sleep(time.Millisecond * 10)
+
+		ctx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+		defer cancel()
+		require.Equal(t, context.DeadlineExceeded, l.Run(ctx, noBackoff))
+		l.Halt()
+	})
+
 	t.Run("backoff to steady state", func(t *testing.T) {
 		l := getLimiter()
-		l.Run(forceBackoff)
+		l.Run(ctx, forceBackoff)
 
 		// Get around the flakiness of the scheduler by forcing
 		// backoff state to propagate thoroughly
 		for i := 0; i < 5; i++ {
-			l.Run(forceBackoff)
+			l.Run(ctx, forceBackoff)
 		}
 
 		var minDelta time.Duration
 		for i := 0; i < 20; i++ {
 			start := time.Now()
-			l.Run(noBackoff)
+			l.Run(ctx, noBackoff)
 			end := time.Now()
 
 			delta := end.Sub(start)
@@ -160,7 +216,7 @@ func TestLadderURL(t *testing.T) {
 	}
 	ladderName := "some-ladder (ABC12020)"
 
-	result, err := ladderURL(cursor, ladderName)
+	result, err := ladderURL(GetLadderURL, cursor, ladderName, LadderOptions{})
 	require.NoError(t, err)
 
 	resultString := result.String()
@@ -174,4 +230,47 @@ func TestLadderURL(t *testing.T) {
 	// Ensure the end result is a valid url
 	_, err = url.Parse(resultString)
 	require.NoError(t, err)
+
+	// Only the cursor is present without options
+	require.Len(t, result.Query(), 2)
+
+	result, err = ladderURL(GetLadderURL, cursor, ladderName, LadderOptions{
+		Sort:        LadderSortDepthSolo,
+		AccountName: "some account",
+	})
+	require.NoError(t, err)
+
+	query := result.Query()
+	require.Equal(t, LadderSortDepthSolo, query.Get("sort"))
+	require.Equal(t, "some account", query.Get("accountName"))
+	require.Empty(t, query.Get("track"))
+	require.Equal(t, strconv.Itoa(cursor.Limit), query.Get("limit"))
+
+	// The labyrinth ladder is selected by the day of its race
+	raceDay := time.Date(2020, time.March, 14, 0, 0, 0, 0, time.UTC)
+	result, err = ladderURL(GetLadderURL, cursor, ladderName, LadderOptions{
+		Type:       LadderTypeLabyrinth,
+		Difficulty: LabyrinthEternal,
+		Start:      raceDay.Add(time.Hour * 15),
+	})
+	require.NoError(t, err)
+
+	query = result.Query()
+	require.Equal(t, LadderTypeLabyrinth, query.Get("type"))
+	require.Equal(t, LabyrinthEternal, query.Get("difficulty"))
+	require.Equal(t, strconv.FormatInt(raceDay.Unix(), 10), query.Get("start"))
+	require.Empty(t, query.Get("sort"))
+
+	for _, invalid := range []LadderOptions{
+		{Sort: "class"},
+		{Type: "pvp"},
+		{Type: LadderTypeLabyrinth, Start: raceDay},
+		{Type: LadderTypeLabyrinth, Difficulty: LabyrinthEternal},
+		{Type: LadderTypeLabyrinth, Difficulty: LabyrinthEternal, Start: raceDay,
+			Sort: LadderSortDepth},
+		{Difficulty: LabyrinthEternal},
+	} {
+		_, err = ladderURL(GetLadderURL, cursor, ladderName, invalid)
+		require.Error(t, err, "%+v", invalid)
+	}
 }
@@ -0,0 +1,114 @@
+package remote
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Retry describes how fetches failing with a transient error are retried.
+//
+// Delays grow exponentially from Base up to Max, with jitter so
+// concurrent fetches don't retry in lockstep. A rate limited fetch
+// always waits at least as long as the remote asked.
+type Retry struct {
+	// Attempts is the most times a fetch is tried, including the first;
+	// zero or one disables retries.
+	Attempts int
+	Base     time.Duration
+	Max      time.Duration
+}
+
+// DefaultRetry is the Retry used by a Client unless specified.
+var DefaultRetry = Retry{
+	Attempts: 4,
+	Base:     time.Second * 2,
+	Max:      time.Minute,
+}
+
+// Transient returns true when the error is worth retrying.
+//
+// Rate limiting, maintenance, 5xx responses and network failures are
+// transient; a missing or private character is not, nor is a
+// cancelled request or one a Replayer could not serve.
+func Transient(err error) bool {
+	cause := errors.Cause(err)
+	// Requests made by an http.Client fail with why wrapped
+	if urlErr, ok := cause.(*url.Error); ok {
+		cause = errors.Cause(urlErr.Err)
+	}
+	if cause == context.Canceled || cause == context.DeadlineExceeded {
+		return false
+	}
+
+	switch cause := cause.(type) {
+	case ErrRateLimited:
+		return true
+	case ErrServer:
+		return cause.StatusCode >= 500
+	case ErrReplay:
+		return false
+	case *net.OpError:
+		// Dialing, reading or writing failed
+		return true
+	case net.Error:
+		return cause.Timeout()
+	}
+	return cause == ErrMaintenance || cause == io.ErrUnexpectedEOF
+}
+
+// delay returns how long to wait before the provided attempt,
+// starting from zero, is retried after failing with err.
+func (r Retry) delay(attempt int, err error) time.Duration {
+	d := r.Base << uint(attempt)
+	if d > r.Max || d <= 0 {
+		d = r.Max
+	}
+	// Jitter into [d/2, d)
+	if half := int64(d / 2); half > 0 {
+		d = time.Duration(half + rand.Int63n(half))
+	}
+
+	if limited, ok := errors.Cause(err).(ErrRateLimited); ok &&
+		limited.RetryAfter > d {
+		d = limited.RetryAfter
+	}
+	return d
+}
+
+// Do calls the provided function until it succeeds, fails with an
+// error which is not Transient, runs out of Attempts or the
+// context is done.
+//
+// The final error is returned; this is the context's error when
+// it is done while waiting to retry.
+func (r Retry) Do(ctx context.Context, logContext *zap.Logger, cb func() error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = cb()
+		if err == nil || ctx.Err() != nil || !Transient(err) ||
+			attempt+1 >= r.Attempts {
+			return err
+		}
+
+		d := r.delay(attempt, err)
+		logContext.Warn("retrying transient failure",
+			zap.Error(err),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("delay", d))
+
+		timer := time.NewTimer(d)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
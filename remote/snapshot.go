@@ -0,0 +1,105 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/Everlag/slippery-policy/items"
+	"github.com/Everlag/slippery-policy/passives"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// SnapshotOptions selects what FetchSnapshot fetches.
+type SnapshotOptions struct {
+	Items    bool
+	Passives bool
+}
+
+// Snapshot is everything fetched about a Character at a single
+// point in time.
+//
+// Items and Passives are nil when they were not fetched or failed.
+type Snapshot struct {
+	Items    *items.GetItemResp
+	Passives *passives.GetPassivesResp
+
+	// ItemsFetched and PassivesFetched are when Items and Passives
+	// were fetched, they are zero when not fetched.
+	ItemsFetched    time.Time
+	PassivesFetched time.Time
+	// ItemsErr and PassivesErr are why Items and Passives are nil
+	// despite being fetched.
+	ItemsErr    error
+	PassivesErr error
+	// Private is set when the Character's profile could not be
+	// read; Items and Passives are then nil.
+	Private bool
+}
+
+// FetchSnapshot fetches, then decodes, everything selected about a
+// Character under a specified account.
+//
+// Failures are recorded in the returned Snapshot rather than
+// returned. Fetching stops at the first private profile or missing
+// Character, and a Character found to be missing part way is treated
+// as missing entirely, so the Snapshot is always consistent.
+//
+// Passives are not fetched once items fail for any reason, as a
+// Snapshot without its items cannot be checked; this saves spending
+// a request on them.
+func (c *Client) FetchSnapshot(ctx context.Context, logContext *zap.Logger,
+	account, character string, opts SnapshotOptions) Snapshot {
+
+	var s Snapshot
+
+	if opts.Items {
+		var buf []byte
+		buf, s.ItemsErr = c.FetchCharacter(ctx, logContext, account, character)
+		s.ItemsFetched = time.Now()
+		if s.ItemsErr == nil {
+			s.Items, s.ItemsErr = items.ReadGetItemResp(bytes.NewReader(buf))
+			s.ItemsErr = errors.Wrap(s.ItemsErr,
+				"decoding character; api may have changed in a way that breaks compatibility")
+		}
+		if s.ItemsErr != nil {
+			snapshotStop(&s, s.ItemsErr)
+			return s
+		}
+	}
+
+	if opts.Passives {
+		var buf []byte
+		buf, s.PassivesErr = c.FetchPassives(ctx, logContext, account, character)
+		s.PassivesFetched = time.Now()
+		if s.PassivesErr == nil {
+			s.Passives, s.PassivesErr = passives.ReadPassives(bytes.NewReader(buf))
+			s.PassivesErr = errors.Wrap(s.PassivesErr,
+				"decoding passives; api may have changed in a way that breaks compatibility")
+		}
+		if snapshotStop(&s, s.PassivesErr) {
+			// Discard what we fetched before the Character went missing
+			// or private.
+			s.Items = nil
+			if s.ItemsErr == nil {
+				s.ItemsErr = s.PassivesErr
+			}
+		}
+	}
+
+	return s
+}
+
+// snapshotStop records the provided fetch error on the snapshot,
+// returning true if nothing more should be fetched.
+func snapshotStop(s *Snapshot, err error) bool {
+	switch errors.Cause(err) {
+	case ErrPrivateProfile:
+		s.Private = true
+		return true
+	case ErrNotFound:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFetchSnapshot(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	account, character := "some-account", "some-character"
+	all := SnapshotOptions{Items: true, Passives: true}
+
+	// snapshotHandler serves items and passives with the provided
+	// statuses, counting requests to each.
+	snapshotHandler := func(itemsStatus, passivesStatus int,
+		requests *requestCounter) http.HandlerFunc {
+
+		return func(w http.ResponseWriter, r *http.Request) {
+			status, body := passivesStatus, `{"hashes": [1, 2], "items": []}`
+			if strings.HasSuffix(r.URL.Path, "get-items") {
+				status, body = itemsStatus, `{"items": [], "character": {"name": "some-character", "level": 90}}`
+			}
+			requests.add(r.URL.Path)
+
+			w.Header().Set("X-Rate-Limit-Rules", "Ip")
+			w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+			w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				w.Write([]byte(body))
+			}
+		}
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		requests := &requestCounter{}
+		c, done := testClient(t, snapshotHandler(http.StatusOK, http.StatusOK, requests))
+		defer done()
+
+		start := time.Now()
+		s := c.FetchSnapshot(ctx, logger, account, character, all)
+		require.NoError(t, s.ItemsErr)
+		require.NoError(t, s.PassivesErr)
+		require.False(t, s.Private)
+		require.Equal(t, 90, s.Items.Character.Level)
+		require.Equal(t, []int{1, 2}, s.Passives.Hashes)
+		require.False(t, s.ItemsFetched.Before(start))
+		require.False(t, s.PassivesFetched.Before(s.ItemsFetched))
+	})
+
+	t.Run("only passives", func(t *testing.T) {
+		requests := &requestCounter{}
+		c, done := testClient(t, snapshotHandler(http.StatusOK, http.StatusOK, requests))
+		defer done()
+
+		s := c.FetchSnapshot(ctx, logger, account, character, SnapshotOptions{Passives: true})
+		require.Nil(t, s.Items)
+		require.True(t, s.ItemsFetched.IsZero())
+		require.NotNil(t, s.Passives)
+		require.Equal(t, 0, requests.get("/character-window/get-items"))
+	})
+
+	t.Run("private", func(t *testing.T) {
+		requests := &requestCounter{}
+		c, done := testClient(t, snapshotHandler(http.StatusForbidden, http.StatusForbidden, requests))
+		defer done()
+
+		s := c.FetchSnapshot(ctx, logger, account, character, all)
+		require.True(t, s.Private)
+		require.Nil(t, s.Items)
+		require.Nil(t, s.Passives)
+		require.Equal(t, ErrPrivateProfile, errors.Cause(s.ItemsErr))
+		require.Equal(t, 0, requests.get("/character-window/get-passive-skills"),
+			"fetched passives of a private profile")
+	})
+
+	t.Run("deleted between fetches", func(t *testing.T) {
+		requests := &requestCounter{}
+		c, done := testClient(t, snapshotHandler(http.StatusOK, http.StatusNotFound, requests))
+		defer done()
+
+		s := c.FetchSnapshot(ctx, logger, account, character, all)
+		require.False(t, s.Private)
+		require.Nil(t, s.Items, "kept items of a deleted character")
+		require.Nil(t, s.Passives)
+		require.Equal(t, ErrNotFound, errors.Cause(s.ItemsErr))
+		require.Equal(t, ErrNotFound, errors.Cause(s.PassivesErr))
+	})
+
+	t.Run("items failed", func(t *testing.T) {
+		requests := &requestCounter{}
+		c, done := testClient(t, snapshotHandler(http.StatusServiceUnavailable, http.StatusOK, requests))
+		defer done()
+
+		s := c.FetchSnapshot(ctx, logger, account, character, all)
+		require.False(t, s.Private)
+		require.Equal(t, ErrMaintenance, errors.Cause(s.ItemsErr))
+		require.Nil(t, s.Items)
+		require.Nil(t, s.Passives)
+		require.True(t, s.PassivesFetched.IsZero())
+		require.Equal(t, c.Retry.Attempts, requests.get("/character-window/get-items"))
+		require.Equal(t, 0, requests.get("/character-window/get-passive-skills"),
+			"fetched passives of a character which cannot be checked")
+	})
+
+	t.Run("passives failed", func(t *testing.T) {
+		requests := &requestCounter{}
+		c, done := testClient(t, snapshotHandler(http.StatusOK, http.StatusBadRequest, requests))
+		defer done()
+
+		s := c.FetchSnapshot(ctx, logger, account, character, all)
+		require.NoError(t, s.ItemsErr)
+		require.NotNil(t, s.Items)
+		require.Error(t, s.PassivesErr)
+		require.Nil(t, s.Passives)
+	})
+}
+
+// requestCounter counts requests by path, safely from any handler.
+type requestCounter struct {
+	byPath map[string]int
+	sync.Mutex
+}
+
+func (c *requestCounter) add(path string) {
+	c.Lock()
+	defer c.Unlock()
+	if c.byPath == nil {
+		c.byPath = make(map[string]int)
+	}
+	c.byPath[path]++
+}
+
+func (c *requestCounter) get(path string) int {
+	c.Lock()
+	defer c.Unlock()
+	return c.byPath[path]
+}
@@ -0,0 +1,242 @@
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// redactedRequestHeaders and redactedResponseHeaders are never
+// recorded, they carry our Credential or a new session.
+var (
+	redactedRequestHeaders  = []string{"Authorization", "Cookie"}
+	redactedResponseHeaders = []string{"Set-Cookie"}
+)
+
+// exchange is a single recorded request and its response.
+type exchange struct {
+	Request struct {
+		Method string      `json:"method"`
+		URL    string      `json:"url"`
+		Header http.Header `json:"header"`
+		Body   string      `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"statusCode"`
+		Header     http.Header `json:"header"`
+		Body       string      `json:"body"`
+	} `json:"response"`
+}
+
+// exchangeKey identifies requests which are replayed from the same
+// recorded exchanges.
+func exchangeKey(method, url, body string) string {
+	return fmt.Sprintf("%s %s\n%s", method, url, body)
+}
+
+// readRequestBody returns the body of the request, consuming it as
+// sending the request would.
+func readRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil {
+		return "", nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// cloneRequest returns a copy of the request able to be sent, with
+// its body buffered, alongside that body.
+//
+// The request of the caller is left as-is besides its body being
+// consumed; a RoundTripper must not otherwise modify it.
+func cloneRequest(req *http.Request) (*http.Request, string, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, "", err
+	}
+	clone := req.Clone(req.Context())
+	if req.Body != nil {
+		clone.Body = ioutil.NopCloser(strings.NewReader(body))
+		clone.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader(body)), nil
+		}
+	}
+	return clone, body, nil
+}
+
+// Recorder is an http.RoundTripper which writes every exchange it
+// performs to a directory, one file per exchange in the order
+// they completed, ie 000042.json
+//
+// Credentials are redacted from what is written.
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+
+	count int
+	sync.Mutex
+}
+
+// NewRecorder returns a Recorder writing to the provided directory,
+// which is created if necessary. Requests are performed by next, or
+// http.DefaultTransport if nil.
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "creating record directory")
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, next: next}, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var e exchange
+	req, body, err := cloneRequest(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading request body")
+	}
+	e.Request.Method = req.Method
+	e.Request.URL = req.URL.String()
+	e.Request.Header = req.Header.Clone()
+	for _, h := range redactedRequestHeaders {
+		e.Request.Header.Del(h)
+	}
+	e.Request.Body = body
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "reading response body")
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	e.Response.StatusCode = resp.StatusCode
+	e.Response.Header = resp.Header.Clone()
+	for _, h := range redactedResponseHeaders {
+		e.Response.Header.Del(h)
+	}
+	e.Response.Body = string(respBody)
+
+	blob, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding exchange")
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.count++
+	filename := filepath.Join(r.dir, fmt.Sprintf("%06d.json", r.count))
+	if err := ioutil.WriteFile(filename, blob, 0644); err != nil {
+		return nil, errors.Wrap(err, "writing exchange")
+	}
+
+	return resp, nil
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// Replayer is an http.RoundTripper serving the exchanges written by
+// a Recorder, without performing any requests.
+//
+// Identical requests are served their recorded responses in the order
+// they were recorded, so a whole run can be reproduced.
+type Replayer struct {
+	// exchanges holds the exchanges not yet served, by exchangeKey
+	exchanges map[string][]exchange
+
+	sync.Mutex
+}
+
+// NewReplayer returns a Replayer serving every exchange in the
+// provided directory.
+func NewReplayer(dir string) (*Replayer, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "listing exchanges")
+	}
+	if len(filenames) == 0 {
+		return nil, errors.Errorf("no exchanges recorded in %s", dir)
+	}
+	sort.Strings(filenames)
+
+	r := &Replayer{exchanges: make(map[string][]exchange)}
+	for _, filename := range filenames {
+		blob, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading exchange")
+		}
+		var e exchange
+		err = json.Unmarshal(blob, &e)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding exchange %s", filename)
+		}
+		key := exchangeKey(e.Request.Method, e.Request.URL, e.Request.Body)
+		r.exchanges[key] = append(r.exchanges[key], e)
+	}
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, ErrReplay{errors.Wrap(err, "reading request body")}
+	}
+	key := exchangeKey(req.Method, req.URL.String(), body)
+
+	r.Lock()
+	recorded := r.exchanges[key]
+	if len(recorded) == 0 {
+		r.Unlock()
+		return nil, ErrReplay{errors.Errorf("no recorded exchange left for %s %s",
+			req.Method, req.URL)}
+	}
+	e := recorded[0]
+	r.exchanges[key] = recorded[1:]
+	r.Unlock()
+
+	status := e.Response.StatusCode
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Response.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(e.Response.Body))),
+		ContentLength: int64(len(e.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+var _ http.RoundTripper = &Replayer{}
+
+// ErrReplay is returned when a Replayer cannot serve a request;
+// retrying would fail the same way.
+type ErrReplay struct {
+	Err error
+}
+
+func (e ErrReplay) Error() string {
+	return e.Err.Error()
+}
@@ -0,0 +1,136 @@
+package remote
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	dir, err := ioutil.TempDir("", "record")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var served int32
+	c, done := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Rules", "Ip")
+		w.Header().Set("X-Rate-Limit-Ip", "45:60:60")
+		w.Header().Set("X-Rate-Limit-Ip-State", "1:60:0")
+		http.SetCookie(w, &http.Cookie{Name: SessionCookieName, Value: "new-session"})
+		if atomic.AddInt32(&served, 1) == 1 {
+			w.Write([]byte(`{"items": ["first"]}`))
+			return
+		}
+		w.Write([]byte(`{"items": ["second"]}`))
+	})
+	c.Credential = Credential{SessionID: "some-session"}
+
+	recorder, err := NewRecorder(dir, c.HTTP.Transport)
+	require.NoError(t, err)
+	c.HTTP = &http.Client{Transport: recorder}
+
+	for _, character := range []string{"some-character", "some-character", "other-character"} {
+		_, err := c.FetchCharacter(ctx, logger, "some-account", character)
+		require.NoError(t, err)
+	}
+	done()
+
+	t.Run("recorded", func(t *testing.T) {
+		recorded, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		require.NoError(t, err)
+		require.Len(t, recorded, 3)
+
+		for _, filename := range recorded {
+			blob, err := ioutil.ReadFile(filename)
+			require.NoError(t, err)
+			require.NotContains(t, string(blob), "some-session",
+				"credential was recorded")
+			require.NotContains(t, string(blob), "Set-Cookie",
+				"new session was recorded")
+			require.NotContains(t, string(blob), "new-session",
+				"new session was recorded")
+		}
+	})
+
+	t.Run("replayed in order", func(t *testing.T) {
+		replayer, err := NewReplayer(dir)
+		require.NoError(t, err)
+		c.HTTP = &http.Client{Transport: replayer}
+		c.Retry = Retry{}
+
+		body, err := c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.NoError(t, err)
+		require.Equal(t, `{"items": ["first"]}`, string(body))
+
+		body, err = c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.NoError(t, err)
+		require.Equal(t, `{"items": ["second"]}`, string(body))
+
+		body, err = c.FetchCharacter(ctx, logger, "some-account", "other-character")
+		require.NoError(t, err)
+		require.Equal(t, `{"items": ["second"]}`, string(body))
+
+		// Running out of exchanges fails at once rather than retrying
+		c.Retry = Retry{Attempts: 3, Base: time.Hour, Max: time.Hour}
+		_, err = c.FetchCharacter(ctx, logger, "some-account", "some-character")
+		require.Error(t, err, "replayed beyond what was recorded")
+		urlErr, ok := errors.Cause(err).(*url.Error)
+		require.True(t, ok)
+		require.IsType(t, ErrReplay{}, urlErr.Err)
+		require.False(t, Transient(err))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		empty, err := ioutil.TempDir("", "replay")
+		require.NoError(t, err)
+		defer os.RemoveAll(empty)
+
+		_, err = NewReplayer(empty)
+		require.Error(t, err)
+	})
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "record")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	received := make(chan string, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- string(body)
+	}))
+	defer s.Close()
+
+	recorder, err := NewRecorder(dir, s.Client().Transport)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("POST", s.URL, strings.NewReader("some-body"))
+	require.NoError(t, err)
+	original := req.Body
+	resp, err := recorder.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Equal(t, "some-body", <-received)
+	require.True(t, req.Body == original, "request of the caller was modified")
+
+	blob, err := ioutil.ReadFile(filepath.Join(dir, "000001.json"))
+	require.NoError(t, err)
+	require.Contains(t, string(blob), "some-body")
+}